@@ -330,6 +330,30 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyType() Option {
 	}
 }
 
+func WithVaultCredentialLibraryKvVersion(inKvVersion string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_version"] = inKvVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
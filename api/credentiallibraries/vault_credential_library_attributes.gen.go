@@ -14,6 +14,7 @@ type VaultCredentialLibraryAttributes struct {
 	Path            string `json:"path,omitempty"`
 	HttpMethod      string `json:"http_method,omitempty"`
 	HttpRequestBody string `json:"http_request_body,omitempty"`
+	KvVersion       string `json:"kv_version,omitempty"`
 }
 
 func AttributesMapToVaultCredentialLibraryAttributes(in map[string]interface{}) (*VaultCredentialLibraryAttributes, error) {
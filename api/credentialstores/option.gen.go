@@ -115,6 +115,54 @@ func WithVaultCredentialStoreAddress(inAddress string) Option {
 	}
 }
 
+func WithVaultCredentialStoreApproleRoleId(inApproleRoleId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["approle_role_id"] = inApproleRoleId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreApproleRoleId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["approle_role_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreApproleSecretId(inApproleSecretId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["approle_secret_id"] = inApproleSecretId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreApproleSecretId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["approle_secret_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -127,6 +175,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithVaultCredentialStoreAuthMountPath(inAuthMountPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_mount_path"] = inAuthMountPath
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreAuthMountPath() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_mount_path"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreCaCert(inCaCert string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -211,6 +283,54 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithVaultCredentialStoreKubernetesJwt(inKubernetesJwt string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kubernetes_jwt"] = inKubernetesJwt
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreKubernetesJwt() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kubernetes_jwt"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreKubernetesRole(inKubernetesRole string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kubernetes_role"] = inKubernetesRole
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreKubernetesRole() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kubernetes_role"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -11,18 +11,27 @@ import (
 )
 
 type VaultCredentialStoreAttributes struct {
-	Address                  string `json:"address,omitempty"`
-	Namespace                string `json:"namespace,omitempty"`
-	CaCert                   string `json:"ca_cert,omitempty"`
-	TlsServerName            string `json:"tls_server_name,omitempty"`
-	TlsSkipVerify            bool   `json:"tls_skip_verify,omitempty"`
-	Token                    string `json:"token,omitempty"`
-	TokenHmac                string `json:"token_hmac,omitempty"`
-	ClientCertificate        string `json:"client_certificate,omitempty"`
-	ClientCertificateKey     string `json:"client_certificate_key,omitempty"`
-	ClientCertificateKeyHmac string `json:"client_certificate_key_hmac,omitempty"`
-	WorkerFilter             string `json:"worker_filter,omitempty"`
-	TokenStatus              string `json:"token_status,omitempty"`
+	Address                  string                      `json:"address,omitempty"`
+	Namespace                string                      `json:"namespace,omitempty"`
+	CaCert                   string                      `json:"ca_cert,omitempty"`
+	TlsServerName            string                      `json:"tls_server_name,omitempty"`
+	TlsSkipVerify            bool                        `json:"tls_skip_verify,omitempty"`
+	Token                    string                      `json:"token,omitempty"`
+	TokenHmac                string                      `json:"token_hmac,omitempty"`
+	ClientCertificate        string                      `json:"client_certificate,omitempty"`
+	ClientCertificateKey     string                      `json:"client_certificate_key,omitempty"`
+	ClientCertificateKeyHmac string                      `json:"client_certificate_key_hmac,omitempty"`
+	WorkerFilter             string                      `json:"worker_filter,omitempty"`
+	TokenStatus              string                      `json:"token_status,omitempty"`
+	Health                   *VaultCredentialStoreHealth `json:"health,omitempty"`
+	AuthMethod               string                      `json:"auth_method,omitempty"`
+	AuthMountPath            string                      `json:"auth_mount_path,omitempty"`
+	ApproleRoleId            string                      `json:"approle_role_id,omitempty"`
+	ApproleSecretId          string                      `json:"approle_secret_id,omitempty"`
+	ApproleSecretIdHmac      string                      `json:"approle_secret_id_hmac,omitempty"`
+	KubernetesRole           string                      `json:"kubernetes_role,omitempty"`
+	KubernetesJwt            string                      `json:"kubernetes_jwt,omitempty"`
+	KubernetesJwtHmac        string                      `json:"kubernetes_jwt_hmac,omitempty"`
 }
 
 func AttributesMapToVaultCredentialStoreAttributes(in map[string]interface{}) (*VaultCredentialStoreAttributes, error) {
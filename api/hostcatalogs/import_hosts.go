@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcatalogs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// ImportHost is a host in an ImportHosts request. Hosts are matched with the
+// existing hosts of the host catalog by name.
+type ImportHost struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Address     string `json:"address,omitempty"`
+}
+
+// ImportHostSet is a host set in an ImportHosts request. Host sets are
+// matched with the existing host sets of the host catalog by name.
+// HostNames contains the names of the hosts in the request which are
+// members of the host set.
+type ImportHostSet struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	HostNames   []string `json:"host_names,omitempty"`
+}
+
+type ImportHostsResult struct {
+	HostsCreated    uint32 `json:"hosts_created,omitempty"`
+	HostsUpdated    uint32 `json:"hosts_updated,omitempty"`
+	HostsDeleted    uint32 `json:"hosts_deleted,omitempty"`
+	HostSetsCreated uint32 `json:"host_sets_created,omitempty"`
+	HostSetsUpdated uint32 `json:"host_sets_updated,omitempty"`
+	HostSetsDeleted uint32 `json:"host_sets_deleted,omitempty"`
+	response        *api.Response
+}
+
+func (n ImportHostsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ImportHosts makes the hosts and host sets of the static host catalog
+// hostCatalogId match hosts and hostSets in a single transaction. Hosts and
+// host sets of the host catalog which are not in hosts or hostSets are
+// deleted.
+func (c *Client) ImportHosts(ctx context.Context, hostCatalogId string, hosts []ImportHost, hostSets []ImportHostSet, opt ...Option) (*ImportHostsResult, error) {
+	if hostCatalogId == "" {
+		return nil, fmt.Errorf("empty hostCatalogId value passed into ImportHosts request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if hosts == nil {
+		hosts = []ImportHost{}
+	}
+	if hostSets == nil {
+		hostSets = []ImportHostSet{}
+	}
+	opts.postMap["hosts"] = hosts
+	opts.postMap["host_sets"] = hostSets
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("host-catalogs/%s:import-hosts", url.PathEscape(hostCatalogId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ImportHosts request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ImportHosts call: %w", err)
+	}
+
+	target := new(ImportHostsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ImportHosts response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+)
+
+// Session is a session authorized against a target along with a client proxy
+// running for it in-process. Connections to the target are made with
+// DialContext, which doesn't require listening on a local port.
+//
+// EXPERIMENTAL: While this API is not expected to change, it is new and
+// feedback from users may necessitate changes.
+type Session struct {
+	proxy         *ClientProxy
+	authorization *targets.SessionAuthorization
+	credentials   Credentials
+	listener      *pipeListener
+	cancel        context.CancelFunc
+	done          chan struct{}
+	err           error
+}
+
+// Connect authorizes a session against the target, which can be given by ID,
+// or by name along with the WithAuthorizeSessionOptions option specifying the
+// target's scope. It starts a client proxy for the session which runs until
+// the given context is canceled, Close is called, or the session expires or
+// runs out of connections.
+//
+// Supported options:
+//
+// * WithAuthorizeSessionOptions - Specify the options used to authorize the
+// session, such as the host to connect to
+//
+// * WithConnectionsLeftCh, WithWorkerHost, WithSkipSessionTeardown and
+// WithHeaders - Passed to the client proxy as with New
+//
+// WithListener and WithListenAddrPort are ignored since connections are made
+// through DialContext.
+//
+// EXPERIMENTAL: While this API is not expected to change, it is new and
+// feedback from users may necessitate changes.
+func Connect(ctx context.Context, client *targets.Client, target string, opt ...Option) (*Session, error) {
+	if client == nil {
+		return nil, errors.New("nil targets client")
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse options: %w", err)
+	}
+
+	sar, err := client.AuthorizeSession(ctx, target, opts.WithAuthorizeSessionOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("error authorizing session: %w", err)
+	}
+	sa, err := sar.GetSessionAuthorization()
+	if err != nil {
+		return nil, err
+	}
+	creds, err := ParseCredentials(sa.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing session credentials: %w", err)
+	}
+
+	s := &Session{
+		authorization: sa,
+		credentials:   creds,
+		listener:      newPipeListener(),
+		done:          make(chan struct{}),
+	}
+	var proxyCtx context.Context
+	proxyCtx, s.cancel = context.WithCancel(ctx)
+	s.proxy, err = New(proxyCtx, sa.AuthorizationToken, append(opt, WithListener(s.listener))...)
+	if err != nil {
+		s.cancel()
+		return nil, fmt.Errorf("error creating client proxy: %w", err)
+	}
+	go func() {
+		defer close(s.done)
+		s.err = s.proxy.Start()
+	}()
+	return s, nil
+}
+
+// DialContext connects to the target through the session. The network and
+// address are ignored since every connection is made to the session's target;
+// they are accepted so that the method can be used in place of
+// net.Dialer.DialContext, for instance by an http.Transport. Each connection
+// counts against the session's connection limit.
+//
+// EXPERIMENTAL: While this API is not expected to change, it is new and
+// feedback from users may necessitate changes.
+func (s *Session) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	client, server := net.Pipe()
+	select {
+	case s.listener.conns <- server:
+		return client, nil
+	case <-s.listener.closed:
+		client.Close()
+		server.Close()
+		return nil, fmt.Errorf("session %s is no longer accepting connections: %w", s.SessionId(), net.ErrClosed)
+	case <-ctx.Done():
+		client.Close()
+		server.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// SessionId returns the ID of the session.
+func (s *Session) SessionId() string {
+	return s.authorization.SessionId
+}
+
+// SessionAuthorization returns the authorization of the session as returned
+// by the controller.
+func (s *Session) SessionAuthorization() *targets.SessionAuthorization {
+	return s.authorization
+}
+
+// Credentials returns the credentials brokered for the session, which the
+// caller is responsible for presenting to the target when connecting.
+func (s *Session) Credentials() Credentials {
+	return s.credentials
+}
+
+// SessionExpiration returns the expiration time of the session.
+func (s *Session) SessionExpiration() time.Time {
+	return s.proxy.SessionExpiration()
+}
+
+// ConnectionsLeft returns the number of connections left in the session, or -1
+// if unlimited.
+func (s *Session) ConnectionsLeft() int32 {
+	return s.proxy.ConnectionsLeft()
+}
+
+// ClientProxy returns the client proxy running for the session.
+func (s *Session) ClientProxy() *ClientProxy {
+	return s.proxy
+}
+
+// Done returns a channel which is closed once the client proxy has stopped.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close stops the client proxy, waits for it to stop, and returns any error it
+// stopped with. The session is canceled unless it has already ended or the
+// WithSkipSessionTeardown option was given.
+func (s *Session) Close() error {
+	s.cancel()
+	<-s.done
+	return s.err
+}
+
+// pipeListener is a listener which accepts the in-memory connections made by
+// Session.DialContext.
+type pipeListener struct {
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *pipeListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+// pipeAddr is the address of a pipeListener.
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "pipe" }
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+
+	// The worker address is closed so that connections made through the
+	// session fail once the proxy tries to reach it
+	workerLn, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	workerAddr := workerLn.Addr().String()
+	require.NoError(t, workerLn.Close())
+
+	sessionAuth := testSessionAuth(t)
+	marshaled, err := proto.Marshal(&targetspb.SessionAuthorizationData{
+		SessionId:       sessionAuth.SessionId,
+		TargetId:        sessionAuth.TargetId,
+		CreatedTime:     timestamppb.New(sessionAuth.CreatedTime),
+		Expiration:      timestamppb.New(sessionAuth.Expiration),
+		Type:            sessionAuth.Type,
+		ConnectionLimit: sessionAuth.ConnectionLimit,
+		Certificate:     sessionAuth.Certificate,
+		WorkerInfo:      []*targetspb.WorkerInfo{{Address: workerAddr}},
+	})
+	require.NoError(t, err)
+
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/targets/ttcp_1234567890:authorize-session":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			json.NewEncoder(w).Encode(&targets.SessionAuthorization{
+				SessionId:          sessionAuth.SessionId,
+				TargetId:           sessionAuth.TargetId,
+				AuthorizationToken: base58.FastBase58Encoding(marshaled),
+				Credentials:        []*targets.SessionCredential{typedUsernamePassword},
+			})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, `{"kind": "PermissionDenied", "message": "Forbidden."}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(&api.Config{Addr: srv.URL})
+	require.NoError(t, err)
+	targetClient := targets.NewClient(client)
+	ctx := context.Background()
+
+	t.Run("nil client", func(t *testing.T) {
+		_, err := Connect(ctx, nil, "ttcp_1234567890")
+		assert.ErrorContains(t, err, "nil targets client")
+	})
+
+	t.Run("authorization denied", func(t *testing.T) {
+		_, err := Connect(ctx, targetClient, "ttcp_denied")
+		assert.ErrorContains(t, err, "error authorizing session")
+		assert.ErrorContains(t, err, "Forbidden.")
+	})
+
+	t.Run("connect", func(t *testing.T) {
+		require, assert := require.New(t), assert.New(t)
+		s, err := Connect(ctx, targetClient, "ttcp_1234567890",
+			WithAuthorizeSessionOptions(targets.WithHostId("h_1234567890")),
+			WithSkipSessionTeardown(true),
+		)
+		require.NoError(err)
+		assert.Equal("h_1234567890", gotBody["host_id"])
+		assert.Equal(sessionAuth.SessionId, s.SessionId())
+		assert.Equal(sessionAuth.TargetId, s.SessionAuthorization().TargetId)
+		assert.Equal(int32(4), s.ConnectionsLeft())
+		assert.WithinDuration(sessionAuth.Expiration, s.SessionExpiration(), time.Second)
+		require.Len(s.Credentials().UsernamePassword, 1)
+		assert.Equal("user", s.Credentials().UsernamePassword[0].Username)
+		assert.Equal("pass", s.Credentials().UsernamePassword[0].Password)
+
+		// The connection is closed once the proxy fails to reach the worker,
+		// which stops the proxy
+		conn, err := s.DialContext(ctx, "tcp", "ignored:1")
+		require.NoError(err)
+		_, err = conn.Read(make([]byte, 1))
+		assert.Error(err)
+		<-s.Done()
+		assert.ErrorContains(s.Close(), "error from getWsConn")
+
+		_, err = s.DialContext(ctx, "tcp", "ignored:1")
+		assert.ErrorIs(err, net.ErrClosed)
+	})
+}
+
+func TestPipeListener(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	l := newPipeListener()
+	s := &Session{
+		authorization: &targets.SessionAuthorization{SessionId: "s_1234567890"},
+		listener:      l,
+	}
+	ctx := context.Background()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, conn)
+	}()
+	conn, err := s.DialContext(ctx, "tcp", "")
+	require.NoError(err)
+	go conn.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(err)
+	assert.Equal("ping", string(buf))
+	require.NoError(conn.Close())
+
+	// Dialing gives up when the context is canceled before the connection is
+	// accepted
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.DialContext(canceledCtx, "tcp", "")
+	assert.ErrorIs(err, context.Canceled)
+
+	require.NoError(l.Close())
+	require.NoError(l.Close())
+	_, err = l.Accept()
+	assert.ErrorIs(err, net.ErrClosed)
+	_, err = s.DialContext(ctx, "tcp", "")
+	assert.ErrorIs(err, net.ErrClosed)
+	assert.ErrorContains(err, "session s_1234567890 is no longer accepting connections")
+}
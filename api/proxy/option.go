@@ -6,6 +6,7 @@ package proxy
 import (
 	"errors"
 	"net"
+	"net/http"
 	"net/netip"
 
 	"github.com/hashicorp/boundary/api/targets"
@@ -35,6 +36,8 @@ type Options struct {
 	WithWorkerHost               string
 	WithSessionAuthorizationData *targets.SessionAuthorizationData
 	WithSkipSessionTeardown      bool
+	WithHeaders                  http.Header
+	WithAuthorizeSessionOptions  []targets.Option
 }
 
 // Option is a function that takes in an options struct and sets values or
@@ -118,3 +121,22 @@ func WithSkipSessionTeardown(with bool) Option {
 		return nil
 	}
 }
+
+// WithHeaders can be used to add headers to the requests made to the worker
+// to open connections, e.g. to propagate a trace context.
+func WithHeaders(with http.Header) Option {
+	return func(o *Options) error {
+		o.WithHeaders = with
+		return nil
+	}
+}
+
+// WithAuthorizeSessionOptions can be used to provide the options used by
+// Connect to authorize the session, such as the target's scope or the host to
+// connect to.
+func WithAuthorizeSessionOptions(with ...targets.Option) Option {
+	return func(o *Options) error {
+		o.WithAuthorizeSessionOptions = with
+		return nil
+	}
+}
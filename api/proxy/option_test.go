@@ -5,6 +5,7 @@ package proxy
 
 import (
 	"net"
+	"net/http"
 	"net/netip"
 	"testing"
 
@@ -86,4 +87,23 @@ func Test_GetOpts(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(opts.WithSkipSessionTeardown)
 	})
+	t.Run("with-headers", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Nil(opts.WithHeaders)
+		h := http.Header{"Traceparent": []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}}
+		opts, err = getOpts(WithHeaders(h))
+		require.NoError(t, err)
+		assert.Equal(h, opts.WithHeaders)
+	})
+	t.Run("with-authorize-session-options", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Nil(opts.WithAuthorizeSessionOptions)
+		opts, err = getOpts(WithAuthorizeSessionOptions(targets.WithHostId("h_1234567890"), targets.WithName("foo")))
+		require.NoError(t, err)
+		assert.Len(opts.WithAuthorizeSessionOptions, 2)
+	})
 }
@@ -5,7 +5,9 @@ package proxy
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
@@ -39,7 +41,10 @@ type ClientProxy struct {
 	callerConnectionsLeftCh chan int32
 	sessionAuthzData        *targets.SessionAuthorizationData
 	createTime              time.Time
+	sessionLock             *sync.RWMutex
+	certificate             *tls.Certificate
 	expiration              time.Time
+	expirationTimer         *time.Timer
 	ctx                     context.Context
 	cancel                  context.CancelFunc
 	transport               *http.Transport
@@ -51,6 +56,7 @@ type ClientProxy struct {
 	connWg                  *sync.WaitGroup
 	started                 *atomic.Bool
 	skipSessionTeardown     bool
+	headers                 http.Header
 }
 
 // New creates a new client proxy. The given context should be cancelable; once
@@ -74,6 +80,8 @@ type ClientProxy struct {
 // * WithWorkerHost - If set, use this host name as the SNI host when making the
 // TLS connection to the worker
 //
+// * WithHeaders - Specify headers to add to the requests made to the worker
+//
 // EXPERIMENTAL: While this API is not expected to change, it is new and
 // feedback from users may necessitate changes.
 func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, error) {
@@ -97,6 +105,8 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 		callerConnectionsLeftCh: opts.WithConnectionsLeftCh,
 		started:                 new(atomic.Bool),
 		skipSessionTeardown:     opts.WithSkipSessionTeardown,
+		headers:                 opts.WithHeaders,
+		sessionLock:             new(sync.RWMutex),
 	}
 
 	if opts.WithListener != nil {
@@ -137,8 +147,10 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 
 	// We don't _rely_ on client-side timeout verification but this prevents us
 	// seeming to be ready for a connection that will immediately fail when we
-	// try to actually make it
-	p.ctx, p.cancel = context.WithDeadline(ctx, p.expiration)
+	// try to actually make it. A timer is used instead of a deadline as the
+	// session can be extended.
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	p.expirationTimer = time.AfterFunc(time.Until(p.expiration), p.cancel)
 
 	transport := cleanhttp.DefaultTransport()
 	transport.DisableKeepAlives = false
@@ -309,7 +321,7 @@ func (p *ClientProxy) Start() (retErr error) {
 	var sendSessionCancel bool
 	// If we're not after expiration, ensure there is a bit of buffer in
 	// case clocks are not quite the same between worker and this machine
-	if time.Now().Before(p.expiration.Add(-5 * time.Minute)) {
+	if time.Now().Before(p.SessionExpiration().Add(-5 * time.Minute)) {
 		sendSessionCancel = true
 	}
 
@@ -380,9 +392,56 @@ func (p *ClientProxy) SessionCreatedTime() time.Time {
 // EXPERIMENTAL: While this API is not expected to change, it is new and
 // feedback from users may necessitate changes.
 func (p *ClientProxy) SessionExpiration() time.Time {
+	p.sessionLock.RLock()
+	defer p.sessionLock.RUnlock()
 	return p.expiration
 }
 
+// SetSessionCertificate replaces the session certificate once the session has
+// been extended, which reissues the certificate with the new expiration time.
+// New connections present the given certificate and the proxy keeps running
+// until it expires. A certificate that does not expire later than the current
+// one is ignored.
+//
+// EXPERIMENTAL: While this API is not expected to change, it is new and
+// feedback from users may necessitate changes.
+func (p *ClientProxy) SetSessionCertificate(certificate []byte) error {
+	const op = "proxy.(ClientProxy).SetSessionCertificate"
+	parsedCert, err := x509.ParseCertificate(certificate)
+	if err != nil {
+		return fmt.Errorf("%s: unable to decode session certificate: %w", op, err)
+	}
+	privKey := ed25519.PrivateKey(p.sessionAuthzData.PrivateKey)
+	if pubKey, ok := privKey.Public().(ed25519.PublicKey); !ok || !pubKey.Equal(parsedCert.PublicKey) {
+		return fmt.Errorf("%s: session certificate does not match the session private key", op)
+	}
+
+	p.sessionLock.Lock()
+	defer p.sessionLock.Unlock()
+	if !parsedCert.NotAfter.After(p.certificate.Leaf.NotAfter) {
+		return nil
+	}
+	p.certificate = &tls.Certificate{
+		Certificate: [][]byte{certificate},
+		PrivateKey:  privKey,
+		Leaf:        parsedCert,
+	}
+	if parsedCert.NotAfter.After(p.expiration) {
+		p.expiration = parsedCert.NotAfter
+		if p.ctx.Err() == nil {
+			p.expirationTimer.Reset(time.Until(p.expiration))
+		}
+	}
+	return nil
+}
+
+// sessionCertificate returns the current session certificate.
+func (p *ClientProxy) sessionCertificate() *tls.Certificate {
+	p.sessionLock.RLock()
+	defer p.sessionLock.RUnlock()
+	return p.certificate
+}
+
 // ConnectionsLeft returns the number of connections left in the session, or -1
 // if unlimited.
 //
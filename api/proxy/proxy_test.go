@@ -149,6 +149,54 @@ func TestListenerAddr(t *testing.T) {
 	assert.WithinDuration(start.Add(3*time.Second), time.Now(), 500*time.Millisecond)
 }
 
+func TestSetSessionCertificate(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	sessionAuth := testSessionAuth(t)
+	sessionAuth.Expiration = time.Now().Add(200 * time.Millisecond)
+	p, err := New(context.Background(), "", WithSessionAuthorizationData(sessionAuth))
+	require.NoError(err)
+	orig := p.sessionCertificate()
+
+	reissue := func(privKey ed25519.PrivateKey, exp time.Time) []byte {
+		template := &x509.Certificate{
+			DNSNames:              []string{sessionAuth.SessionId},
+			SerialNumber:          big.NewInt(mathrand.Int63()),
+			NotBefore:             sessionAuth.CreatedTime.Add(-1 * time.Minute),
+			NotAfter:              exp,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		certBytes, err := x509.CreateCertificate(rand.Reader, template, template, privKey.Public(), privKey)
+		require.NoError(err)
+		return certBytes
+	}
+
+	// A certificate for another key is rejected.
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	require.NoError(err)
+	assert.Error(p.SetSessionCertificate(reissue(otherKey, time.Now().Add(time.Hour))))
+	assert.Error(p.SetSessionCertificate([]byte("not a certificate")))
+
+	// A certificate which expires earlier is ignored.
+	privKey := ed25519.PrivateKey(sessionAuth.PrivateKey)
+	require.NoError(p.SetSessionCertificate(reissue(privKey, sessionAuth.Expiration.Add(-time.Second))))
+	assert.Equal(orig, p.sessionCertificate())
+	assert.Equal(sessionAuth.Expiration, p.SessionExpiration())
+
+	extended := sessionAuth.Expiration.Add(time.Hour).Truncate(time.Second)
+	certBytes := reissue(privKey, extended)
+	require.NoError(p.SetSessionCertificate(certBytes))
+	assert.Equal(certBytes, p.sessionCertificate().Certificate[0])
+	assert.True(extended.Equal(p.SessionExpiration()))
+
+	// The proxy is not canceled at the original expiration.
+	time.Sleep(400 * time.Millisecond)
+	assert.NoError(p.ctx.Err())
+	p.cancel()
+}
+
 func testSessionAuth(t *testing.T) *targets.SessionAuthorizationData {
 	sessionAuth := &targets.SessionAuthorizationData{
 		SessionId: "s_1234567890",
@@ -191,5 +239,6 @@ func testSessionAuth(t *testing.T) *targets.SessionAuthorizationData {
 	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, pubKey, privKey)
 	require.NoError(t, err)
 	sessionAuth.Certificate = certBytes
+	sessionAuth.PrivateKey = privKey
 	return sessionAuth
 }
@@ -54,16 +54,18 @@ func (p *ClientProxy) clientTlsConfig(opt ...Option) (*tls.Config, error) {
 		}
 	}
 
-	certPool := x509.NewCertPool()
-	certPool.AddCert(parsedCert)
+	p.sessionLock.Lock()
+	p.certificate = &tls.Certificate{
+		Certificate: [][]byte{p.sessionAuthzData.Certificate},
+		PrivateKey:  ed25519.PrivateKey(p.sessionAuthzData.PrivateKey),
+		Leaf:        parsedCert,
+	}
+	p.sessionLock.Unlock()
 
 	tlsConf := &tls.Config{
-		Certificates: []tls.Certificate{
-			{
-				Certificate: [][]byte{p.sessionAuthzData.Certificate},
-				PrivateKey:  ed25519.PrivateKey(p.sessionAuthzData.PrivateKey),
-				Leaf:        parsedCert,
-			},
+		// The session certificate is replaced when the session is extended.
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return p.sessionCertificate(), nil
 		},
 		ServerName: workerHost,
 		MinVersion: tls.VersionTLS13,
@@ -79,7 +81,6 @@ func (p *ClientProxy) clientTlsConfig(opt ...Option) (*tls.Config, error) {
 	// etc.
 	verifyOpts := x509.VerifyOptions{
 		DNSName: p.sessionAuthzData.SessionId,
-		Roots:   certPool,
 		KeyUsages: []x509.ExtKeyUsage{
 			x509.ExtKeyUsageClientAuth,
 			x509.ExtKeyUsageServerAuth,
@@ -91,7 +92,20 @@ func (p *ClientProxy) clientTlsConfig(opt ...Option) (*tls.Config, error) {
 		if len(cs.PeerCertificates) == 0 {
 			return fmt.Errorf("%s: no peer certificates provided", op)
 		}
-		_, err := cs.PeerCertificates[0].Verify(verifyOpts)
+		// The worker presents the current session certificate, which is
+		// reissued with a later expiration time when the session is extended,
+		// so it is accepted if it is for the session key, which the worker
+		// proves possession of in the handshake.
+		peerCert := cs.PeerCertificates[0]
+		peerKey, ok := peerCert.PublicKey.(ed25519.PublicKey)
+		if !ok || !peerKey.Equal(p.sessionCertificate().Leaf.PublicKey) {
+			return fmt.Errorf("%s: peer certificate does not match session certificate", op)
+		}
+		certPool := x509.NewCertPool()
+		certPool.AddCert(peerCert)
+		opts := verifyOpts
+		opts.Roots = certPool
+		_, err := peerCert.Verify(opts)
 		return err
 	}
 
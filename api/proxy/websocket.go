@@ -28,6 +28,7 @@ func (p *ClientProxy) getWsConn(ctx context.Context) (*websocket.Conn, error) {
 				Transport: p.transport,
 			},
 			Subprotocols: []string{consts.WebsocketProtocolTcpProxyV1},
+			HTTPHeader:   p.headers,
 		},
 	)
 	if err != nil {
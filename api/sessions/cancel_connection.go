@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// CancelConnection closes a single live connection of the session without
+// canceling the session itself. The worker proxying the connection terminates
+// it on its next status update.
+func (c *Client) CancelConnection(ctx context.Context, sessionId string, connectionId string, opt ...Option) (*SessionUpdateResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into CancelConnection request")
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("empty connectionId value passed into CancelConnection request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["connection_id"] = connectionId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("sessions/%s:cancel-connection", sessionId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CancelConnection request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CancelConnection call: %w", err)
+	}
+
+	target := new(SessionUpdateResult)
+	target.Item = new(Session)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CancelConnection response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
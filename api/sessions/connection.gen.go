@@ -4,12 +4,18 @@
 
 package sessions
 
+import (
+	"time"
+)
+
 type Connection struct {
-	ClientTcpAddress   string `json:"client_tcp_address,omitempty"`
-	ClientTcpPort      uint32 `json:"client_tcp_port,omitempty"`
-	EndpointTcpAddress string `json:"endpoint_tcp_address,omitempty"`
-	EndpointTcpPort    uint32 `json:"endpoint_tcp_port,omitempty"`
-	BytesUp            int64  `json:"bytes_up,string,omitempty"`
-	BytesDown          int64  `json:"bytes_down,string,omitempty"`
-	ClosedReason       string `json:"closed_reason,omitempty"`
+	Id                 string    `json:"id,omitempty"`
+	CreatedTime        time.Time `json:"created_time,omitempty"`
+	ClientTcpAddress   string    `json:"client_tcp_address,omitempty"`
+	ClientTcpPort      uint32    `json:"client_tcp_port,omitempty"`
+	EndpointTcpAddress string    `json:"endpoint_tcp_address,omitempty"`
+	EndpointTcpPort    uint32    `json:"endpoint_tcp_port,omitempty"`
+	BytesUp            int64     `json:"bytes_up,string,omitempty"`
+	BytesDown          int64     `json:"bytes_down,string,omitempty"`
+	ClosedReason       string    `json:"closed_reason,omitempty"`
 }
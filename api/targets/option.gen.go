@@ -127,6 +127,18 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithBandwidthLimit(inBandwidthLimit uint32) Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit"] = inBandwidthLimit
+	}
+}
+
+func DefaultBandwidthLimit() Option {
+	return func(o *options) {
+		o.postMap["bandwidth_limit"] = nil
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -139,6 +151,18 @@ func DefaultBrokeredCredentialSourceIds() Option {
 	}
 }
 
+func WithConnectionIdleTimeoutSeconds(inConnectionIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["connection_idle_timeout_seconds"] = inConnectionIdleTimeoutSeconds
+	}
+}
+
+func DefaultConnectionIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		o.postMap["connection_idle_timeout_seconds"] = nil
+	}
+}
+
 func WithSshTargetDefaultClientPort(inDefaultClientPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -259,6 +283,18 @@ func DefaultEgressWorkerFilter() Option {
 	}
 }
 
+func WithEnableHostHealthChecks(inEnableHostHealthChecks bool) Option {
+	return func(o *options) {
+		o.postMap["enable_host_health_checks"] = inEnableHostHealthChecks
+	}
+}
+
+func DefaultEnableHostHealthChecks() Option {
+	return func(o *options) {
+		o.postMap["enable_host_health_checks"] = nil
+	}
+}
+
 func WithSshTargetEnableSessionRecording(inEnableSessionRecording bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -277,6 +313,18 @@ func WithHostId(inHostId string) Option {
 	}
 }
 
+func WithHostSelectionStrategy(inHostSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = inHostSelectionStrategy
+	}
+}
+
+func DefaultHostSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = nil
+	}
+}
+
 func WithIngressWorkerFilter(inIngressWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["ingress_worker_filter"] = inIngressWorkerFilter
@@ -301,6 +349,18 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithMaxConcurrentConnections(inMaxConcurrentConnections uint32) Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_connections"] = inMaxConcurrentConnections
+	}
+}
+
+func DefaultMaxConcurrentConnections() Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_connections"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -325,6 +385,18 @@ func WithScopeName(inScopeName string) Option {
 	}
 }
 
+func WithSessionBandwidthLimit(inSessionBandwidthLimit uint32) Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit"] = inSessionBandwidthLimit
+	}
+}
+
+func DefaultSessionBandwidthLimit() Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit"] = nil
+	}
+}
+
 func WithSessionConnectionLimit(inSessionConnectionLimit int32) Option {
 	return func(o *options) {
 		o.postMap["session_connection_limit"] = inSessionConnectionLimit
@@ -337,6 +409,18 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithSessionMaxConcurrentConnections(inSessionMaxConcurrentConnections uint32) Option {
+	return func(o *options) {
+		o.postMap["session_max_concurrent_connections"] = inSessionMaxConcurrentConnections
+	}
+}
+
+func DefaultSessionMaxConcurrentConnections() Option {
+	return func(o *options) {
+		o.postMap["session_max_concurrent_connections"] = nil
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -384,3 +468,15 @@ func DefaultWorkerFilter() Option {
 		o.postMap["worker_filter"] = nil
 	}
 }
+
+func WithWorkerSelectionStrategy(inWorkerSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = inWorkerSelectionStrategy
+	}
+}
+
+func DefaultWorkerSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = nil
+	}
+}
@@ -34,6 +34,14 @@ type Target struct {
 	WorkerFilter                           string                 `json:"worker_filter,omitempty"`
 	EgressWorkerFilter                     string                 `json:"egress_worker_filter,omitempty"`
 	IngressWorkerFilter                    string                 `json:"ingress_worker_filter,omitempty"`
+	WorkerSelectionStrategy                string                 `json:"worker_selection_strategy,omitempty"`
+	HostSelectionStrategy                  string                 `json:"host_selection_strategy,omitempty"`
+	EnableHostHealthChecks                 bool                   `json:"enable_host_health_checks,omitempty"`
+	BandwidthLimit                         uint32                 `json:"bandwidth_limit,omitempty"`
+	SessionBandwidthLimit                  uint32                 `json:"session_bandwidth_limit,omitempty"`
+	MaxConcurrentConnections               uint32                 `json:"max_concurrent_connections,omitempty"`
+	SessionMaxConcurrentConnections        uint32                 `json:"session_max_concurrent_connections,omitempty"`
+	ConnectionIdleTimeoutSeconds           uint32                 `json:"connection_idle_timeout_seconds,omitempty"`
 	BrokeredCredentialSourceIds            []string               `json:"brokered_credential_source_ids,omitempty"`
 	BrokeredCredentialSources              []*CredentialSource    `json:"brokered_credential_sources,omitempty"`
 	InjectedApplicationCredentialSourceIds []string               `json:"injected_application_credential_source_ids,omitempty"`
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Drain requests that the worker with the given id stop accepting new sessions
+// and shut down once its active connections have closed.
+func (c *Client) Drain(ctx context.Context, workerId string, opt ...Option) (*WorkerUpdateResult, error) {
+	if workerId == "" {
+		return nil, fmt.Errorf("empty workerId value passed into Drain request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("workers/%s:drain", url.PathEscape(workerId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Drain request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Drain call: %w", err)
+	}
+
+	target := new(WorkerUpdateResult)
+	target.Item = new(Worker)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Drain response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -45,6 +45,7 @@ const (
 	EndpointField                               = "endpoint"
 	CertificateField                            = "certificate"
 	TerminationReasonField                      = "termination_reason"
+	KvSecretVersionChangedField                 = "kv_secret_version_changed"
 	StatusField                                 = "status"
 	StatesField                                 = "states"
 	SessionConnectionLimitField                 = "session_connection_limit"
@@ -52,6 +53,15 @@ const (
 	WorkerFilterField                           = "worker_filter"
 	EgressWorkerFilterField                     = "egress_worker_filter"
 	IngressWorkerFilterField                    = "ingress_worker_filter"
+	WorkerSelectionStrategyField                = "worker_selection_strategy"
+	HostSelectionStrategyField                  = "host_selection_strategy"
+	EnableHostHealthChecksField                 = "enable_host_health_checks"
+	BandwidthLimitField                         = "bandwidth_limit"
+	SessionBandwidthLimitField                  = "session_bandwidth_limit"
+	MaxConcurrentConnectionsField               = "max_concurrent_connections"
+	SessionMaxConcurrentConnectionsField        = "session_max_concurrent_connections"
+	ConnectionIdleTimeoutSecondsField           = "connection_idle_timeout_seconds"
+	HealthField                                 = "health"
 	AccountIdsField                             = "account_ids"
 	AccountsField                               = "accounts"
 	LoginNameField                              = "login_name"
@@ -100,6 +110,7 @@ const (
 	CompletedCountField                         = "completed_count"
 	TotalCountField                             = "total_count"
 	DirectlyConnectedDownstreamWorkersField     = "directly_connected_downstream_workers"
+	OperationalStateField                       = "operational_state"
 	AttributesAddressField                      = "attributes.address"
 	SecretsField                                = "secrets"
 	MimeTypeField                               = "mime_type"
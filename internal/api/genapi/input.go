@@ -500,6 +500,16 @@ var inputStructs = []*structInfo{
 		recursiveListing:    true,
 	},
 	// Credentials
+	{
+		inProto:     &credentialstores.VaultCredentialStoreHealth{},
+		outFile:     "credentialstores/vault_credential_store_health.gen.go",
+		subtypeName: "VaultCredentialStoreHealth",
+		fieldOverrides: []fieldInfo{
+			// Vault reachability is unknown until the store has been
+			// checked, which has to be told apart from unreachable.
+			{Name: "VaultReachable", FieldType: "*bool"},
+		},
+	},
 	{
 		inProto:        &credentialstores.VaultCredentialStoreAttributes{},
 		outFile:        "credentialstores/vault_credential_store_attributes.gen.go",
@@ -871,6 +881,11 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &hosts.HostHealth{},
+		outFile:     "hosts/host_health.gen.go",
+		subtypeName: "HostHealth",
+	},
 	{
 		inProto: &hosts.Host{},
 		outFile: "hosts/host.gen.go",
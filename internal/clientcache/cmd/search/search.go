@@ -4,15 +4,22 @@
 package search
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"net/url"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	daemoncmd "github.com/hashicorp/boundary/internal/clientcache/cmd/daemon"
@@ -31,6 +38,10 @@ var (
 	supportedResourceTypes = []string{
 		"targets",
 		"sessions",
+		"hosts",
+		"host-sets",
+		"scopes",
+		"session-recordings",
 	}
 
 	errDaemonNotRunning = stderrors.New("The deamon process is not running.")
@@ -40,6 +51,7 @@ type SearchCommand struct {
 	*base.Command
 	flagQuery        string
 	flagResource     string
+	flagColumns      []string
 	flagForceRefresh bool
 }
 
@@ -55,6 +67,11 @@ Usage: boundary search [options]
 
       $ boundary search -resource targets -query 'name="foo"'
 
+  Search for active sessions in a project created more than 2 hours ago,
+  showing only some of their fields:
+
+      $ boundary search -resource sessions -query 'status = "active" and scope_id = "p_1234567890" and created_time < "-2h" order by created_time' -columns id,target_id,created_time
+
   For a full list of examples, please see the documentation.
 
 ` + c.Flags().Help()
@@ -97,7 +114,7 @@ func (c *SearchCommand) Flags() *base.FlagSets {
 	f.StringVar(&base.StringVar{
 		Name:   "query",
 		Target: &c.flagQuery,
-		Usage:  `If set, specifies the resource search query. See https://www.boundaryproject.io/docs/commands/search for more information.`,
+		Usage:  `If set, specifies the resource search query. Queries compare fields using =, !=, <, <=, >, >=, % (contains) and like, combine them using and, or and not, and can end with order by, limit and offset clauses. See https://www.boundaryproject.io/docs/commands/search for more information.`,
 	})
 	f.StringVar(&base.StringVar{
 		Name:   "filter",
@@ -110,6 +127,11 @@ func (c *SearchCommand) Flags() *base.FlagSets {
 		Usage:      `Specifies the resource type to search over`,
 		Completion: complete.PredictSet(supportedResourceTypes...),
 	})
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "columns",
+		Target: &c.flagColumns,
+		Usage:  `If set, the table output only contains the provided comma separated fields of each resource, named as in the JSON output, for example "id,status,created_time".`,
+	})
 	f.BoolVar(&base.BoolVar{
 		Name:   "force-refresh",
 		Target: &c.flagForceRefresh,
@@ -163,10 +185,25 @@ func (c *SearchCommand) Run(args []string) int {
 		}
 	default:
 		switch {
+		case len(c.flagColumns) > 0:
+			out, err := printColumnsTable(result, c.flagColumns)
+			if err != nil {
+				c.PrintCliError(err)
+				return base.CommandCliError
+			}
+			c.UI.Output(out)
 		case len(result.Targets) > 0:
 			c.UI.Output(printTargetListTable(result.Targets))
 		case len(result.Sessions) > 0:
 			c.UI.Output(printSessionListTable(result.Sessions))
+		case len(result.Hosts) > 0:
+			c.UI.Output(printHostListTable(result.Hosts))
+		case len(result.HostSets) > 0:
+			c.UI.Output(printHostSetListTable(result.HostSets))
+		case len(result.Scopes) > 0:
+			c.UI.Output(printScopeListTable(result.Scopes))
+		case len(result.SessionRecordings) > 0:
+			c.UI.Output(printSessionRecordingListTable(result.SessionRecordings))
 		default:
 			c.UI.Output("No items found")
 		}
@@ -241,6 +278,55 @@ func search(ctx context.Context, daemonPath string, fb filterBy, opt ...client.O
 	return resp, res, nil, nil
 }
 
+// printColumnsTable prints the provided fields of each resource in the search
+// result as a table. The fields are named as in the JSON output.
+func printColumnsTable(result *daemon.SearchResult, columns []string) (string, error) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("Error when encoding search result: %w.", err)
+	}
+	// The search result only has the resources of a single type populated.
+	var byResource map[string][]map[string]any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&byResource); err != nil {
+		return "", fmt.Errorf("Error when decoding search result: %w.", err)
+	}
+	var items []map[string]any
+	for _, v := range byResource {
+		items = v
+	}
+	if len(items) == 0 {
+		return "No items found", nil
+	}
+
+	var out bytes.Buffer
+	tw := tabwriter.NewWriter(&out, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, item := range items {
+		vals := make([]string, 0, len(columns))
+		for _, col := range columns {
+			switch v := item[col].(type) {
+			case nil:
+				vals = append(vals, "")
+			case string:
+				vals = append(vals, v)
+			default:
+				b, err := json.Marshal(v)
+				if err != nil {
+					return "", fmt.Errorf("Error when encoding field %q: %w.", col, err)
+				}
+				vals = append(vals, string(b))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(vals, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
 func printTargetListTable(items []*targets.Target) string {
 	if len(items) == 0 {
 		return "No targets found"
@@ -372,6 +458,278 @@ func printSessionListTable(items []*sessions.Session) string {
 	return base.WrapForHelpText(output)
 }
 
+func printHostListTable(items []*hosts.Host) string {
+	if len(items) == 0 {
+		return "No hosts found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Host information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Scope != nil && item.Scope.Id != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.Scope.Id),
+			)
+		}
+		if item.HostCatalogId != "" {
+			output = append(output,
+				fmt.Sprintf("    Host Catalog ID:     %s", item.HostCatalogId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if item.ExternalId != "" {
+			output = append(output,
+				fmt.Sprintf("    External ID:         %s", item.ExternalId),
+			)
+		}
+		if item.ExternalName != "" {
+			output = append(output,
+				fmt.Sprintf("    External Name:       %s", item.ExternalName),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printHostSetListTable(items []*hostsets.HostSet) string {
+	if len(items) == 0 {
+		return "No host sets found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Host Set information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Scope != nil && item.Scope.Id != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.Scope.Id),
+			)
+		}
+		if item.HostCatalogId != "" {
+			output = append(output,
+				fmt.Sprintf("    Host Catalog ID:     %s", item.HostCatalogId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printScopeListTable(items []*scopes.Scope) string {
+	if len(items) == 0 {
+		return "No scopes found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Scope information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printSessionRecordingListTable(items []*sessionrecordings.SessionRecording) string {
+	if len(items) == 0 {
+		return "No session recordings found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Session Recording information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if item.Scope != nil && item.Scope.Id != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.Scope.Id),
+			)
+		}
+		if item.SessionId != "" {
+			output = append(output,
+				fmt.Sprintf("    Session ID:          %s", item.SessionId),
+			)
+		}
+		if item.StorageBucketId != "" {
+			output = append(output,
+				fmt.Sprintf("    Storage Bucket ID:   %s", item.StorageBucketId),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", item.Type),
+			)
+		}
+		if item.State != "" {
+			output = append(output,
+				fmt.Sprintf("    State:               %s", item.State),
+			)
+		}
+		if item.Endpoint != "" {
+			output = append(output,
+				fmt.Sprintf("    Endpoint:            %s", item.Endpoint),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.StartTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Start Time:          %s", item.StartTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.EndTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    End Time:            %s", item.EndTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 type filterBy struct {
 	flagFilter   string
 	flagQuery    string
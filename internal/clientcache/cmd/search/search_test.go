@@ -90,7 +90,7 @@ func TestSearch(t *testing.T) {
 			fb: filterBy{
 				authTokenId: at.Id,
 				flagQuery:   "name=name",
-				resource:    "hosts",
+				resource:    "workers",
 			},
 			apiErrContains: "provided resource is not a valid searchable resource",
 		},
@@ -318,4 +318,32 @@ func TestSearch(t *testing.T) {
 		assert.NotNil(t, r)
 		assert.Len(t, r.Sessions, 1)
 	})
+	t.Run("ordered and limited session response from query", func(t *testing.T) {
+		resp, r, apiErr, err := search(ctx, srv.BaseDotDir(), filterBy{
+			authTokenId: at.Id,
+			flagQuery:   "id % 'sess' order by id desc limit 1",
+			resource:    "sessions",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+		assert.NotNil(t, resp)
+		require.NotNil(t, r)
+		require.Len(t, r.Sessions, 1)
+		assert.Equal(t, "sess_1234567890", r.Sessions[0].Id)
+	})
+}
+
+func TestPrintColumnsTable(t *testing.T) {
+	got, err := printColumnsTable(&daemon.SearchResult{
+		Sessions: []*sessions.Session{
+			{Id: "s_1", Status: "active", Version: 2},
+			{Id: "s_22", Status: "pending"},
+		},
+	}, []string{"id", "status", "version"})
+	require.NoError(t, err)
+	assert.Equal(t, "id    status   version\ns_1   active   2\ns_22  pending  ", got)
+
+	got, err = printColumnsTable(&daemon.SearchResult{}, []string{"id"})
+	require.NoError(t, err)
+	assert.Equal(t, "No items found", got)
 }
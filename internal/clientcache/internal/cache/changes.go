@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// changeSubscriberBufferSize is the number of changes which can be queued for
+// a subscriber before it is considered too slow and is unsubscribed.
+const changeSubscriberBufferSize = 512
+
+// ChangeType describes how a cached resource changed when it was refreshed.
+type ChangeType string
+
+const (
+	ResourceAdded   ChangeType = "added"
+	ResourceUpdated ChangeType = "updated"
+	ResourceRemoved ChangeType = "removed"
+)
+
+// ResourceChange is a change made to a single cached resource when the
+// resources for a user were refreshed.
+type ResourceChange struct {
+	UserId   string
+	Resource SearchableResource
+	Type     ChangeType
+	Id       string
+	// Item is the json encoded resource as returned by boundary. For removed
+	// resources it is the last value which was cached.
+	Item string
+}
+
+// ChangeNotifier sends the changes made to cached resources to the
+// subscribers for the user whose resources changed. A ChangeNotifier can be
+// shared by multiple repositories operating on the same backing data.
+type ChangeNotifier struct {
+	mu   sync.Mutex
+	subs map[*changeSubscriber]struct{}
+}
+
+type changeSubscriber struct {
+	userId string
+	ch     chan ResourceChange
+}
+
+// NewChangeNotifier returns a ChangeNotifier with no subscribers.
+func NewChangeNotifier() *ChangeNotifier {
+	return &ChangeNotifier{
+		subs: make(map[*changeSubscriber]struct{}),
+	}
+}
+
+// Subscribe returns a channel on which the changes to the provided user's
+// cached resources are sent. The channel is closed when the provided context
+// is done, or when the subscriber falls too far behind in receiving changes.
+// In the latter case the subscriber should subscribe again and search the
+// cache to catch up on any changes it missed.
+func (n *ChangeNotifier) Subscribe(ctx context.Context, userId string) (<-chan ResourceChange, error) {
+	const op = "cache.(ChangeNotifier).Subscribe"
+	switch {
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
+	case ctx.Err() != nil:
+		return nil, errors.Wrap(ctx, ctx.Err(), op)
+	}
+	s := &changeSubscriber{
+		userId: userId,
+		ch:     make(chan ResourceChange, changeSubscriberBufferSize),
+	}
+	n.mu.Lock()
+	n.subs[s] = struct{}{}
+	n.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		n.unsubscribe(s)
+	}()
+	return s.ch, nil
+}
+
+// unsubscribe removes the subscriber and closes its channel. The caller must
+// hold the lock.
+func (n *ChangeNotifier) unsubscribe(s *changeSubscriber) {
+	if _, ok := n.subs[s]; !ok {
+		return
+	}
+	delete(n.subs, s)
+	close(s.ch)
+}
+
+// publish sends the provided changes to the subscribers for the changed
+// resource's user. It never blocks; a subscriber which cannot receive a
+// change is unsubscribed. It is a no-op when called on a nil ChangeNotifier.
+func (n *ChangeNotifier) publish(changes []ResourceChange) {
+	if n == nil || len(changes) == 0 {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, c := range changes {
+		for s := range n.subs {
+			if s.userId != c.UserId {
+				continue
+			}
+			select {
+			case s.ch <- c:
+			default:
+				n.unsubscribe(s)
+			}
+		}
+	}
+}
+
+// hasSubscribers reports whether anyone is subscribed to the changes to the
+// provided user's cached resources. It returns false when called on a nil
+// ChangeNotifier.
+func (n *ChangeNotifier) hasSubscribers(userId string) bool {
+	if n == nil {
+		return false
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for s := range n.subs {
+		if s.userId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// cachedItems returns the item of each resource cached in the provided table
+// for the user keyed by the resource's id. Since it reads every resource of
+// the user, it should only be called when the changes are published, as
+// reported by ChangeNotifier.hasSubscribers.
+func (r *Repository) cachedItems(ctx context.Context, reader db.Reader, table string, u *user) (map[string]string, error) {
+	const op = "cache.(Repository).cachedItems"
+	rows, err := reader.Query(ctx, fmt.Sprintf("select id, item from %s where fk_user_id = @fk_user_id", table),
+		[]any{sql.Named("fk_user_id", u.Id)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	ret := make(map[string]string)
+	for rows.Next() {
+		var id, item string
+		if err := rows.Scan(&id, &item); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret[id] = item
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// diffItems returns the changes needed to go from the before to the after
+// cached items, as returned by cachedItems, ordered by resource id.
+func diffItems(userId string, resource SearchableResource, before, after map[string]string) []ResourceChange {
+	var ret []ResourceChange
+	for id, item := range after {
+		old, ok := before[id]
+		switch {
+		case !ok:
+			ret = append(ret, ResourceChange{UserId: userId, Resource: resource, Type: ResourceAdded, Id: id, Item: item})
+		case old != item:
+			ret = append(ret, ResourceChange{UserId: userId, Resource: resource, Type: ResourceUpdated, Id: id, Item: item})
+		}
+	}
+	for id, item := range before {
+		if _, ok := after[id]; !ok {
+			ret = append(ret, ResourceChange{UserId: userId, Resource: resource, Type: ResourceRemoved, Id: id, Item: item})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Id < ret[j].Id })
+	return ret
+}
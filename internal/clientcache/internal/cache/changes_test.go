@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/api/targets"
+	cachedb "github.com/hashicorp/boundary/internal/clientcache/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// receiveChanges returns the changes which are already queued on the channel.
+func receiveChanges(t *testing.T, ch <-chan ResourceChange) []ResourceChange {
+	t.Helper()
+	var ret []ResourceChange
+	for {
+		select {
+		case c, ok := <-ch:
+			require.True(t, ok, "change channel was closed")
+			ret = append(ret, c)
+		default:
+			return ret
+		}
+	}
+}
+
+func TestChangeNotifier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing user id", func(t *testing.T) {
+		n := NewChangeNotifier()
+		ch, err := n.Subscribe(ctx, "")
+		assert.Nil(t, ch)
+		assert.ErrorContains(t, err, "user id is missing")
+	})
+
+	t.Run("only the user's changes", func(t *testing.T) {
+		n := NewChangeNotifier()
+		ch1, err := n.Subscribe(ctx, "u1")
+		require.NoError(t, err)
+		ch2, err := n.Subscribe(ctx, "u2")
+		require.NoError(t, err)
+
+		c := ResourceChange{UserId: "u1", Resource: Targets, Type: ResourceAdded, Id: "t_1"}
+		n.publish([]ResourceChange{c})
+		assert.Equal(t, []ResourceChange{c}, receiveChanges(t, ch1))
+		assert.Empty(t, receiveChanges(t, ch2))
+	})
+
+	t.Run("closed when context is done", func(t *testing.T) {
+		n := NewChangeNotifier()
+		ctx, cancel := context.WithCancel(ctx)
+		ch, err := n.Subscribe(ctx, "u1")
+		require.NoError(t, err)
+		cancel()
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(5 * time.Second):
+			t.Fatal("change channel was not closed")
+		}
+	})
+
+	t.Run("slow subscriber is closed", func(t *testing.T) {
+		n := NewChangeNotifier()
+		ch, err := n.Subscribe(ctx, "u1")
+		require.NoError(t, err)
+		changes := make([]ResourceChange, changeSubscriberBufferSize+1)
+		for i := range changes {
+			changes[i] = ResourceChange{UserId: "u1", Resource: Sessions, Type: ResourceUpdated, Id: "s_1"}
+		}
+		n.publish(changes)
+
+		var received int
+		for range ch {
+			received++
+		}
+		assert.Equal(t, changeSubscriberBufferSize, received)
+		assert.Empty(t, n.subs)
+	})
+
+	t.Run("has subscribers", func(t *testing.T) {
+		n := NewChangeNotifier()
+		assert.False(t, n.hasSubscribers("u1"))
+		ctx, cancel := context.WithCancel(ctx)
+		ch, err := n.Subscribe(ctx, "u1")
+		require.NoError(t, err)
+		assert.True(t, n.hasSubscribers("u1"))
+		assert.False(t, n.hasSubscribers("u2"))
+
+		cancel()
+		for range ch {
+		}
+		assert.False(t, n.hasSubscribers("u1"))
+	})
+
+	t.Run("nil notifier", func(t *testing.T) {
+		var n *ChangeNotifier
+		assert.NotPanics(t, func() {
+			n.publish([]ResourceChange{{UserId: "u1"}})
+		})
+		assert.False(t, n.hasSubscribers("u1"))
+	})
+}
+
+func TestDiffItems(t *testing.T) {
+	before := map[string]string{
+		"1": "one",
+		"2": "two",
+		"3": "three",
+	}
+	after := map[string]string{
+		"2": "two",
+		"3": "new three",
+		"4": "four",
+	}
+	assert.Equal(t, []ResourceChange{
+		{UserId: "u", Resource: Targets, Type: ResourceRemoved, Id: "1", Item: "one"},
+		{UserId: "u", Resource: Targets, Type: ResourceUpdated, Id: "3", Item: "new three"},
+		{UserId: "u", Resource: Targets, Type: ResourceAdded, Id: "4", Item: "four"},
+	}, diffItems("u", Targets, before, after))
+	assert.Empty(t, diffItems("u", Targets, nil, nil))
+}
+
+func TestRepository_RefreshPublishesChanges(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u.Id,
+	}
+	kt := KeyringToken{
+		KeyringType: "keyring",
+		TokenName:   "token",
+		AuthTokenId: at.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{kt.KeyringType, kt.TokenName}: at,
+	}
+	n := NewChangeNotifier()
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)), WithChangeNotifier(n))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt))
+
+	// Refreshing while no one is subscribed to the user's changes doesn't
+	// publish them.
+	retTargets := [][]*targets.Target{{target("1")}, {target("2")}}
+	require.NoError(t, r.refreshTargets(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithTargetRetrievalFunc(testStaticResourceRetrievalFunc(t, retTargets, [][]string{nil, nil}))))
+
+	userCh, err := n.Subscribe(ctx, u.Id)
+	require.NoError(t, err)
+	otherCh, err := n.Subscribe(ctx, "u2")
+	require.NoError(t, err)
+
+	terminated := session("2")
+	terminated.Status = "terminated"
+	ret := [][]*sessions.Session{
+		{session("1"), session("2")},
+		{terminated, session("3")},
+	}
+	removed := [][]string{
+		nil,
+		{ret[0][0].Id},
+	}
+	item := func(s *sessions.Session) string {
+		b, err := json.Marshal(s)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	require.NoError(t, r.refreshSessions(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithSessionRetrievalFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+	assert.Equal(t, []ResourceChange{
+		{UserId: u.Id, Resource: Sessions, Type: ResourceAdded, Id: "session_1", Item: item(ret[0][0])},
+		{UserId: u.Id, Resource: Sessions, Type: ResourceAdded, Id: "session_2", Item: item(ret[0][1])},
+	}, receiveChanges(t, userCh))
+
+	require.NoError(t, r.refreshSessions(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithSessionRetrievalFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+	assert.Equal(t, []ResourceChange{
+		{UserId: u.Id, Resource: Sessions, Type: ResourceRemoved, Id: "session_1", Item: item(ret[0][0])},
+		{UserId: u.Id, Resource: Sessions, Type: ResourceUpdated, Id: "session_2", Item: item(terminated)},
+		{UserId: u.Id, Resource: Sessions, Type: ResourceAdded, Id: "session_3", Item: item(ret[1][1])},
+	}, receiveChanges(t, userCh))
+
+	// Refreshing with nothing new doesn't publish any changes
+	require.NoError(t, r.refreshSessions(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithSessionRetrievalFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+	assert.Empty(t, receiveChanges(t, userCh))
+
+	// Only the changes made since subscribing are published.
+	require.NoError(t, r.refreshTargets(ctx, u, map[AuthToken]string{{Id: "id"}: "something"},
+		WithTargetRetrievalFunc(testStaticResourceRetrievalFunc(t, retTargets, [][]string{nil, nil}))))
+	b, err := json.Marshal(retTargets[1][0])
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceChange{
+		{UserId: u.Id, Resource: Targets, Type: ResourceAdded, Id: "target_2", Item: string(b)},
+	}, receiveChanges(t, userCh))
+
+	assert.Empty(t, receiveChanges(t, otherCh))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/exp/maps"
+)
+
+// hostCatalogListFunc lists the resources in a single host catalog using the
+// provided list token and returns the listed items, the ids removed since the
+// list token was issued, the new list token and the response type.
+type hostCatalogListFunc[T any] func(ctx context.Context, hostCatalogId, listToken string) (ret []T, removedIds []string, newListToken string, responseType string, err error)
+
+// hostCatalogRefreshToken is the refresh token of resources which are listed
+// one host catalog at a time.
+type hostCatalogRefreshToken struct {
+	// HostCatalogs is the list token returned when listing the host catalogs.
+	HostCatalogs string `json:"host_catalogs"`
+	// ListTokens maps the id of each host catalog to the list token returned
+	// when listing the resources in that catalog.
+	ListTokens map[string]string `json:"list_tokens"`
+}
+
+// listPerHostCatalog lists resources, such as hosts and host sets, which can
+// only be listed one host catalog at a time. The returned refresh token holds
+// the list token of the host catalogs and of the resources in each catalog,
+// so that both the catalogs and the resources in them are refreshed
+// incrementally. If a host catalog which is in the provided refresh token has
+// been removed the ids of its resources cannot be reported as removed, so
+// api.ErrInvalidListToken is returned to force a full refresh.
+//
+// Host catalogs whose authorized collection actions do not allow listing the
+// collection are skipped, as are host catalogs which cannot be listed because
+// they are not found or permission is denied, so that a single such catalog
+// does not fail the refresh of all the others. If such a catalog is in the
+// provided refresh token its cached resources must be removed, so
+// api.ErrInvalidListToken is returned to force a full refresh which skips it.
+func listPerHostCatalog[T any](ctx context.Context, client *api.Client, collection string, refreshTok RefreshTokenValue, list hostCatalogListFunc[T]) ([]T, []string, RefreshTokenValue, error) {
+	const op = "cache.listPerHostCatalog"
+	var oldTok hostCatalogRefreshToken
+	if refreshTok != "" {
+		if err := json.Unmarshal([]byte(refreshTok), &oldTok); err != nil || oldTok.HostCatalogs == "" {
+			return nil, nil, "", api.ErrInvalidListToken
+		}
+	}
+
+	hcl, err := hostcatalogs.NewClient(client).List(ctx, "global", hostcatalogs.WithRecursive(true), hostcatalogs.WithListToken(oldTok.HostCatalogs))
+	if err != nil {
+		if api.ErrInvalidListToken.Is(err) {
+			return nil, nil, "", err
+		}
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	if hcl.ResponseType == "" {
+		return nil, nil, "", ErrRefreshNotSupported
+	}
+
+	// Only the host catalogs created or updated since the host catalog list
+	// token was issued are listed, so the others are taken from the refresh
+	// token.
+	newTok := hostCatalogRefreshToken{
+		HostCatalogs: hcl.ListToken,
+		ListTokens:   make(map[string]string, len(oldTok.ListTokens)+len(hcl.Items)),
+	}
+	for id, listToken := range oldTok.ListTokens {
+		newTok.ListTokens[id] = listToken
+	}
+	for _, hc := range hcl.Items {
+		if !slices.Contains(hc.AuthorizedCollectionActions[collection], "list") {
+			if _, ok := oldTok.ListTokens[hc.Id]; ok {
+				return nil, nil, "", api.ErrInvalidListToken
+			}
+			continue
+		}
+		if _, ok := newTok.ListTokens[hc.Id]; !ok {
+			newTok.ListTokens[hc.Id] = ""
+		}
+	}
+	for _, id := range hcl.RemovedIds {
+		if _, ok := oldTok.ListTokens[id]; ok {
+			return nil, nil, "", api.ErrInvalidListToken
+		}
+		delete(newTok.ListTokens, id)
+	}
+
+	hostCatalogIds := maps.Keys(newTok.ListTokens)
+	slices.Sort(hostCatalogIds)
+	var ret []T
+	var removedIds []string
+	for _, id := range hostCatalogIds {
+		items, removed, listToken, responseType, err := list(ctx, id, newTok.ListTokens[id])
+		if err != nil {
+			switch {
+			case api.ErrInvalidListToken.Is(err):
+				return nil, nil, "", err
+			case api.ErrNotFound.Is(err), api.ErrPermissionDenied.Is(err):
+				if _, ok := oldTok.ListTokens[id]; ok {
+					return nil, nil, "", api.ErrInvalidListToken
+				}
+				delete(newTok.ListTokens, id)
+				continue
+			}
+			return nil, nil, "", errors.Wrap(ctx, err, op)
+		}
+		if responseType == "" {
+			return nil, nil, "", ErrRefreshNotSupported
+		}
+		ret = append(ret, items...)
+		removedIds = append(removedIds, removed...)
+		newTok.ListTokens[id] = listToken
+	}
+
+	newRefreshTok, err := json.Marshal(newTok)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	return ret, removedIds, RefreshTokenValue(newRefreshTok), nil
+}
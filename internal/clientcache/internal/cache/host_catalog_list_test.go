@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPerHostCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	// The server lists all the host catalogs without a list token, and only
+	// the changed and removed ones with a list token.
+	catalogIds := []string{"hc_1", "hc_2"}
+	var changedIds, removedCatalogIds, unlistableIds []string
+	responseType := "complete"
+	var gotCatalogListToken string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/host-catalogs", r.URL.Path)
+		gotCatalogListToken = r.URL.Query().Get("list_token")
+		l := hostcatalogs.HostCatalogListResult{ResponseType: responseType, ListToken: "hc_list_token"}
+		ids := catalogIds
+		if gotCatalogListToken != "" {
+			ids = changedIds
+			l.RemovedIds = removedCatalogIds
+		}
+		for _, id := range ids {
+			hc := &hostcatalogs.HostCatalog{Id: id, AuthorizedCollectionActions: map[string][]string{"hosts": {"create", "list"}}}
+			if slices.Contains(unlistableIds, id) {
+				hc.AuthorizedCollectionActions = map[string][]string{"hosts": {"create"}}
+			}
+			l.Items = append(l.Items, hc)
+		}
+		b, err := json.Marshal(l)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(&api.Config{Addr: srv.URL})
+	require.NoError(t, err)
+
+	// listFn returns a single host per catalog and a list token which is the
+	// catalog id followed by the number of times it has been listed.
+	gotListTokens := make(map[string]string)
+	listErrs := make(map[string]error)
+	listFn := func(_ context.Context, hostCatalogId, listToken string) ([]*hosts.Host, []string, string, string, error) {
+		gotListTokens[hostCatalogId] = listToken
+		if err := listErrs[hostCatalogId]; err != nil {
+			return nil, nil, "", "", err
+		}
+		var removed []string
+		if listToken != "" {
+			removed = []string{"removed_" + hostCatalogId}
+		}
+		return []*hosts.Host{{Id: "host_" + hostCatalogId, HostCatalogId: hostCatalogId}}, removed, hostCatalogId + "_" + listToken + "x", "complete", nil
+	}
+
+	got, removed, refTok, err := listPerHostCatalog(ctx, client, "hosts", "", listFn)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Empty(t, removed)
+	assert.Empty(t, gotCatalogListToken)
+	assert.Equal(t, map[string]string{"hc_1": "", "hc_2": ""}, gotListTokens)
+
+	// The host catalog list token and the per catalog list tokens are passed
+	// back in on the next list, and catalogs which did not change are still
+	// listed.
+	got, removed, refTok, err = listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.ElementsMatch(t, []string{"removed_hc_1", "removed_hc_2"}, removed)
+	assert.Equal(t, "hc_list_token", gotCatalogListToken)
+	assert.Equal(t, map[string]string{"hc_1": "hc_1_x", "hc_2": "hc_2_x"}, gotListTokens)
+
+	t.Run("new catalog", func(t *testing.T) {
+		changedIds = []string{"hc_3"}
+		t.Cleanup(func() { changedIds = nil })
+		got, _, tok, err := listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+		require.NoError(t, err)
+		assert.Len(t, got, 3)
+		assert.Equal(t, map[string]string{"hc_1": "hc_1_hc_1_xx", "hc_2": "hc_2_hc_2_xx", "hc_3": ""}, gotListTokens)
+
+		var listTokens hostCatalogRefreshToken
+		require.NoError(t, json.Unmarshal([]byte(tok), &listTokens))
+		assert.Equal(t, "hc_list_token", listTokens.HostCatalogs)
+		assert.Equal(t, "hc_3_x", listTokens.ListTokens["hc_3"])
+	})
+
+	t.Run("updated catalog", func(t *testing.T) {
+		changedIds = []string{"hc_1"}
+		t.Cleanup(func() { changedIds = nil })
+		got, _, _, err := listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, "hc_1_hc_1_xx", gotListTokens["hc_1"])
+	})
+
+	t.Run("removed catalog", func(t *testing.T) {
+		removedCatalogIds = []string{"hc_2"}
+		t.Cleanup(func() { removedCatalogIds = nil })
+		_, _, _, err := listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+		assert.True(t, api.ErrInvalidListToken.Is(err))
+	})
+
+	t.Run("removed unknown catalog", func(t *testing.T) {
+		removedCatalogIds = []string{"hc_4"}
+		t.Cleanup(func() { removedCatalogIds = nil })
+		got, _, _, err := listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+	})
+
+	t.Run("forbidden catalog", func(t *testing.T) {
+		catalogIds = []string{"hc_1", "hc_2", "hc_3"}
+		listErrs["hc_3"] = api.ErrPermissionDenied
+		t.Cleanup(func() {
+			catalogIds = []string{"hc_1", "hc_2"}
+			delete(listErrs, "hc_3")
+		})
+
+		// A full refresh skips the catalog and lists the others.
+		got, _, tok, err := listPerHostCatalog(ctx, client, "hosts", "", listFn)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		var listTokens hostCatalogRefreshToken
+		require.NoError(t, json.Unmarshal([]byte(tok), &listTokens))
+		assert.Equal(t, map[string]string{"hc_1": "hc_1_x", "hc_2": "hc_2_x"}, listTokens.ListTokens)
+
+		// A catalog whose hosts were cached and can no longer be listed
+		// forces a full refresh so that its hosts are removed.
+		changedIds = []string{"hc_2"}
+		listErrs["hc_2"] = api.ErrNotFound
+		t.Cleanup(func() {
+			changedIds = nil
+			delete(listErrs, "hc_2")
+		})
+		_, _, _, err = listPerHostCatalog(ctx, client, "hosts", tok, listFn)
+		assert.True(t, api.ErrInvalidListToken.Is(err))
+	})
+
+	t.Run("catalog without list action", func(t *testing.T) {
+		catalogIds = []string{"hc_1", "hc_2", "hc_3"}
+		unlistableIds = []string{"hc_3"}
+		t.Cleanup(func() {
+			catalogIds = []string{"hc_1", "hc_2"}
+			unlistableIds = nil
+		})
+		delete(gotListTokens, "hc_3")
+		got, _, _, err := listPerHostCatalog(ctx, client, "hosts", "", listFn)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.NotContains(t, gotListTokens, "hc_3")
+
+		changedIds = []string{"hc_1"}
+		unlistableIds = []string{"hc_1"}
+		t.Cleanup(func() { changedIds = nil })
+		_, _, _, err = listPerHostCatalog(ctx, client, "hosts", refTok, listFn)
+		assert.True(t, api.ErrInvalidListToken.Is(err))
+	})
+
+	t.Run("malformed refresh token", func(t *testing.T) {
+		_, _, _, err := listPerHostCatalog(ctx, client, "hosts", "not json", listFn)
+		assert.True(t, api.ErrInvalidListToken.Is(err))
+		_, _, _, err = listPerHostCatalog(ctx, client, "hosts", `{"hc_1":"hc_1_x"}`, listFn)
+		assert.True(t, api.ErrInvalidListToken.Is(err))
+	})
+
+	t.Run("refresh not supported", func(t *testing.T) {
+		responseType = ""
+		t.Cleanup(func() { responseType = "complete" })
+		_, _, _, err := listPerHostCatalog(ctx, client, "hosts", "", listFn)
+		assert.ErrorIs(t, err, ErrRefreshNotSupported)
+	})
+}
@@ -8,13 +8,22 @@ import (
 )
 
 type options struct {
-	withUpdateLastAccessedTime bool
-	withDbType                 dbw.DbType
-	withAuthTokenId            string
-	withUserId                 string
-	withTargetRetrievalFunc    TargetRetrievalFunc
-	withSessionRetrievalFunc   SessionRetrievalFunc
-	withIgnoreSearchStaleness  bool
+	withUpdateLastAccessedTime        bool
+	withDbType                        dbw.DbType
+	withAuthTokenId                   string
+	withUserId                        string
+	withTargetRetrievalFunc           TargetRetrievalFunc
+	withSessionRetrievalFunc          SessionRetrievalFunc
+	withHostRetrievalFunc             HostRetrievalFunc
+	withHostSetRetrievalFunc          HostSetRetrievalFunc
+	withScopeRetrievalFunc            ScopeRetrievalFunc
+	withSessionRecordingRetrievalFunc SessionRecordingRetrievalFunc
+	withIgnoreSearchStaleness         bool
+	withChangeNotifier                *ChangeNotifier
+	withOrder                         string
+	withLimit                         int
+	withOffset                        int
+	withFilter                        func(item any) bool
 }
 
 // Option - how options are passed as args
@@ -77,6 +86,39 @@ func WithSessionRetrievalFunc(fn SessionRetrievalFunc) Option {
 	}
 }
 
+// WithHostRetrievalFunc provides an option for specifying a hostRetrievalFunc
+func WithHostRetrievalFunc(fn HostRetrievalFunc) Option {
+	return func(o *options) error {
+		o.withHostRetrievalFunc = fn
+		return nil
+	}
+}
+
+// WithHostSetRetrievalFunc provides an option for specifying a hostSetRetrievalFunc
+func WithHostSetRetrievalFunc(fn HostSetRetrievalFunc) Option {
+	return func(o *options) error {
+		o.withHostSetRetrievalFunc = fn
+		return nil
+	}
+}
+
+// WithScopeRetrievalFunc provides an option for specifying a scopeRetrievalFunc
+func WithScopeRetrievalFunc(fn ScopeRetrievalFunc) Option {
+	return func(o *options) error {
+		o.withScopeRetrievalFunc = fn
+		return nil
+	}
+}
+
+// WithSessionRecordingRetrievalFunc provides an option for specifying a
+// sessionRecordingRetrievalFunc
+func WithSessionRecordingRetrievalFunc(fn SessionRecordingRetrievalFunc) Option {
+	return func(o *options) error {
+		o.withSessionRecordingRetrievalFunc = fn
+		return nil
+	}
+}
+
 // WithIgnoreSearchStaleness provides an option for ignoring the resource
 // staleness when performing a search.
 func WithIgnoreSearchStaleness(b bool) Option {
@@ -85,3 +127,45 @@ func WithIgnoreSearchStaleness(b bool) Option {
 		return nil
 	}
 }
+
+// WithChangeNotifier provides an option for specifying the ChangeNotifier a
+// repository sends the changes made to cached resources to.
+func WithChangeNotifier(n *ChangeNotifier) Option {
+	return func(o *options) error {
+		o.withChangeNotifier = n
+		return nil
+	}
+}
+
+// withOrder provides an option for the order by clause used when searching
+func withOrder(order string) Option {
+	return func(o *options) error {
+		o.withOrder = order
+		return nil
+	}
+}
+
+// withLimit provides an option for limiting the number of search results
+func withLimit(limit int) Option {
+	return func(o *options) error {
+		o.withLimit = limit
+		return nil
+	}
+}
+
+// withOffset provides an option for skipping the first search results
+func withOffset(offset int) Option {
+	return func(o *options) error {
+		o.withOffset = offset
+		return nil
+	}
+}
+
+// withFilter provides an option for filtering search results before the limit
+// and offset are applied
+func withFilter(f func(item any) bool) Option {
+	return func(o *options) error {
+		o.withFilter = f
+		return nil
+	}
+}
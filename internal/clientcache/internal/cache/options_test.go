@@ -7,6 +7,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/go-dbw"
@@ -72,6 +76,58 @@ func Test_GetOpts(t *testing.T) {
 		testOpts := getDefaultOptions()
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithHostRetrievalFunc", func(t *testing.T) {
+		var f HostRetrievalFunc = func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*hosts.Host, []string, RefreshTokenValue, error) {
+			return nil, nil, "", nil
+		}
+		opts, err := getOpts(WithHostRetrievalFunc(f))
+		require.NoError(t, err)
+
+		assert.NotNil(t, opts.withHostRetrievalFunc)
+		opts.withHostRetrievalFunc = nil
+
+		testOpts := getDefaultOptions()
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithHostSetRetrievalFunc", func(t *testing.T) {
+		var f HostSetRetrievalFunc = func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*hostsets.HostSet, []string, RefreshTokenValue, error) {
+			return nil, nil, "", nil
+		}
+		opts, err := getOpts(WithHostSetRetrievalFunc(f))
+		require.NoError(t, err)
+
+		assert.NotNil(t, opts.withHostSetRetrievalFunc)
+		opts.withHostSetRetrievalFunc = nil
+
+		testOpts := getDefaultOptions()
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithScopeRetrievalFunc", func(t *testing.T) {
+		var f ScopeRetrievalFunc = func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*scopes.Scope, []string, RefreshTokenValue, error) {
+			return nil, nil, "", nil
+		}
+		opts, err := getOpts(WithScopeRetrievalFunc(f))
+		require.NoError(t, err)
+
+		assert.NotNil(t, opts.withScopeRetrievalFunc)
+		opts.withScopeRetrievalFunc = nil
+
+		testOpts := getDefaultOptions()
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSessionRecordingRetrievalFunc", func(t *testing.T) {
+		var f SessionRecordingRetrievalFunc = func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*sessionrecordings.SessionRecording, []string, RefreshTokenValue, error) {
+			return nil, nil, "", nil
+		}
+		opts, err := getOpts(WithSessionRecordingRetrievalFunc(f))
+		require.NoError(t, err)
+
+		assert.NotNil(t, opts.withSessionRecordingRetrievalFunc)
+		opts.withSessionRecordingRetrievalFunc = nil
+
+		testOpts := getDefaultOptions()
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("withIgnoreSearchStaleness", func(t *testing.T) {
 		opts, err := getOpts(WithIgnoreSearchStaleness(true))
 		require.NoError(t, err)
@@ -79,4 +135,40 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withIgnoreSearchStaleness = true
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithChangeNotifier", func(t *testing.T) {
+		n := NewChangeNotifier()
+		opts, err := getOpts(WithChangeNotifier(n))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.withChangeNotifier = n
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withOrder", func(t *testing.T) {
+		opts, err := getOpts(withOrder("name desc"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.withOrder = "name desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withLimit", func(t *testing.T) {
+		opts, err := getOpts(withLimit(5))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withOffset", func(t *testing.T) {
+		opts, err := getOpts(withOffset(5))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.withOffset = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withFilter", func(t *testing.T) {
+		opts, err := getOpts(withFilter(func(item any) bool { return item == "match" }))
+		require.NoError(t, err)
+		require.NotNil(t, opts.withFilter)
+		assert.True(t, opts.withFilter("match"))
+		assert.False(t, opts.withFilter("other"))
+	})
 }
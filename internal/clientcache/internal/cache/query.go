@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A search query is made up of an optional condition followed by optional
+// order by, limit and offset clauses, for example:
+//
+//	status = "active" and not scope_id = "p_1234567890" order by created_time desc limit 10 offset 5
+//
+// A condition compares a column to a value using one of the operators =, !=,
+// <, <=, >, >=, % (the column contains the value) or like (the column matches
+// the value using the sql like wildcards % and _). Comparisons can be combined
+// using and, or and not, and grouped using parentheses. Keywords are case
+// insensitive. Values can be quoted using double quotes, single quotes or
+// backticks and must be quoted if they contain whitespace or any of the
+// characters ()=!<>%,
+//
+// Values compared to time columns can be an RFC 3339 timestamp, a date such
+// as 2024-01-31, now, or a duration relative to now such as -2h or +30m.
+//
+// The condition is not parsed using github.com/hashicorp/mql since it has no
+// not or like operators and compares times by casting columns using the
+// postgres only ::date syntax, which sqlite doesn't support.
+
+// searchQuery is a search query compiled into the clauses used to search the
+// cache for a resource.
+type searchQuery struct {
+	// condition is the where clause using ? placeholders for the args. When
+	// the query has no condition it is "true".
+	condition string
+	args      []any
+	// order is the order by clause, or empty if the query has none.
+	order string
+	// limit is the maximum number of results, or 0 if there is no limit.
+	limit  int
+	offset int
+}
+
+type queryFieldType int
+
+const (
+	stringQueryField queryFieldType = iota
+	numberQueryField
+	timeQueryField
+)
+
+type queryField struct {
+	column string
+	typ    queryFieldType
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// queryFields returns the fields which can be used in a search query for the
+// provided model keyed by column name. The column name of a field is its name
+// in snake case. Fields which are ignored or whose type can't be queried are
+// not included.
+func queryFields(model any, ignoredFields ...string) map[string]queryField {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	ignored := make(map[string]bool, len(ignoredFields))
+	for _, f := range ignoredFields {
+		ignored[f] = true
+	}
+	ret := make(map[string]queryField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || ignored[f.Name] {
+			continue
+		}
+		var typ queryFieldType
+		switch {
+		case f.Type == timeType:
+			typ = timeQueryField
+		case f.Type.Kind() == reflect.String:
+			typ = stringQueryField
+		case f.Type.Kind() >= reflect.Int && f.Type.Kind() <= reflect.Float64:
+			typ = numberQueryField
+		default:
+			continue
+		}
+		column := toSnakeCase(f.Name)
+		ret[column] = queryField{column: column, typ: typ}
+	}
+	return ret
+}
+
+// toSnakeCase converts a go field name such as HostCatalogId to the column
+// name host_catalog_id.
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	rs := []rune(s)
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(rs[i-1]) || unicode.IsDigit(rs[i-1])) {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// parseSearchQuery parses the provided query and compiles it into a
+// searchQuery for the table represented by the provided model.
+func parseSearchQuery(ctx context.Context, query string, model any, ignoredFields ...string) (*searchQuery, error) {
+	const op = "cache.parseSearchQuery"
+	toks, err := lexQuery(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p := &queryParser{
+		ctx:    ctx,
+		toks:   toks,
+		fields: queryFields(model, ignoredFields...),
+		now:    time.Now(),
+	}
+	ret, err := p.parse()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+type queryTokenType int
+
+const (
+	eofQueryToken queryTokenType = iota
+	wordQueryToken
+	stringQueryToken
+	operatorQueryToken
+	lParenQueryToken
+	rParenQueryToken
+	commaQueryToken
+)
+
+type queryToken struct {
+	typ queryTokenType
+	val string
+}
+
+// isKeyword reports whether the token is the provided unquoted keyword.
+func (t queryToken) isKeyword(k string) bool {
+	return t.typ == wordQueryToken && strings.EqualFold(t.val, k)
+}
+
+func (t queryToken) String() string {
+	switch t.typ {
+	case eofQueryToken:
+		return "end of query"
+	default:
+		return fmt.Sprintf("%q", t.val)
+	}
+}
+
+// lexQuery splits the provided query into tokens, always ending with an eof
+// token.
+func lexQuery(ctx context.Context, query string) ([]queryToken, error) {
+	const op = "cache.lexQuery"
+	var toks []queryToken
+	rs := []rune(query)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			toks = append(toks, queryToken{typ: lParenQueryToken, val: "("})
+			i++
+		case r == ')':
+			toks = append(toks, queryToken{typ: rParenQueryToken, val: ")"})
+			i++
+		case r == ',':
+			toks = append(toks, queryToken{typ: commaQueryToken, val: ","})
+			i++
+		case r == '=' || r == '%':
+			toks = append(toks, queryToken{typ: operatorQueryToken, val: string(r)})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			opr := string(r)
+			if i+1 < len(rs) && rs[i+1] == '=' {
+				opr += "="
+			}
+			if opr == "!" {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "", errors.WithMsg("unexpected %q at position %d", r, i))
+			}
+			toks = append(toks, queryToken{typ: operatorQueryToken, val: opr})
+			i += len(opr)
+		case r == '"' || r == '\'' || r == '`':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(rs) {
+					return nil, errors.New(ctx, errors.InvalidParameter, op, "", errors.WithMsg("unterminated quoted string starting at position %d", start))
+				}
+				if rs[i] == '\\' && i+1 < len(rs) && (rs[i+1] == r || rs[i+1] == '\\') {
+					i++
+				} else if rs[i] == r {
+					break
+				}
+				b.WriteRune(rs[i])
+			}
+			toks = append(toks, queryToken{typ: stringQueryToken, val: b.String()})
+			i++
+		default:
+			start := i
+			for i < len(rs) && !unicode.IsSpace(rs[i]) && !strings.ContainsRune("()=!<>%,\"'`", rs[i]) {
+				i++
+			}
+			toks = append(toks, queryToken{typ: wordQueryToken, val: string(rs[start:i])})
+		}
+	}
+	return append(toks, queryToken{typ: eofQueryToken}), nil
+}
+
+// queryParser is a recursive descent parser for the search query grammar:
+//
+//	query      = [ or ] [ "order" "by" order { "," order } ] [ "limit" int ] [ "offset" int ]
+//	or         = and { "or" and }
+//	and        = not { "and" not }
+//	not        = "not" not | "(" or ")" | comparison
+//	comparison = column ( "=" | "!=" | "<" | "<=" | ">" | ">=" | "%" | "like" ) value
+//	order      = column [ "asc" | "desc" ]
+type queryParser struct {
+	ctx    context.Context
+	toks   []queryToken
+	pos    int
+	fields map[string]queryField
+	now    time.Time
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.toks[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	t := p.toks[p.pos]
+	if t.typ != eofQueryToken {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) errorf(format string, a ...any) error {
+	const op = "cache.(queryParser).parse"
+	return errors.New(p.ctx, errors.InvalidParameter, op, "", errors.WithMsg(format, a...))
+}
+
+// startsClause reports whether the next token starts one of the clauses which
+// follow the condition.
+func (p *queryParser) startsClause() bool {
+	t := p.peek()
+	return t.typ == eofQueryToken || t.isKeyword("order") || t.isKeyword("limit") || t.isKeyword("offset")
+}
+
+func (p *queryParser) parse() (*searchQuery, error) {
+	ret := &searchQuery{condition: "true"}
+	if !p.startsClause() {
+		cond, args, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		ret.condition, ret.args = cond, args
+	}
+
+	if p.peek().isKeyword("order") {
+		p.next()
+		if t := p.next(); !t.isKeyword("by") {
+			return nil, p.errorf("expected \"by\" after \"order\" but got %s", t)
+		}
+		var terms []string
+		for {
+			term, err := p.parseOrder()
+			if err != nil {
+				return nil, err
+			}
+			terms = append(terms, term)
+			if p.peek().typ != commaQueryToken {
+				break
+			}
+			p.next()
+		}
+		ret.order = strings.Join(terms, ", ")
+	}
+	if p.peek().isKeyword("limit") {
+		p.next()
+		n, err := p.parseCount("limit")
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, p.errorf("limit must be greater than 0")
+		}
+		ret.limit = n
+	}
+	if p.peek().isKeyword("offset") {
+		p.next()
+		n, err := p.parseCount("offset")
+		if err != nil {
+			return nil, err
+		}
+		ret.offset = n
+	}
+	if t := p.peek(); t.typ != eofQueryToken {
+		return nil, p.errorf("unexpected %s", t)
+	}
+	return ret, nil
+}
+
+func (p *queryParser) parseOr() (string, []any, error) {
+	cond, args, err := p.parseAnd()
+	if err != nil {
+		return "", nil, err
+	}
+	for p.peek().isKeyword("or") {
+		p.next()
+		right, rightArgs, err := p.parseAnd()
+		if err != nil {
+			return "", nil, err
+		}
+		cond = fmt.Sprintf("(%s or %s)", cond, right)
+		args = append(args, rightArgs...)
+	}
+	return cond, args, nil
+}
+
+func (p *queryParser) parseAnd() (string, []any, error) {
+	cond, args, err := p.parseNot()
+	if err != nil {
+		return "", nil, err
+	}
+	for p.peek().isKeyword("and") {
+		p.next()
+		right, rightArgs, err := p.parseNot()
+		if err != nil {
+			return "", nil, err
+		}
+		cond = fmt.Sprintf("(%s and %s)", cond, right)
+		args = append(args, rightArgs...)
+	}
+	return cond, args, nil
+}
+
+func (p *queryParser) parseNot() (string, []any, error) {
+	switch t := p.peek(); {
+	case t.isKeyword("not"):
+		p.next()
+		cond, args, err := p.parseNot()
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("(not %s)", cond), args, nil
+	case t.typ == lParenQueryToken:
+		p.next()
+		cond, args, err := p.parseOr()
+		if err != nil {
+			return "", nil, err
+		}
+		if t := p.next(); t.typ != rParenQueryToken {
+			return "", nil, p.errorf("expected \")\" but got %s", t)
+		}
+		return cond, args, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *queryParser) parseColumn() (queryField, error) {
+	t := p.next()
+	if t.typ != wordQueryToken {
+		return queryField{}, p.errorf("expected a column but got %s", t)
+	}
+	f, ok := p.fields[strings.ToLower(t.val)]
+	if !ok {
+		return queryField{}, p.errorf("invalid column %q", t.val)
+	}
+	return f, nil
+}
+
+func (p *queryParser) parseComparison() (string, []any, error) {
+	f, err := p.parseColumn()
+	if err != nil {
+		return "", nil, err
+	}
+	var opr string
+	switch t := p.next(); {
+	case t.typ == operatorQueryToken:
+		opr = t.val
+	case t.isKeyword("like"):
+		opr = "like"
+	default:
+		return "", nil, p.errorf("expected a comparison operator after %q but got %s", f.column, t)
+	}
+	v := p.next()
+	if v.typ != wordQueryToken && v.typ != stringQueryToken {
+		return "", nil, p.errorf("expected a value to compare %q to but got %s", f.column, v)
+	}
+
+	if f.typ != stringQueryField && (opr == "%" || opr == "like") {
+		return "", nil, p.errorf("operator %q cannot be used with column %q", opr, f.column)
+	}
+	switch f.typ {
+	case numberQueryField:
+		n, err := strconv.ParseFloat(v.val, 64)
+		if err != nil {
+			return "", nil, p.errorf("%q is not a valid number for column %q", v.val, f.column)
+		}
+		return fmt.Sprintf("%s %s ?", f.column, opr), []any{n}, nil
+	case timeQueryField:
+		tm, err := p.parseTime(v.val)
+		if err != nil {
+			return "", nil, p.errorf("%q is not a valid time for column %q", v.val, f.column)
+		}
+		return fmt.Sprintf("julianday(%s) %s julianday(?)", f.column, opr), []any{tm.UTC().Format(time.RFC3339Nano)}, nil
+	}
+	switch opr {
+	case "%":
+		return fmt.Sprintf("%s like ?", f.column), []any{fmt.Sprintf("%%%s%%", v.val)}, nil
+	default:
+		return fmt.Sprintf("%s %s ?", f.column, opr), []any{v.val}, nil
+	}
+}
+
+// parseTime parses a value compared to a time column.
+func (p *queryParser) parseTime(v string) (time.Time, error) {
+	switch {
+	case strings.EqualFold(v, "now"):
+		return p.now, nil
+	case strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+"):
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return p.now.Add(d), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, v)
+}
+
+func (p *queryParser) parseOrder() (string, error) {
+	f, err := p.parseColumn()
+	if err != nil {
+		return "", err
+	}
+	term := f.column
+	if f.typ == timeQueryField {
+		term = fmt.Sprintf("julianday(%s)", f.column)
+	}
+	switch t := p.peek(); {
+	case t.isKeyword("asc"):
+		p.next()
+		term += " asc"
+	case t.isKeyword("desc"):
+		p.next()
+		term += " desc"
+	}
+	return term, nil
+}
+
+func (p *queryParser) parseCount(clause string) (int, error) {
+	t := p.next()
+	n, err := strconv.Atoi(t.val)
+	if t.typ != wordQueryToken || err != nil || n < 0 {
+		return 0, p.errorf("expected a non-negative integer after %q but got %s", clause, t)
+	}
+	return n, nil
+}
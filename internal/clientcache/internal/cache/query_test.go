@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testQueryModel struct {
+	FkUserId    string
+	Id          string
+	Name        string
+	ScopeId     string
+	Count       int
+	CreatedTime time.Time
+	Item        string
+	Ignored     []string
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name  string
+		query string
+		want  *searchQuery
+	}{
+		{
+			name:  "empty",
+			query: "  ",
+			want:  &searchQuery{condition: "true"},
+		},
+		{
+			name:  "equal unquoted",
+			query: "name=foo",
+			want:  &searchQuery{condition: "name = ?", args: []any{"foo"}},
+		},
+		{
+			name:  "contains",
+			query: `name % "foo bar"`,
+			want:  &searchQuery{condition: "name like ?", args: []any{"%foo bar%"}},
+		},
+		{
+			name:  "like",
+			query: `Name LIKE 'foo_%'`,
+			want:  &searchQuery{condition: "name like ?", args: []any{"foo_%"}},
+		},
+		{
+			name:  "escaped quote",
+			query: `name = "say \"hi\""`,
+			want:  &searchQuery{condition: "name = ?", args: []any{`say "hi"`}},
+		},
+		{
+			name:  "keyword as value",
+			query: `name != limit`,
+			want:  &searchQuery{condition: "name != ?", args: []any{"limit"}},
+		},
+		{
+			name:  "precedence",
+			query: `name = a or name = b and not scope_id = c`,
+			want: &searchQuery{
+				condition: "(name = ? or (name = ? and (not scope_id = ?)))",
+				args:      []any{"a", "b", "c"},
+			},
+		},
+		{
+			name:  "parentheses",
+			query: `(name % 'a' or name % 'b') and scope_id = "p_123"`,
+			want: &searchQuery{
+				condition: "((name like ? or name like ?) and scope_id = ?)",
+				args:      []any{"%a%", "%b%", "p_123"},
+			},
+		},
+		{
+			name:  "number",
+			query: `count >= 10`,
+			want:  &searchQuery{condition: "count >= ?", args: []any{float64(10)}},
+		},
+		{
+			name:  "time",
+			query: `created_time < "2024-01-02T03:04:05+01:00"`,
+			want: &searchQuery{
+				condition: "julianday(created_time) < julianday(?)",
+				args:      []any{"2024-01-02T02:04:05Z"},
+			},
+		},
+		{
+			name:  "date",
+			query: `created_time >= 2024-01-02`,
+			want: &searchQuery{
+				condition: "julianday(created_time) >= julianday(?)",
+				args:      []any{"2024-01-02T00:00:00Z"},
+			},
+		},
+		{
+			name:  "order limit and offset",
+			query: `name % a order by created_time desc, name limit 10 offset 5`,
+			want: &searchQuery{
+				condition: "name like ?",
+				args:      []any{"%a%"},
+				order:     "julianday(created_time) desc, name",
+				limit:     10,
+				offset:    5,
+			},
+		},
+		{
+			name:  "only order",
+			query: `ORDER BY id ASC`,
+			want:  &searchQuery{condition: "true", order: "id asc"},
+		},
+		{
+			name:  "only offset",
+			query: `offset 3`,
+			want:  &searchQuery{condition: "true", offset: 3},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseSearchQuery(ctx, tc.query, testQueryModel{}, "FkUserId", "Item")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("relative time", func(t *testing.T) {
+		got, err := parseSearchQuery(ctx, `created_time < -2h`, testQueryModel{})
+		require.NoError(t, err)
+		require.Len(t, got.args, 1)
+		tm, err := time.Parse(time.RFC3339Nano, got.args[0].(string))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(-2*time.Hour), tm, time.Minute)
+	})
+
+	errorCases := []struct {
+		name        string
+		query       string
+		errContains string
+	}{
+		{name: "ignored column", query: `item % "one"`, errContains: `invalid column "item"`},
+		{name: "unsupported type", query: `ignored = "one"`, errContains: `invalid column "ignored"`},
+		{name: "unknown column", query: `nope = "one"`, errContains: `invalid column "nope"`},
+		{name: "missing operator", query: `name "one"`, errContains: `expected a comparison operator after "name"`},
+		{name: "missing value", query: `name =`, errContains: `expected a value to compare "name" to`},
+		{name: "unterminated string", query: `name = "one`, errContains: "unterminated quoted string"},
+		{name: "lone bang", query: `name ! "one"`, errContains: `unexpected '!'`},
+		{name: "unbalanced parentheses", query: `(name = one`, errContains: `expected ")"`},
+		{name: "dangling and", query: `name = one and`, errContains: "expected a column"},
+		{name: "contains on time", query: `created_time % 2024`, errContains: `operator "%" cannot be used with column "created_time"`},
+		{name: "bad time", query: `created_time < yesterday`, errContains: `"yesterday" is not a valid time`},
+		{name: "bad number", query: `count < many`, errContains: `"many" is not a valid number`},
+		{name: "order without by", query: `order name`, errContains: `expected "by" after "order"`},
+		{name: "order unknown column", query: `order by nope`, errContains: `invalid column "nope"`},
+		{name: "negative limit", query: `limit -1`, errContains: `expected a non-negative integer after "limit"`},
+		{name: "zero limit", query: `limit 0`, errContains: "limit must be greater than 0"},
+		{name: "clauses out of order", query: `offset 1 limit 1`, errContains: `unexpected "limit"`},
+		{name: "trailing tokens", query: `name = one two`, errContains: `unexpected "two"`},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseSearchQuery(ctx, tc.query, testQueryModel{}, "FkUserId", "Item")
+			assert.Nil(t, got)
+			assert.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	assert.Equal(t, "id", toSnakeCase("Id"))
+	assert.Equal(t, "host_catalog_id", toSnakeCase("HostCatalogId"))
+	assert.Equal(t, "fk_user_id", toSnakeCase("FkUserId"))
+	assert.Equal(t, "ipv4_address", toSnakeCase("Ipv4Address"))
+}
+
+func FuzzLexQuery(f *testing.F) {
+	ctx := context.Background()
+	for _, q := range fuzzQuerySeeds {
+		f.Add(q)
+	}
+
+	f.Fuzz(func(t *testing.T, query string) {
+		toks, err := lexQuery(ctx, query)
+		if err != nil {
+			return
+		}
+		require.NotEmpty(t, toks)
+		for i, tok := range toks {
+			if i == len(toks)-1 {
+				assert.Equal(t, eofQueryToken, tok.typ, "query %q must end with an eof token", query)
+				continue
+			}
+			assert.NotEqual(t, eofQueryToken, tok.typ, "query %q has an eof token at position %d", query, i)
+			if tok.typ == wordQueryToken {
+				assert.NotEmpty(t, tok.val, "query %q has an empty word at position %d", query, i)
+			}
+		}
+	})
+}
+
+func FuzzParseSearchQuery(f *testing.F) {
+	ctx := context.Background()
+	for _, q := range fuzzQuerySeeds {
+		f.Add(q)
+	}
+
+	f.Fuzz(func(t *testing.T, query string) {
+		got, err := parseSearchQuery(ctx, query, testQueryModel{}, "FkUserId", "Item")
+		if err != nil {
+			return
+		}
+		// Values must only ever be passed as args so nothing from the query
+		// other than known columns and keywords ends up in the sql.
+		assert.Equal(t, len(got.args), strings.Count(got.condition, "?"), "query %q", query)
+		for _, clause := range []string{got.condition, got.order} {
+			assert.NotContainsf(t, clause, `'`, "query %q", query)
+			assert.NotContainsf(t, clause, `"`, "query %q", query)
+			assert.NotContainsf(t, clause, ";", "query %q", query)
+			assert.NotContainsf(t, clause, "--", "query %q", query)
+		}
+		assert.GreaterOrEqual(t, got.limit, 0)
+		assert.GreaterOrEqual(t, got.offset, 0)
+	})
+}
+
+var fuzzQuerySeeds = []string{
+	"",
+	"name=foo",
+	`name % "foo bar"`,
+	`Name LIKE 'foo_%'`,
+	`name = "say \"hi\""`,
+	"name != `a b`",
+	`name = a or name = b and not scope_id = c`,
+	`(name % 'a' or name % 'b') and scope_id = "p_123"`,
+	`count >= 10`,
+	`created_time < "2024-01-02T03:04:05+01:00"`,
+	`created_time >= 2024-01-02`,
+	`created_time < -2h`,
+	`name % a order by created_time desc, name limit 10 offset 5`,
+	`ORDER BY id ASC`,
+	`offset 3`,
+	`name = "one`,
+	`name ! "one"`,
+	`name = "'; drop table target; --"`,
+}
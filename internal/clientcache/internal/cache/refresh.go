@@ -210,6 +210,46 @@ func (r *RefreshService) RefreshForSearch(ctx context.Context, authTokenid strin
 				return errors.Wrap(ctx, err, op)
 			}
 		}
+	case Hosts:
+		rtv, err := r.repo.lookupRefreshToken(ctx, u, hostResourceType)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if opts.withIgnoreSearchStaleness || rtv != nil && time.Since(rtv.UpdateTime) > r.maxSearchStaleness {
+			if err := r.repo.refreshHosts(ctx, u, tokens, opt...); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+	case HostSets:
+		rtv, err := r.repo.lookupRefreshToken(ctx, u, hostSetResourceType)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if opts.withIgnoreSearchStaleness || rtv != nil && time.Since(rtv.UpdateTime) > r.maxSearchStaleness {
+			if err := r.repo.refreshHostSets(ctx, u, tokens, opt...); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+	case Scopes:
+		rtv, err := r.repo.lookupRefreshToken(ctx, u, scopeResourceType)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if opts.withIgnoreSearchStaleness || rtv != nil && time.Since(rtv.UpdateTime) > r.maxSearchStaleness {
+			if err := r.repo.refreshScopes(ctx, u, tokens, opt...); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+	case SessionRecordings:
+		rtv, err := r.repo.lookupRefreshToken(ctx, u, sessionRecordingResourceType)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if opts.withIgnoreSearchStaleness || rtv != nil && time.Since(rtv.UpdateTime) > r.maxSearchStaleness {
+			if err := r.repo.refreshSessionRecordings(ctx, u, tokens, opt...); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
 	default:
 		return errors.New(ctx, errors.InvalidParameter, op, "unrecognized resource type")
 	}
@@ -221,8 +261,10 @@ func (r *RefreshService) RefreshForSearch(ctx context.Context, authTokenid strin
 // have a refresh token or which do not have any resources in the cache yet. It
 // then attempts to read those user's resources from boundary and updates the
 // cache with the values retrieved there. Refresh accepts the options
-// WithTargetRetrievalFunc and WithSessionRetrievalFunc which overwrites the
-// default functions used to retrieve those resources from boundary.
+// WithTargetRetrievalFunc, WithSessionRetrievalFunc, WithHostRetrievalFunc,
+// WithHostSetRetrievalFunc, WithScopeRetrievalFunc and
+// WithSessionRecordingRetrievalFunc which overwrite the default functions used
+// to retrieve those resources from boundary.
 func (r *RefreshService) Refresh(ctx context.Context, opt ...Option) error {
 	const op = "cache.(RefreshService).Refresh"
 	if err := r.repo.cleanExpiredOrOrphanedAuthTokens(ctx); err != nil {
@@ -256,6 +298,18 @@ func (r *RefreshService) Refresh(ctx context.Context, opt ...Option) error {
 		if err := r.repo.refreshSessions(ctx, u, tokens, opt...); err != nil {
 			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
 		}
+		if err := r.repo.refreshHosts(ctx, u, tokens, opt...); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.refreshHostSets(ctx, u, tokens, opt...); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.refreshScopes(ctx, u, tokens, opt...); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.refreshSessionRecordings(ctx, u, tokens, opt...); err != nil {
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
 
 	}
 	return retErr
@@ -316,6 +370,34 @@ func (r *RefreshService) RecheckCachingSupport(ctx context.Context, opt ...Optio
 			}
 			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
 		}
+		if err := r.repo.checkCachingHosts(ctx, u, tokens, opt...); err != nil {
+			if err == ErrRefreshNotSupported {
+				// This is expected so no need to propagate the error up
+				continue
+			}
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.checkCachingHostSets(ctx, u, tokens, opt...); err != nil {
+			if err == ErrRefreshNotSupported {
+				// This is expected so no need to propagate the error up
+				continue
+			}
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.checkCachingScopes(ctx, u, tokens, opt...); err != nil {
+			if err == ErrRefreshNotSupported {
+				// This is expected so no need to propagate the error up
+				continue
+			}
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
+		if err := r.repo.checkCachingSessionRecordings(ctx, u, tokens, opt...); err != nil {
+			if err == ErrRefreshNotSupported {
+				// This is expected so no need to propagate the error up
+				continue
+			}
+			retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for user id %s", u.Id))))
+		}
 
 	}
 	return retErr
@@ -14,6 +14,10 @@ import (
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/clientcache/internal/db"
@@ -366,6 +370,10 @@ func TestRefreshForSearch(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -417,6 +425,10 @@ func TestRefreshForSearch(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -471,6 +483,10 @@ func TestRefreshForSearch(t *testing.T) {
 
 		// Get the first set of resources, but no refresh tokens
 		err = rs.Refresh(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.ErrorContains(t, err, ErrRefreshNotSupported.Error())
@@ -483,11 +499,19 @@ func TestRefreshForSearch(t *testing.T) {
 		// wont be refreshed any more, and we wont see the error when refreshing
 		// any more.
 		err = rs.Refresh(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.Nil(t, err)
 
 		err = rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.Nil(t, err)
@@ -499,6 +523,10 @@ func TestRefreshForSearch(t *testing.T) {
 		// Now simulate the controller updating to support refresh tokens and
 		// the resources starting to be cached.
 		err = rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, [][]*targets.Target{retTargets}, [][]string{{}})))
 		assert.Nil(t, err, err)
@@ -524,6 +552,10 @@ func TestRefreshForSearch(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -572,6 +604,10 @@ func TestRefreshForSearch(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -643,6 +679,10 @@ func TestRefresh(t *testing.T) {
 			target("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t,
 				[][]*targets.Target{
@@ -684,6 +724,10 @@ func TestRefresh(t *testing.T) {
 			session("4"),
 		}
 		opts := []Option{
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t,
 				[][]*sessions.Session{
@@ -719,6 +763,10 @@ func TestRefresh(t *testing.T) {
 
 		innerErr := errors.New("test error")
 		err = rs.Refresh(ctx,
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*targets.Target, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -727,6 +775,10 @@ func TestRefresh(t *testing.T) {
 			}))
 		assert.ErrorContains(t, err, innerErr.Error())
 		err = rs.Refresh(ctx,
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil)),
 			WithSessionRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*sessions.Session, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -759,6 +811,10 @@ func TestRefresh(t *testing.T) {
 		assert.Len(t, us, 1)
 
 		require.NoError(t, rs.Refresh(ctx,
+			WithHostRetrievalFunc(testStaticResourceRetrievalFunc[*hosts.Host](t, nil, nil)),
+			WithHostSetRetrievalFunc(testStaticResourceRetrievalFunc[*hostsets.HostSet](t, nil, nil)),
+			WithScopeRetrievalFunc(testStaticResourceRetrievalFunc[*scopes.Scope](t, nil, nil)),
+			WithSessionRecordingRetrievalFunc(testStaticResourceRetrievalFunc[*sessionrecordings.SessionRecording](t, nil, nil)),
 			WithSessionRetrievalFunc(testStaticResourceRetrievalFunc[*sessions.Session](t, nil, nil)),
 			WithTargetRetrievalFunc(testStaticResourceRetrievalFunc[*targets.Target](t, nil, nil))))
 
@@ -802,6 +858,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 		// Since this user doesn't have any resources, the user's data will still
 		// only get updated with a call to Refresh.
 		assert.NoError(t, rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t))))
 
@@ -810,6 +870,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 		assert.Empty(t, got)
 
 		err = rs.Refresh(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
@@ -820,6 +884,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		// now a full fetch will work since the user has resources and no refresh token
 		assert.NoError(t, rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t))))
 	})
@@ -835,6 +903,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		assert.NoError(t, rs.RecheckCachingSupport(ctx,
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t))))
 
 		got, err := r.ListSessions(ctx, at.Id)
@@ -843,6 +915,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		err = rs.Refresh(ctx,
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
 
@@ -852,6 +928,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		assert.NoError(t, rs.RecheckCachingSupport(ctx,
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t))))
 		got, err = r.ListSessions(ctx, at.Id)
 		assert.NoError(t, err)
@@ -869,11 +949,19 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		err = rs.Refresh(ctx,
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
 
 		innerErr := errors.New("test error")
 		err = rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*targets.Target, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -883,6 +971,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 		assert.ErrorContains(t, err, innerErr.Error())
 
 		err = rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(func(ctx context.Context, addr, token string, refreshTok RefreshTokenValue) ([]*targets.Target, []string, RefreshTokenValue, error) {
 				require.Equal(t, boundaryAddr, addr)
@@ -903,6 +995,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 
 		err = rs.Refresh(ctx,
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)),
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)))
 		assert.ErrorIs(t, err, ErrRefreshNotSupported)
 
@@ -920,6 +1016,10 @@ func TestRecheckCachingSupport(t *testing.T) {
 		assert.Len(t, us, 1)
 
 		err = rs.RecheckCachingSupport(ctx,
+			WithHostRetrievalFunc(testNoRefreshRetrievalFunc[*hosts.Host](t)),
+			WithHostSetRetrievalFunc(testNoRefreshRetrievalFunc[*hostsets.HostSet](t)),
+			WithScopeRetrievalFunc(testNoRefreshRetrievalFunc[*scopes.Scope](t)),
+			WithSessionRecordingRetrievalFunc(testNoRefreshRetrievalFunc[*sessionrecordings.SessionRecording](t)),
 			WithSessionRetrievalFunc(testNoRefreshRetrievalFunc[*sessions.Session](t)),
 			WithTargetRetrievalFunc(testNoRefreshRetrievalFunc[*targets.Target](t)))
 		assert.NoError(t, err)
@@ -953,3 +1053,42 @@ func session(suffix string) *sessions.Session {
 		Type: "tcp",
 	}
 }
+
+func host(suffix string) *hosts.Host {
+	return &hosts.Host{
+		Id:            fmt.Sprintf("host_%s", suffix),
+		HostCatalogId: fmt.Sprintf("hc_%s", suffix),
+		Name:          fmt.Sprintf("name_%s", suffix),
+		Description:   fmt.Sprintf("description_%s", suffix),
+		Type:          "static",
+	}
+}
+
+func hostSet(suffix string) *hostsets.HostSet {
+	return &hostsets.HostSet{
+		Id:            fmt.Sprintf("hostset_%s", suffix),
+		HostCatalogId: fmt.Sprintf("hc_%s", suffix),
+		Name:          fmt.Sprintf("name_%s", suffix),
+		Description:   fmt.Sprintf("description_%s", suffix),
+		Type:          "static",
+	}
+}
+
+func scope(suffix string) *scopes.Scope {
+	return &scopes.Scope{
+		Id:          fmt.Sprintf("o_%s", suffix),
+		ScopeId:     "global",
+		Name:        fmt.Sprintf("name_%s", suffix),
+		Description: fmt.Sprintf("description_%s", suffix),
+		Type:        "org",
+	}
+}
+
+func sessionRecording(suffix string) *sessionrecordings.SessionRecording {
+	return &sessionrecordings.SessionRecording{
+		Id:        fmt.Sprintf("sr_%s", suffix),
+		SessionId: fmt.Sprintf("s_%s", suffix),
+		State:     "available",
+		Type:      "ssh",
+	}
+}
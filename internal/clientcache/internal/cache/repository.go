@@ -32,6 +32,9 @@ type Repository struct {
 	tokenReadFromBoundaryFn BoundaryTokenReaderFn
 	// idToKeyringlessAuthToken maps an auth token id to an *authtokens.AuthToken
 	idToKeyringlessAuthToken *sync.Map
+	// changes is notified of the changes made to cached targets and sessions
+	// when they are refreshed. It may be nil.
+	changes *ChangeNotifier
 }
 
 // NewRepository returns a cache repository.  The provided context is stored as
@@ -48,6 +51,10 @@ func NewRepository(ctx context.Context, conn *db.DB, idToAuthToken *sync.Map, ke
 	case util.IsNil(atReadFn):
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token read function")
 	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	return &Repository{
 		serverCtx:               ctx,
 		rw:                      db.New(conn),
@@ -56,9 +63,59 @@ func NewRepository(ctx context.Context, conn *db.DB, idToAuthToken *sync.Map, ke
 		// This is passed in instead of being fully owned by the repo so multiple
 		// instances of the repo can operate on the same backing data
 		idToKeyringlessAuthToken: idToAuthToken,
+		changes:                  opts.withChangeNotifier,
 	}, nil
 }
 
+// searchWhere returns the cached resources matching the provided condition,
+// applying the order, limit and offset from the provided options. If the
+// options have a filter the limit and offset are left to filterAndPage, since
+// they must be applied after filtering.
+func searchWhere[T any](ctx context.Context, rw *db.Db, condition string, args []any, opts options) ([]T, error) {
+	const op = "cache.searchWhere"
+	dbOpts := []db.Option{db.WithLimit(-1)}
+	if opts.withLimit > 0 && opts.withFilter == nil {
+		dbOpts = []db.Option{db.WithLimit(opts.withLimit + opts.withOffset)}
+	}
+	if opts.withOrder != "" {
+		dbOpts = append(dbOpts, db.WithOrder(opts.withOrder))
+	}
+	var ret []T
+	if err := rw.SearchWhere(ctx, &ret, condition, args, dbOpts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if opts.withFilter != nil {
+		return ret, nil
+	}
+	if opts.withOffset >= len(ret) {
+		return nil, nil
+	}
+	return ret[opts.withOffset:], nil
+}
+
+// filterAndPage returns the items matching the filter from the provided
+// options, applying the limit and offset to the matching items. The items are
+// returned unchanged if the options have no filter.
+func filterAndPage[T any](items []T, opts options) []T {
+	if opts.withFilter == nil {
+		return items
+	}
+	ret := make([]T, 0, len(items))
+	for _, item := range items {
+		if opts.withFilter(item) {
+			ret = append(ret, item)
+		}
+	}
+	if opts.withOffset >= len(ret) {
+		return nil
+	}
+	ret = ret[opts.withOffset:]
+	if opts.withLimit > 0 && len(ret) > opts.withLimit {
+		ret = ret[:opts.withLimit]
+	}
+	return ret
+}
+
 func (r *Repository) saveError(ctx context.Context, u *user, resourceType resourceType, err error) error {
 	const op = "cache.(Repository).saveError"
 	switch {
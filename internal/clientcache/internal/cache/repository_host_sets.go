@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// HostSetRetrievalFunc is a function that retrieves host sets
+// from the provided boundary addr using the provided token.
+type HostSetRetrievalFunc func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) (ret []*hostsets.HostSet, removedIds []string, refreshToken RefreshTokenValue, err error)
+
+// defaultHostSetFunc lists the host sets in every host catalog the auth token
+// can list. Since host sets are listed per host catalog, the refresh token
+// holds the list token of the host catalogs and of each host catalog. See
+// listPerHostCatalog for details.
+func defaultHostSetFunc(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*hostsets.HostSet, []string, RefreshTokenValue, error) {
+	const op = "cache.defaultHostSetFunc"
+	client, err := api.NewClient(&api.Config{
+		Addr:  addr,
+		Token: authTok,
+	})
+	if err != nil {
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	c := hostsets.NewClient(client)
+	ret, removedIds, newRefreshTok, err := listPerHostCatalog(ctx, client, "host-sets", refreshTok, func(ctx context.Context, hostCatalogId, listToken string) ([]*hostsets.HostSet, []string, string, string, error) {
+		l, err := c.List(ctx, hostCatalogId, hostsets.WithListToken(listToken))
+		if err != nil {
+			return nil, nil, "", "", err
+		}
+		return l.Items, l.RemovedIds, l.ListToken, l.ResponseType, nil
+	})
+	if err != nil {
+		if api.ErrInvalidListToken.Is(err) || err == ErrRefreshNotSupported {
+			return nil, nil, "", err
+		}
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	return ret, removedIds, newRefreshTok, nil
+}
+
+// hostSetResource describes how host sets are cached.
+var hostSetResource = &cachedResource[*hostsets.HostSet, HostSet]{
+	name:         "host sets",
+	resourceType: hostSetResourceType,
+	table:        "host_set",
+	retrievalFunc: func(opts options) resourceRetrievalFunc[*hostsets.HostSet] {
+		if opts.withHostSetRetrievalFunc != nil {
+			return resourceRetrievalFunc[*hostsets.HostSet](opts.withHostSetRetrievalFunc)
+		}
+		return defaultHostSetFunc
+	},
+	toRow: func(u *user, hs *hostsets.HostSet, item string) *HostSet {
+		var scopeId string
+		if hs.Scope != nil {
+			scopeId = hs.Scope.Id
+		}
+		return &HostSet{
+			FkUserId:      u.Id,
+			Id:            hs.Id,
+			Name:          hs.Name,
+			Description:   hs.Description,
+			Type:          hs.Type,
+			HostCatalogId: hs.HostCatalogId,
+			ScopeId:       scopeId,
+			Item:          item,
+		}
+	},
+	updateColumns: []string{"name", "description", "type", "host_catalog_id", "scope_id", "item"},
+	item:          func(row *HostSet) string { return row.Item },
+}
+
+// refreshHostSets attempts to refresh the host sets for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func (r *Repository) refreshHostSets(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return refreshResources(ctx, r, hostSetResource, u, tokens, opt...)
+}
+
+// checkCachingHostSets fetches all host sets for the provided user and sets the
+// cache to match the values returned. If the response includes a refresh
+// token it will save that as well.
+func (r *Repository) checkCachingHostSets(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return checkCachingResources(ctx, r, hostSetResource, u, tokens, opt...)
+}
+
+func (r *Repository) ListHostSets(ctx context.Context, authTokenId string) ([]*hostsets.HostSet, error) {
+	return listResources(ctx, r, hostSetResource, authTokenId)
+}
+
+func (r *Repository) QueryHostSets(ctx context.Context, authTokenId, query string, opt ...Option) ([]*hostsets.HostSet, error) {
+	return queryResources(ctx, r, hostSetResource, authTokenId, query, opt...)
+}
+
+type HostSet struct {
+	FkUserId      string `gorm:"primaryKey"`
+	Id            string `gorm:"primaryKey"`
+	Name          string `gorm:"default:null"`
+	Description   string `gorm:"default:null"`
+	Type          string `gorm:"default:null"`
+	HostCatalogId string `gorm:"default:null"`
+	ScopeId       string `gorm:"default:null"`
+	Item          string `gorm:"default:null"`
+}
+
+func (*HostSet) TableName() string {
+	return "host_set"
+}
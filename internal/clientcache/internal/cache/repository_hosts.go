@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// HostRetrievalFunc is a function that retrieves hosts
+// from the provided boundary addr using the provided token.
+type HostRetrievalFunc func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) (ret []*hosts.Host, removedIds []string, refreshToken RefreshTokenValue, err error)
+
+// defaultHostFunc lists the hosts in every host catalog the auth token can
+// list. Since hosts are listed per host catalog, the refresh token holds the
+// list token of the host catalogs and of each host catalog. See
+// listPerHostCatalog for details.
+func defaultHostFunc(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*hosts.Host, []string, RefreshTokenValue, error) {
+	const op = "cache.defaultHostFunc"
+	client, err := api.NewClient(&api.Config{
+		Addr:  addr,
+		Token: authTok,
+	})
+	if err != nil {
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	c := hosts.NewClient(client)
+	ret, removedIds, newRefreshTok, err := listPerHostCatalog(ctx, client, "hosts", refreshTok, func(ctx context.Context, hostCatalogId, listToken string) ([]*hosts.Host, []string, string, string, error) {
+		l, err := c.List(ctx, hostCatalogId, hosts.WithListToken(listToken))
+		if err != nil {
+			return nil, nil, "", "", err
+		}
+		return l.Items, l.RemovedIds, l.ListToken, l.ResponseType, nil
+	})
+	if err != nil {
+		if api.ErrInvalidListToken.Is(err) || err == ErrRefreshNotSupported {
+			return nil, nil, "", err
+		}
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	return ret, removedIds, newRefreshTok, nil
+}
+
+// hostResource describes how hosts are cached.
+var hostResource = &cachedResource[*hosts.Host, Host]{
+	name:         "hosts",
+	resourceType: hostResourceType,
+	table:        "host",
+	retrievalFunc: func(opts options) resourceRetrievalFunc[*hosts.Host] {
+		if opts.withHostRetrievalFunc != nil {
+			return resourceRetrievalFunc[*hosts.Host](opts.withHostRetrievalFunc)
+		}
+		return defaultHostFunc
+	},
+	toRow: func(u *user, h *hosts.Host, item string) *Host {
+		var scopeId string
+		if h.Scope != nil {
+			scopeId = h.Scope.Id
+		}
+		return &Host{
+			FkUserId:      u.Id,
+			Id:            h.Id,
+			Name:          h.Name,
+			Description:   h.Description,
+			Type:          h.Type,
+			HostCatalogId: h.HostCatalogId,
+			ScopeId:       scopeId,
+			ExternalId:    h.ExternalId,
+			ExternalName:  h.ExternalName,
+			Item:          item,
+		}
+	},
+	updateColumns: []string{"name", "description", "type", "host_catalog_id", "scope_id", "external_id", "external_name", "item"},
+	item:          func(row *Host) string { return row.Item },
+}
+
+// refreshHosts attempts to refresh the hosts for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func (r *Repository) refreshHosts(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return refreshResources(ctx, r, hostResource, u, tokens, opt...)
+}
+
+// checkCachingHosts fetches all hosts for the provided user and sets the
+// cache to match the values returned. If the response includes a refresh
+// token it will save that as well.
+func (r *Repository) checkCachingHosts(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return checkCachingResources(ctx, r, hostResource, u, tokens, opt...)
+}
+
+func (r *Repository) ListHosts(ctx context.Context, authTokenId string) ([]*hosts.Host, error) {
+	return listResources(ctx, r, hostResource, authTokenId)
+}
+
+func (r *Repository) QueryHosts(ctx context.Context, authTokenId, query string, opt ...Option) ([]*hosts.Host, error) {
+	return queryResources(ctx, r, hostResource, authTokenId, query, opt...)
+}
+
+type Host struct {
+	FkUserId      string `gorm:"primaryKey"`
+	Id            string `gorm:"primaryKey"`
+	Name          string `gorm:"default:null"`
+	Description   string `gorm:"default:null"`
+	Type          string `gorm:"default:null"`
+	HostCatalogId string `gorm:"default:null"`
+	ScopeId       string `gorm:"default:null"`
+	ExternalId    string `gorm:"default:null"`
+	ExternalName  string `gorm:"default:null"`
+	Item          string `gorm:"default:null"`
+}
+
+func (*Host) TableName() string {
+	return "host"
+}
@@ -218,14 +218,18 @@ func upsertRefreshToken(ctx context.Context, writer db.Writer, u *user, rt resou
 type resourceType string
 
 const (
-	unknownResourceType resourceType = "unknown"
-	targetResourceType  resourceType = "target"
-	sessionResourceType resourceType = "session"
+	unknownResourceType          resourceType = "unknown"
+	targetResourceType           resourceType = "target"
+	sessionResourceType          resourceType = "session"
+	hostResourceType             resourceType = "host"
+	hostSetResourceType          resourceType = "host_set"
+	scopeResourceType            resourceType = "scope"
+	sessionRecordingResourceType resourceType = "session_recording"
 )
 
 func (r resourceType) valid() bool {
 	switch r {
-	case targetResourceType, sessionResourceType:
+	case targetResourceType, sessionResourceType, hostResourceType, hostSetResourceType, scopeResourceType, sessionRecordingResourceType:
 		return true
 	}
 	return false
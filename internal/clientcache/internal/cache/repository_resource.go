@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// resourceRetrievalFunc is a function that retrieves resources from the
+// provided boundary addr using the provided token.
+type resourceRetrievalFunc[T any] func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) (ret []T, removedIds []string, refreshToken RefreshTokenValue, err error)
+
+// cachedResource describes how resources of type T, as returned by the
+// boundary api, are retrieved and stored in the cache as rows of type R.
+type cachedResource[T any, R any] struct {
+	// name is the plural name of the resource, e.g. "hosts".
+	name         string
+	resourceType resourceType
+	// table is the name of the table the rows are stored in.
+	table string
+	// changes is the resource the changes to the cached resources are
+	// published as, or empty if they aren't published.
+	changes SearchableResource
+	// retrievalFunc returns the function provided in the options for
+	// retrieving the resources, or the default one.
+	retrievalFunc func(options) resourceRetrievalFunc[T]
+	// toRow returns the row caching the resource for the user. item is the
+	// json encoded resource.
+	toRow func(u *user, in T, item string) *R
+	// updateColumns are the columns updated when a cached resource is
+	// retrieved again.
+	updateColumns []string
+	// item returns the json encoded resource stored in the row.
+	item func(*R) string
+}
+
+// refreshResources attempts to refresh the resources for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func refreshResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], u *user, tokens map[AuthToken]string, opt ...Option) error {
+	const op = "cache.refreshResources"
+	switch {
+	case util.IsNil(u):
+		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
+	case u.Id == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
+	case u.Address == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user boundary address is missing")
+	}
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	retrieve := res.retrievalFunc(opts)
+
+	var oldRefreshTokenVal RefreshTokenValue
+	oldRefreshToken, err := r.lookupRefreshToken(ctx, u, res.resourceType)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if oldRefreshToken != nil {
+		oldRefreshTokenVal = oldRefreshToken.RefreshToken
+	}
+
+	// Find and use a token for retrieving the resources
+	var gotResponse bool
+	var resp []T
+	var removedIds []string
+	var newRefreshToken RefreshTokenValue
+	var unsupportedCacheRequest bool
+	var retErr error
+	for at, t := range tokens {
+		resp, removedIds, newRefreshToken, err = retrieve(ctx, u.Address, t, oldRefreshTokenVal)
+		if api.ErrInvalidListToken.Is(err) {
+			event.WriteSysEvent(ctx, op, "old list token is no longer valid, starting new initial fetch", "resource", res.name, "user_id", u.Id)
+			if err := r.deleteRefreshToken(ctx, u, res.resourceType); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// try again without the refresh token
+			oldRefreshToken = nil
+			resp, removedIds, newRefreshToken, err = retrieve(ctx, u.Address, t, "")
+		}
+		if err != nil {
+			if err == ErrRefreshNotSupported {
+				unsupportedCacheRequest = true
+			} else {
+				retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("for token %q", at.Id)))
+				continue
+			}
+		}
+		gotResponse = true
+		break
+	}
+	if retErr != nil {
+		if saveErr := r.saveError(r.serverCtx, u, res.resourceType, retErr); saveErr != nil {
+			return stderrors.Join(err, errors.Wrap(ctx, saveErr, op))
+		}
+	}
+	if !gotResponse {
+		return retErr
+	}
+
+	var numDeleted int
+	err = updateResources(ctx, r, res, u, func(w db.Writer) error {
+		var err error
+		switch {
+		case oldRefreshToken == nil || unsupportedCacheRequest:
+			if numDeleted, err = w.Exec(ctx, fmt.Sprintf("delete from %s where fk_user_id = @fk_user_id", res.table),
+				[]any{sql.Named("fk_user_id", u.Id)}); err != nil {
+				return err
+			}
+		case len(removedIds) > 0:
+			if numDeleted, err = w.Exec(ctx, fmt.Sprintf("delete from %s where fk_user_id = @fk_user_id and id in @ids", res.table),
+				[]any{sql.Named("fk_user_id", u.Id), sql.Named("ids", removedIds)}); err != nil {
+				return err
+			}
+		}
+		switch {
+		case unsupportedCacheRequest:
+			if err := upsertRefreshToken(ctx, w, u, res.resourceType, sentinelNoRefreshToken); err != nil {
+				return err
+			}
+		case newRefreshToken != "":
+			if err := upsertResources(ctx, w, res, u, resp); err != nil {
+				return err
+			}
+			if err := upsertRefreshToken(ctx, w, u, res.resourceType, newRefreshToken); err != nil {
+				return err
+			}
+		default:
+			// controller supports caching, but doesn't have any resources
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if unsupportedCacheRequest {
+		return ErrRefreshNotSupported
+	}
+	event.WriteSysEvent(ctx, op, fmt.Sprintf("%s updated", res.name), "deleted", numDeleted, "upserted", len(resp), "user_id", u.Id)
+	return nil
+}
+
+// checkCachingResources fetches all resources for the provided user and sets
+// the cache to match the values returned. If the response includes a refresh
+// token it will save that as well. If there is no refresh token in the
+// response it marks this user as unable to cache the data.
+func checkCachingResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], u *user, tokens map[AuthToken]string, opt ...Option) error {
+	const op = "cache.checkCachingResources"
+	switch {
+	case util.IsNil(u):
+		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
+	case u.Id == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
+	case u.Address == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "user boundary address is missing")
+	}
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	retrieve := res.retrievalFunc(opts)
+
+	// Find and use a token for retrieving the resources
+	var gotResponse bool
+	var resp []T
+	var newRefreshToken RefreshTokenValue
+	var unsupportedCacheRequest bool
+	var retErr error
+	for at, t := range tokens {
+		resp, _, newRefreshToken, err = retrieve(ctx, u.Address, t, "")
+		if err != nil {
+			if err == ErrRefreshNotSupported {
+				unsupportedCacheRequest = true
+			} else {
+				retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("for token %q", at.Id)))
+				continue
+			}
+		}
+		gotResponse = true
+		break
+	}
+	if retErr != nil {
+		if saveErr := r.saveError(r.serverCtx, u, res.resourceType, retErr); saveErr != nil {
+			return stderrors.Join(err, errors.Wrap(ctx, saveErr, op))
+		}
+	}
+	if !gotResponse {
+		return retErr
+	}
+
+	var numDeleted int
+	err = updateResources(ctx, r, res, u, func(w db.Writer) error {
+		switch {
+		case unsupportedCacheRequest:
+			if err := upsertRefreshToken(ctx, w, u, res.resourceType, sentinelNoRefreshToken); err != nil {
+				return err
+			}
+		case newRefreshToken != "":
+			var err error
+			if numDeleted, err = w.Exec(ctx, fmt.Sprintf("delete from %s where fk_user_id = @fk_user_id", res.table),
+				[]any{sql.Named("fk_user_id", u.Id)}); err != nil {
+				return err
+			}
+			if err := upsertResources(ctx, w, res, u, resp); err != nil {
+				return err
+			}
+			if err := upsertRefreshToken(ctx, w, u, res.resourceType, newRefreshToken); err != nil {
+				return err
+			}
+		default:
+			// This is no longer flagged as not supported, but we dont have a
+			// refresh token so clear out any refresh token we have stored.
+			if err := deleteRefreshToken(ctx, w, u, res.resourceType); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if unsupportedCacheRequest {
+		return ErrRefreshNotSupported
+	}
+	event.WriteSysEvent(ctx, op, fmt.Sprintf("%s updated", res.name), "deleted", numDeleted, "upserted", len(resp), "user_id", u.Id)
+	return nil
+}
+
+// updateResources calls update in a transaction to update the cached
+// resources of the provided user, then publishes the changes it made if the
+// resource's changes are published.
+func updateResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], u *user, update func(w db.Writer) error) error {
+	const op = "cache.updateResources"
+	// Only read the cached items to find the changes to publish when someone
+	// is subscribed to them, since it reads all of the user's resources.
+	publishChanges := res.changes != "" && r.changes.hasSubscribers(u.Id)
+	var before, after map[string]string
+	_, err := r.rw.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		var err error
+		if publishChanges {
+			if before, err = r.cachedItems(ctx, reader, res.table, u); err != nil {
+				return err
+			}
+		}
+		if err := update(w); err != nil {
+			return err
+		}
+		if publishChanges {
+			if after, err = r.cachedItems(ctx, reader, res.table, u); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	r.changes.publish(diffItems(u.Id, res.changes, before, after))
+	return nil
+}
+
+// upsertResources upserts the provided resources to be stored for the
+// provided user.
+func upsertResources[T any, R any](ctx context.Context, w db.Writer, res *cachedResource[T, R], u *user, in []T) error {
+	const op = "cache.upsertResources"
+	switch {
+	case util.IsNil(w):
+		return errors.New(ctx, errors.InvalidParameter, op, "writer is nil")
+	case !w.IsTx(ctx):
+		return errors.New(ctx, errors.InvalidParameter, op, "writer isn't in a transaction")
+	case util.IsNil(u):
+		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
+	}
+
+	onConflict := db.OnConflict{
+		Target: db.Columns{"fk_user_id", "id"},
+		Action: db.SetColumns(res.updateColumns),
+	}
+	for _, v := range in {
+		item, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := w.Create(ctx, res.toRow(u, v, string(item)), db.WithOnConflict(&onConflict)); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// listResources returns all the cached resources of the user of the auth
+// token.
+func listResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], authTokenId string) ([]T, error) {
+	const op = "cache.listResources"
+	switch {
+	case authTokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
+	}
+	ret, err := searchResources(ctx, r, res, "true", nil, withAuthTokenId(authTokenId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// queryResources returns the cached resources of the user of the auth token
+// which match the query.
+func queryResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], authTokenId, query string, opt ...Option) ([]T, error) {
+	const op = "cache.queryResources"
+	switch {
+	case authTokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
+	case query == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "query is missing")
+	}
+
+	var model R
+	w, err := parseSearchQuery(ctx, query, model, "FkUserId", "Item")
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret, err := searchResources(ctx, r, res, w.condition, w.args, withAuthTokenId(authTokenId), withOrder(w.order), withLimit(w.limit), withOffset(w.offset), withFilter(opts.withFilter))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+func searchResources[T any, R any](ctx context.Context, r *Repository, res *cachedResource[T, R], condition string, searchArgs []any, opt ...Option) ([]T, error) {
+	const op = "cache.searchResources"
+	switch {
+	case condition == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "condition is missing")
+	}
+
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case opts.withAuthTokenId != "" && opts.withUserId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "both user id and auth token id were provided")
+	case opts.withAuthTokenId == "" && opts.withUserId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "neither user id nor auth token id were provided")
+	case opts.withAuthTokenId != "":
+		condition = fmt.Sprintf("%s and fk_user_id in (select user_id from auth_token where id = ?)", condition)
+		searchArgs = append(searchArgs, opts.withAuthTokenId)
+	case opts.withUserId != "":
+		condition = fmt.Sprintf("%s and fk_user_id = ?", condition)
+		searchArgs = append(searchArgs, opts.withUserId)
+	}
+
+	cached, err := searchWhere[*R](ctx, r.rw, condition, searchArgs, opts)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	ret := make([]T, 0, len(cached))
+	for _, c := range cached {
+		var item T
+		if err := json.Unmarshal([]byte(res.item(c)), &item); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, item)
+	}
+	return filterAndPage(ret, opts), nil
+}
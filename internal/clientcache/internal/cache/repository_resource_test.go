@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	cachedb "github.com/hashicorp/boundary/internal/clientcache/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+func TestRepository_cachedResources(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "hosts",
+			test: func(t *testing.T) {
+				testCachedResource(t, hostResource, host,
+					func(fn resourceRetrievalFunc[*hosts.Host]) Option {
+						return WithHostRetrievalFunc(HostRetrievalFunc(fn))
+					},
+					`(name % "name_1" or name % "name_2") and host_catalog_id % "hc_"`)
+			},
+		},
+		{
+			name: "host sets",
+			test: func(t *testing.T) {
+				testCachedResource(t, hostSetResource, hostSet,
+					func(fn resourceRetrievalFunc[*hostsets.HostSet]) Option {
+						return WithHostSetRetrievalFunc(HostSetRetrievalFunc(fn))
+					},
+					`(name % "name_1" or name % "name_2") and host_catalog_id % "hc_"`)
+			},
+		},
+		{
+			name: "scopes",
+			test: func(t *testing.T) {
+				testCachedResource(t, scopeResource, scope,
+					func(fn resourceRetrievalFunc[*scopes.Scope]) Option {
+						return WithScopeRetrievalFunc(ScopeRetrievalFunc(fn))
+					},
+					`(name % "name_1" or name % "name_2") and scope_id = "global"`)
+			},
+		},
+		{
+			name: "session recordings",
+			test: func(t *testing.T) {
+				testCachedResource(t, sessionRecordingResource, sessionRecording,
+					func(fn resourceRetrievalFunc[*sessionrecordings.SessionRecording]) Option {
+						return WithSessionRecordingRetrievalFunc(SessionRecordingRetrievalFunc(fn))
+					},
+					`(session_id % "s_1" or session_id % "s_2") and state = "available"`)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, tc.test)
+	}
+}
+
+// testCachedResource tests refreshing, listing and querying the resources
+// described by res. newItem returns a resource for a suffix and withFunc
+// returns the option providing the retrieval func for the resource. query must
+// match the resources with the suffixes "1" and "2" but not "3".
+func testCachedResource[T any, R any](t *testing.T, res *cachedResource[T, R], newItem func(string) T, withFunc func(resourceRetrievalFunc[T]) Option, query string) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	addr := "address"
+	u1 := &user{
+		Id:      "u1",
+		Address: addr,
+	}
+	at1 := &authtokens.AuthToken{
+		Id:     "at_1",
+		Token:  "at_1_token",
+		UserId: u1.Id,
+	}
+	kt1 := KeyringToken{
+		KeyringType: "k1",
+		TokenName:   "t1",
+		AuthTokenId: at1.Id,
+	}
+	u2 := &user{
+		Id:      "u2",
+		Address: addr,
+	}
+	at2 := &authtokens.AuthToken{
+		Id:     "at_2",
+		Token:  "at_2_token",
+		UserId: u2.Id,
+	}
+	kt2 := KeyringToken{
+		KeyringType: "k2",
+		TokenName:   "t2",
+		AuthTokenId: at2.Id,
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{"k1", "t1"}: at1,
+		{"k2", "t2"}: at2,
+	}
+	r, err := NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader(maps.Values(atMap)))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt1))
+	require.NoError(t, r.AddKeyringToken(ctx, addr, kt2))
+	tokens := map[AuthToken]string{{Id: "id"}: "something"}
+
+	ret := [][]T{
+		{
+			newItem("1"),
+			newItem("2"),
+			newItem("3"),
+		},
+		{
+			newItem("4"),
+		},
+	}
+	removed := [][]string{
+		nil,
+		{itemId(t, ret[0][2])},
+	}
+
+	t.Run("refresh errors", func(t *testing.T) {
+		err := refreshResources(ctx, r, res, nil, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed)))
+		assert.ErrorContains(t, err, "user is nil")
+		err = refreshResources(ctx, r, res, &user{Address: addr}, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed)))
+		assert.ErrorContains(t, err, "user id is missing")
+		err = refreshResources(ctx, r, res, &user{Id: u1.Id}, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed)))
+		assert.ErrorContains(t, err, "user boundary address is missing")
+	})
+	t.Run("list errors", func(t *testing.T) {
+		l, err := listResources(ctx, r, res, "")
+		assert.Nil(t, l)
+		assert.ErrorContains(t, err, "auth token id is missing")
+	})
+	t.Run("query errors", func(t *testing.T) {
+		l, err := queryResources(ctx, r, res, "", query)
+		assert.Nil(t, l)
+		assert.ErrorContains(t, err, "auth token id is missing")
+		l, err = queryResources(ctx, r, res, at1.Id, "")
+		assert.Nil(t, l)
+		assert.ErrorContains(t, err, "query is missing")
+	})
+
+	// Both users cache the same resources.
+	require.NoError(t, refreshResources(ctx, r, res, u1, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+	require.NoError(t, refreshResources(ctx, r, res, u2, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret[:1], removed[:1]))))
+
+	t.Run("list", func(t *testing.T) {
+		l, err := listResources(ctx, r, res, at1.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ret[0], l)
+	})
+	t.Run("query", func(t *testing.T) {
+		l, err := queryResources(ctx, r, res, at1.Id, query)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ret[0][:2], l)
+	})
+	t.Run("unknown token gets nothing", func(t *testing.T) {
+		l, err := listResources(ctx, r, res, "unknown")
+		require.NoError(t, err)
+		assert.Empty(t, l)
+	})
+
+	// Refreshing again uses the refresh token, adding the new resources and
+	// removing the ones reported as removed only for the refreshed user.
+	require.NoError(t, refreshResources(ctx, r, res, u1, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+	t.Run("refresh with refresh token", func(t *testing.T) {
+		l, err := listResources(ctx, r, res, at1.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []T{ret[0][0], ret[0][1], ret[1][0]}, l)
+
+		l, err = listResources(ctx, r, res, at2.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ret[0], l)
+	})
+
+	// Refreshing with the refresh token being reported as invalid replaces
+	// the cached resources.
+	require.NoError(t, refreshResources(ctx, r, res, u1, tokens, withFunc(testErroringForRefreshTokenRetrievalFunc(t, ret[1]))))
+	t.Run("refresh with invalid refresh token", func(t *testing.T) {
+		l, err := listResources(ctx, r, res, at1.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ret[1], l)
+	})
+
+	t.Run("check caching", func(t *testing.T) {
+		require.NoError(t, checkCachingResources(ctx, r, res, u1, tokens, withFunc(testStaticResourceRetrievalFunc(t, ret, removed))))
+		l, err := listResources(ctx, r, res, at1.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ret[0], l)
+
+		err = checkCachingResources(ctx, r, res, u1, tokens, withFunc(testNoRefreshRetrievalFunc[T](t)))
+		assert.ErrorIs(t, err, ErrRefreshNotSupported)
+		cs, err := r.cacheSupportState(ctx, u1)
+		require.NoError(t, err)
+		assert.Equal(t, NotSupportedCacheSupport, cs.supported)
+	})
+}
+
+// itemId returns the id of a resource returned by the boundary api.
+func itemId(t *testing.T, in any) string {
+	t.Helper()
+	switch i := in.(type) {
+	case *hosts.Host:
+		return i.Id
+	case *hostsets.HostSet:
+		return i.Id
+	case *scopes.Scope:
+		return i.Id
+	case *sessionrecordings.SessionRecording:
+		return i.Id
+	}
+	require.FailNow(t, "unknown resource type")
+	return ""
+}
+
+func Test_cachedResourceRows(t *testing.T) {
+	u := &user{Id: "u1", Address: "address"}
+	scopeInfo := &scopes.ScopeInfo{Id: "p_1"}
+	tests := []struct {
+		name string
+		row  any
+		want any
+	}{
+		{
+			name: "host",
+			row: hostResource.toRow(u, &hosts.Host{
+				Id:            "hst_1",
+				HostCatalogId: "hc_1",
+				Scope:         scopeInfo,
+				Name:          "name",
+				Description:   "description",
+				Type:          "plugin",
+				ExternalId:    "i-1",
+				ExternalName:  "external",
+			}, "item"),
+			want: &Host{
+				FkUserId:      u.Id,
+				Id:            "hst_1",
+				HostCatalogId: "hc_1",
+				ScopeId:       "p_1",
+				Name:          "name",
+				Description:   "description",
+				Type:          "plugin",
+				ExternalId:    "i-1",
+				ExternalName:  "external",
+				Item:          "item",
+			},
+		},
+		{
+			name: "host without scope",
+			row:  hostResource.toRow(u, &hosts.Host{Id: "hst_1"}, "item"),
+			want: &Host{FkUserId: u.Id, Id: "hst_1", Item: "item"},
+		},
+		{
+			name: "host set",
+			row: hostSetResource.toRow(u, &hostsets.HostSet{
+				Id:            "hsst_1",
+				HostCatalogId: "hc_1",
+				Scope:         scopeInfo,
+				Name:          "name",
+				Description:   "description",
+				Type:          "static",
+			}, "item"),
+			want: &HostSet{
+				FkUserId:      u.Id,
+				Id:            "hsst_1",
+				HostCatalogId: "hc_1",
+				ScopeId:       "p_1",
+				Name:          "name",
+				Description:   "description",
+				Type:          "static",
+				Item:          "item",
+			},
+		},
+		{
+			name: "scope",
+			row: scopeResource.toRow(u, &scopes.Scope{
+				Id:          "o_1",
+				ScopeId:     "global",
+				Name:        "name",
+				Description: "description",
+				Type:        "org",
+			}, "item"),
+			want: &Scope{
+				FkUserId:    u.Id,
+				Id:          "o_1",
+				ScopeId:     "global",
+				Name:        "name",
+				Description: "description",
+				Type:        "org",
+				Item:        "item",
+			},
+		},
+		{
+			name: "session recording",
+			row: sessionRecordingResource.toRow(u, &sessionrecordings.SessionRecording{
+				Id:              "sr_1",
+				Scope:           scopeInfo,
+				SessionId:       "s_1",
+				StorageBucketId: "sb_1",
+				Endpoint:        "tcp://localhost:22",
+				State:           "available",
+				Type:            "ssh",
+			}, "item"),
+			want: &SessionRecording{
+				FkUserId:        u.Id,
+				Id:              "sr_1",
+				ScopeId:         "p_1",
+				SessionId:       "s_1",
+				StorageBucketId: "sb_1",
+				Endpoint:        "tcp://localhost:22",
+				State:           "available",
+				Type:            "ssh",
+				Item:            "item",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.row)
+		})
+	}
+}
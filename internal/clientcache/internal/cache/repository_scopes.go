@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ScopeRetrievalFunc is a function that retrieves scopes
+// from the provided boundary addr using the provided token.
+type ScopeRetrievalFunc func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) (ret []*scopes.Scope, removedIds []string, refreshToken RefreshTokenValue, err error)
+
+func defaultScopeFunc(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*scopes.Scope, []string, RefreshTokenValue, error) {
+	const op = "cache.defaultScopeFunc"
+	client, err := api.NewClient(&api.Config{
+		Addr:  addr,
+		Token: authTok,
+	})
+	if err != nil {
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	c := scopes.NewClient(client)
+	l, err := c.List(ctx, "global", scopes.WithRecursive(true), scopes.WithListToken(string(refreshTok)))
+	if err != nil {
+		if api.ErrInvalidListToken.Is(err) {
+			return nil, nil, "", err
+		}
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	if l.ResponseType == "" {
+		return nil, nil, "", ErrRefreshNotSupported
+	}
+	return l.Items, l.RemovedIds, RefreshTokenValue(l.ListToken), nil
+}
+
+// scopeResource describes how scopes are cached.
+var scopeResource = &cachedResource[*scopes.Scope, Scope]{
+	name:         "scopes",
+	resourceType: scopeResourceType,
+	table:        "scope",
+	retrievalFunc: func(opts options) resourceRetrievalFunc[*scopes.Scope] {
+		if opts.withScopeRetrievalFunc != nil {
+			return resourceRetrievalFunc[*scopes.Scope](opts.withScopeRetrievalFunc)
+		}
+		return defaultScopeFunc
+	},
+	toRow: func(u *user, s *scopes.Scope, item string) *Scope {
+		return &Scope{
+			FkUserId:    u.Id,
+			Id:          s.Id,
+			Name:        s.Name,
+			Description: s.Description,
+			Type:        s.Type,
+			ScopeId:     s.ScopeId,
+			Item:        item,
+		}
+	},
+	updateColumns: []string{"name", "description", "type", "scope_id", "item"},
+	item:          func(row *Scope) string { return row.Item },
+}
+
+// refreshScopes attempts to refresh the scopes for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func (r *Repository) refreshScopes(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return refreshResources(ctx, r, scopeResource, u, tokens, opt...)
+}
+
+// checkCachingScopes fetches all scopes for the provided user and sets the
+// cache to match the values returned. If the response includes a refresh
+// token it will save that as well.
+func (r *Repository) checkCachingScopes(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return checkCachingResources(ctx, r, scopeResource, u, tokens, opt...)
+}
+
+func (r *Repository) ListScopes(ctx context.Context, authTokenId string) ([]*scopes.Scope, error) {
+	return listResources(ctx, r, scopeResource, authTokenId)
+}
+
+func (r *Repository) QueryScopes(ctx context.Context, authTokenId, query string, opt ...Option) ([]*scopes.Scope, error) {
+	return queryResources(ctx, r, scopeResource, authTokenId, query, opt...)
+}
+
+type Scope struct {
+	FkUserId    string `gorm:"primaryKey"`
+	Id          string `gorm:"primaryKey"`
+	Name        string `gorm:"default:null"`
+	Description string `gorm:"default:null"`
+	Type        string `gorm:"default:null"`
+	ScopeId     string `gorm:"default:null"`
+	Item        string `gorm:"default:null"`
+}
+
+func (*Scope) TableName() string {
+	return "scope"
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// SessionRecordingRetrievalFunc is a function that retrieves session recordings
+// from the provided boundary addr using the provided token.
+type SessionRecordingRetrievalFunc func(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) (ret []*sessionrecordings.SessionRecording, removedIds []string, refreshToken RefreshTokenValue, err error)
+
+func defaultSessionRecordingFunc(ctx context.Context, addr, authTok string, refreshTok RefreshTokenValue) ([]*sessionrecordings.SessionRecording, []string, RefreshTokenValue, error) {
+	const op = "cache.defaultSessionRecordingFunc"
+	client, err := api.NewClient(&api.Config{
+		Addr:  addr,
+		Token: authTok,
+	})
+	if err != nil {
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	c := sessionrecordings.NewClient(client)
+	l, err := c.List(ctx, "global", sessionrecordings.WithRecursive(true), sessionrecordings.WithListToken(string(refreshTok)))
+	if err != nil {
+		if api.ErrInvalidListToken.Is(err) {
+			return nil, nil, "", err
+		}
+		return nil, nil, "", errors.Wrap(ctx, err, op)
+	}
+	if l.ResponseType == "" {
+		return nil, nil, "", ErrRefreshNotSupported
+	}
+	return l.Items, l.RemovedIds, RefreshTokenValue(l.ListToken), nil
+}
+
+// sessionRecordingResource describes how session recordings are cached.
+var sessionRecordingResource = &cachedResource[*sessionrecordings.SessionRecording, SessionRecording]{
+	name:         "session recordings",
+	resourceType: sessionRecordingResourceType,
+	table:        "session_recording",
+	retrievalFunc: func(opts options) resourceRetrievalFunc[*sessionrecordings.SessionRecording] {
+		if opts.withSessionRecordingRetrievalFunc != nil {
+			return resourceRetrievalFunc[*sessionrecordings.SessionRecording](opts.withSessionRecordingRetrievalFunc)
+		}
+		return defaultSessionRecordingFunc
+	},
+	toRow: func(u *user, sr *sessionrecordings.SessionRecording, item string) *SessionRecording {
+		var scopeId string
+		if sr.Scope != nil {
+			scopeId = sr.Scope.Id
+		}
+		return &SessionRecording{
+			FkUserId:        u.Id,
+			Id:              sr.Id,
+			Type:            sr.Type,
+			State:           sr.State,
+			Endpoint:        sr.Endpoint,
+			SessionId:       sr.SessionId,
+			StorageBucketId: sr.StorageBucketId,
+			ScopeId:         scopeId,
+			Item:            item,
+		}
+	},
+	updateColumns: []string{"type", "state", "endpoint", "session_id", "storage_bucket_id", "scope_id", "item"},
+	item:          func(row *SessionRecording) string { return row.Item },
+}
+
+// refreshSessionRecordings attempts to refresh the session recordings for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func (r *Repository) refreshSessionRecordings(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return refreshResources(ctx, r, sessionRecordingResource, u, tokens, opt...)
+}
+
+// checkCachingSessionRecordings fetches all session recordings for the provided user and sets the
+// cache to match the values returned. If the response includes a refresh
+// token it will save that as well.
+func (r *Repository) checkCachingSessionRecordings(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return checkCachingResources(ctx, r, sessionRecordingResource, u, tokens, opt...)
+}
+
+func (r *Repository) ListSessionRecordings(ctx context.Context, authTokenId string) ([]*sessionrecordings.SessionRecording, error) {
+	return listResources(ctx, r, sessionRecordingResource, authTokenId)
+}
+
+func (r *Repository) QuerySessionRecordings(ctx context.Context, authTokenId, query string, opt ...Option) ([]*sessionrecordings.SessionRecording, error) {
+	return queryResources(ctx, r, sessionRecordingResource, authTokenId, query, opt...)
+}
+
+type SessionRecording struct {
+	FkUserId        string `gorm:"primaryKey"`
+	Id              string `gorm:"primaryKey"`
+	Type            string `gorm:"default:null"`
+	State           string `gorm:"default:null"`
+	Endpoint        string `gorm:"default:null"`
+	SessionId       string `gorm:"default:null"`
+	StorageBucketId string `gorm:"default:null"`
+	ScopeId         string `gorm:"default:null"`
+	Item            string `gorm:"default:null"`
+}
+
+func (*SessionRecording) TableName() string {
+	return "session_recording"
+}
@@ -5,18 +5,11 @@ package cache
 
 import (
 	"context"
-	"database/sql"
-	"encoding/json"
-	stderrors "errors"
-	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/sessions"
-	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
-	"github.com/hashicorp/boundary/internal/event"
-	"github.com/hashicorp/boundary/internal/util"
-	"github.com/hashicorp/mql"
 )
 
 // SessionRetrievalFunc is a function that retrieves sessions
@@ -46,330 +39,71 @@ func defaultSessionFunc(ctx context.Context, addr, authTok string, refreshTok Re
 	return l.Items, l.RemovedIds, RefreshTokenValue(l.ListToken), nil
 }
 
-// refreshSessions uses attempts to refresh the sessions for the provided user
-// using the provided tokens. If available, it uses the refresh tokens in
-// storage to retrieve and apply only the delta.
-func (r *Repository) refreshSessions(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
-	const op = "cache.(Repository).refreshSessions"
-	switch {
-	case util.IsNil(u):
-		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
-	case u.Id == "":
-		return errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
-	case u.Address == "":
-		return errors.New(ctx, errors.InvalidParameter, op, "user boundary address is missing")
-	}
-	const resourceType = sessionResourceType
-
-	opts, err := getOpts(opt...)
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-	if opts.withSessionRetrievalFunc == nil {
-		opts.withSessionRetrievalFunc = defaultSessionFunc
-	}
-	var oldRefreshTokenVal RefreshTokenValue
-	oldRefreshToken, err := r.lookupRefreshToken(ctx, u, resourceType)
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-	if oldRefreshToken != nil {
-		oldRefreshTokenVal = oldRefreshToken.RefreshToken
-	}
-
-	// Find and use a token for retrieving sessions
-	var gotResponse bool
-	var resp []*sessions.Session
-	var newRefreshToken RefreshTokenValue
-	var unsupportedCacheRequest bool
-	var removedIds []string
-	var retErr error
-	for at, t := range tokens {
-		resp, removedIds, newRefreshToken, err = opts.withSessionRetrievalFunc(ctx, u.Address, t, oldRefreshTokenVal)
-		if api.ErrInvalidListToken.Is(err) {
-			event.WriteSysEvent(ctx, op, "old list token is no longer valid, starting new initial fetch", "user_id", u.Id)
-			if err := r.deleteRefreshToken(ctx, u, resourceType); err != nil {
-				return errors.Wrap(ctx, err, op)
-			}
-			// try again without the refresh token
-			oldRefreshToken = nil
-			resp, removedIds, newRefreshToken, err = opts.withSessionRetrievalFunc(ctx, u.Address, t, "")
+// sessionResource describes how sessions are cached.
+var sessionResource = &cachedResource[*sessions.Session, Session]{
+	name:         "sessions",
+	resourceType: sessionResourceType,
+	table:        "session",
+	changes:      Sessions,
+	retrievalFunc: func(opts options) resourceRetrievalFunc[*sessions.Session] {
+		if opts.withSessionRetrievalFunc != nil {
+			return resourceRetrievalFunc[*sessions.Session](opts.withSessionRetrievalFunc)
 		}
-		if err != nil {
-			if err == ErrRefreshNotSupported {
-				unsupportedCacheRequest = true
-			} else {
-				retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("for token %q", at.Id)))
-				continue
-			}
+		return defaultSessionFunc
+	},
+	toRow: func(u *user, s *sessions.Session, item string) *Session {
+		return &Session{
+			FkUserId:       u.Id,
+			Id:             s.Id,
+			Type:           s.Type,
+			Status:         s.Status,
+			Endpoint:       s.Endpoint,
+			ScopeId:        s.ScopeId,
+			TargetId:       s.TargetId,
+			UserId:         s.UserId,
+			CreatedTime:    s.CreatedTime,
+			ExpirationTime: s.ExpirationTime,
+			Item:           item,
 		}
-		gotResponse = true
-		break
-	}
-
-	if retErr != nil {
-		if saveErr := r.saveError(r.serverCtx, u, resourceType, retErr); saveErr != nil {
-			return stderrors.Join(err, errors.Wrap(ctx, saveErr, op))
-		}
-	}
-	if !gotResponse {
-		return retErr
-	}
+	},
+	updateColumns: []string{"type", "status", "endpoint", "scope_id", "target_id", "user_id", "created_time", "expiration_time", "item"},
+	item:          func(row *Session) string { return row.Item },
+}
 
-	var numDeleted int
-	_, err = r.rw.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
-		var err error
-		switch {
-		case oldRefreshToken == nil:
-			if numDeleted, err = w.Exec(ctx, "delete from session where fk_user_id = @fk_user_id",
-				[]any{sql.Named("fk_user_id", u.Id)}); err != nil {
-				return err
-			}
-		case len(removedIds) > 0:
-			if numDeleted, err = w.Exec(ctx, "delete from session where id in @ids",
-				[]any{sql.Named("ids", removedIds)}); err != nil {
-				return err
-			}
-		}
-		switch {
-		case unsupportedCacheRequest:
-			if err := upsertRefreshToken(ctx, w, u, resourceType, sentinelNoRefreshToken); err != nil {
-				return err
-			}
-		case newRefreshToken != "":
-			if err := upsertSessions(ctx, w, u, resp); err != nil {
-				return err
-			}
-			if err := upsertRefreshToken(ctx, w, u, resourceType, newRefreshToken); err != nil {
-				return err
-			}
-		default:
-			// controller supports caching, but doesn't have any resources
-		}
-		return nil
-	})
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-	if unsupportedCacheRequest {
-		return ErrRefreshNotSupported
-	}
-	event.WriteSysEvent(ctx, op, "sessions updated", "deleted", numDeleted, "upserted", len(resp), "user_id", u.Id)
-	return nil
+// refreshSessions attempts to refresh the sessions for the provided user
+// using the provided tokens. If available, it uses the refresh tokens in
+// storage to retrieve and apply only the delta.
+func (r *Repository) refreshSessions(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
+	return refreshResources(ctx, r, sessionResource, u, tokens, opt...)
 }
 
 // checkCachingSessions fetches all sessions for the provided user and sets the
 // cache to match the values returned.  If the response includes a refresh
 // token it will save that as well.
 func (r *Repository) checkCachingSessions(ctx context.Context, u *user, tokens map[AuthToken]string, opt ...Option) error {
-	const op = "cache.(Repository).checkSessionsForSearchability"
-	switch {
-	case util.IsNil(u):
-		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
-	case u.Id == "":
-		return errors.New(ctx, errors.InvalidParameter, op, "user id is missing")
-	case u.Address == "":
-		return errors.New(ctx, errors.InvalidParameter, op, "user boundary address is missing")
-	}
-	const resourceType = sessionResourceType
-
-	opts, err := getOpts(opt...)
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-	if opts.withSessionRetrievalFunc == nil {
-		opts.withSessionRetrievalFunc = defaultSessionFunc
-	}
-
-	// Find and use a token for retrieving sessions
-	var gotResponse bool
-	var resp []*sessions.Session
-	var newRefreshToken RefreshTokenValue
-	var unsupportedCacheRequest bool
-	var retErr error
-	for at, t := range tokens {
-		resp, _, newRefreshToken, err = opts.withSessionRetrievalFunc(ctx, u.Address, t, "")
-		if err != nil {
-			if err == ErrRefreshNotSupported {
-				unsupportedCacheRequest = true
-			} else {
-				retErr = stderrors.Join(retErr, errors.Wrap(ctx, err, op, errors.WithMsg("for token %q", at.Id)))
-				continue
-			}
-		}
-		gotResponse = true
-		break
-	}
-
-	if retErr != nil {
-		if saveErr := r.saveError(r.serverCtx, u, resourceType, retErr); saveErr != nil {
-			return stderrors.Join(err, errors.Wrap(ctx, saveErr, op))
-		}
-	}
-	if !gotResponse {
-		return retErr
-	}
-
-	var numDeleted int
-	_, err = r.rw.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
-		switch {
-		case unsupportedCacheRequest:
-			if err := upsertRefreshToken(ctx, w, u, resourceType, sentinelNoRefreshToken); err != nil {
-				return err
-			}
-		case newRefreshToken != "":
-			var err error
-			if numDeleted, err = w.Exec(ctx, "delete from session where fk_user_id = @fk_user_id",
-				[]any{sql.Named("fk_user_id", u.Id)}); err != nil {
-				return err
-			}
-			if err := upsertSessions(ctx, w, u, resp); err != nil {
-				return err
-			}
-			if err := upsertRefreshToken(ctx, w, u, resourceType, newRefreshToken); err != nil {
-				return err
-			}
-		default:
-			// This is no longer flagged as not supported, but we dont have a
-			// refresh token so clear out any refresh token we have stored.
-			if err := deleteRefreshToken(ctx, w, u, resourceType); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-	if unsupportedCacheRequest {
-		return ErrRefreshNotSupported
-	}
-	event.WriteSysEvent(ctx, op, "sessions updated", "deleted", numDeleted, "upserted", len(resp), "user_id", u.Id)
-	return nil
-}
-
-// upsertSessions upserts the provided sessions to be stored for the provided user.
-func upsertSessions(ctx context.Context, w db.Writer, u *user, in []*sessions.Session) error {
-	const op = "cache.upsertSessions"
-	switch {
-	case util.IsNil(w):
-		return errors.New(ctx, errors.InvalidParameter, op, "writer is nil")
-	case !w.IsTx(ctx):
-		return errors.New(ctx, errors.InvalidParameter, op, "writer isn't in a transaction")
-	case util.IsNil(u):
-		return errors.New(ctx, errors.InvalidParameter, op, "user is nil")
-	}
-
-	for _, s := range in {
-		item, err := json.Marshal(s)
-		if err != nil {
-			return errors.Wrap(ctx, err, op)
-		}
-		newSession := &Session{
-			FkUserId: u.Id,
-			Id:       s.Id,
-			Type:     s.Type,
-			Status:   s.Status,
-			Endpoint: s.Endpoint,
-			ScopeId:  s.ScopeId,
-			TargetId: s.TargetId,
-			UserId:   s.UserId,
-			Item:     string(item),
-		}
-		onConflict := db.OnConflict{
-			Target: db.Columns{"fk_user_id", "id"},
-			Action: db.SetColumns([]string{"type", "status", "endpoint", "scope_id", "target_id", "user_id", "item"}),
-		}
-		if err := w.Create(ctx, newSession, db.WithOnConflict(&onConflict)); err != nil {
-			return errors.Wrap(ctx, err, op)
-		}
-	}
-	return nil
+	return checkCachingResources(ctx, r, sessionResource, u, tokens, opt...)
 }
 
 func (r *Repository) ListSessions(ctx context.Context, authTokenId string) ([]*sessions.Session, error) {
-	const op = "cache.(Repository).ListSessions"
-	switch {
-	case authTokenId == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
-	}
-	ret, err := r.searchSessions(ctx, "true", nil, withAuthTokenId(authTokenId))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	return ret, nil
-}
-
-func (r *Repository) QuerySessions(ctx context.Context, authTokenId, query string) ([]*sessions.Session, error) {
-	const op = "cache.(Repository).QuerySessions"
-	switch {
-	case authTokenId == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth token id is missing")
-	case query == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "query is missing")
-	}
-
-	w, err := mql.Parse(query, Session{}, mql.WithIgnoredFields("FkUserId", "Item"))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
-	}
-	ret, err := r.searchSessions(ctx, w.Condition, w.Args, withAuthTokenId(authTokenId))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	return ret, nil
+	return listResources(ctx, r, sessionResource, authTokenId)
 }
 
-func (r *Repository) searchSessions(ctx context.Context, condition string, searchArgs []any, opt ...Option) ([]*sessions.Session, error) {
-	const op = "cache.(Repository).searchSessions"
-	switch {
-	case condition == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "condition is missing")
-	}
-
-	opts, err := getOpts(opt...)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	switch {
-	case opts.withAuthTokenId != "" && opts.withUserId != "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "both user id and auth token id were provided")
-	case opts.withAuthTokenId == "" && opts.withUserId == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "neither user id nor auth token id were provided")
-	case opts.withAuthTokenId != "":
-		condition = fmt.Sprintf("%s and fk_user_id in (select user_id from auth_token where id = ?)", condition)
-		searchArgs = append(searchArgs, opts.withAuthTokenId)
-	case opts.withUserId != "":
-		condition = fmt.Sprintf("%s and fk_user_id = ?", condition)
-		searchArgs = append(searchArgs, opts.withUserId)
-	}
-
-	var cachedSessions []*Session
-	if err := r.rw.SearchWhere(ctx, &cachedSessions, condition, searchArgs, db.WithLimit(-1)); err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-
-	retSessions := make([]*sessions.Session, 0, len(cachedSessions))
-	for _, cachedSess := range cachedSessions {
-		var sess sessions.Session
-		if err := json.Unmarshal([]byte(cachedSess.Item), &sess); err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		retSessions = append(retSessions, &sess)
-	}
-	return retSessions, nil
+func (r *Repository) QuerySessions(ctx context.Context, authTokenId, query string, opt ...Option) ([]*sessions.Session, error) {
+	return queryResources(ctx, r, sessionResource, authTokenId, query, opt...)
 }
 
 type Session struct {
-	FkUserId string `gorm:"primaryKey"`
-	Id       string `gorm:"primaryKey"`
-	Type     string `gorm:"default:null"`
-	Endpoint string `gorm:"default:null"`
-	Status   string `gorm:"default:null"`
-	ScopeId  string `gorm:"default:null"`
-	TargetId string `gorm:"default:null"`
-	UserId   string `gorm:"default:null"`
-	Item     string `gorm:"default:null"`
+	FkUserId       string    `gorm:"primaryKey"`
+	Id             string    `gorm:"primaryKey"`
+	Type           string    `gorm:"default:null"`
+	Endpoint       string    `gorm:"default:null"`
+	Status         string    `gorm:"default:null"`
+	ScopeId        string    `gorm:"default:null"`
+	TargetId       string    `gorm:"default:null"`
+	UserId         string    `gorm:"default:null"`
+	CreatedTime    time.Time `gorm:"default:null"`
+	ExpirationTime time.Time `gorm:"default:null"`
+	Item           string    `gorm:"default:null"`
 }
 
 func (*Session) TableName() string {
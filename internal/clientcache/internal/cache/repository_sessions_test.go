@@ -436,33 +436,37 @@ func TestRepository_QuerySessions(t *testing.T) {
 		})
 	}
 
+	now := time.Now().UTC().Truncate(time.Second)
 	ss := []*sessions.Session{
 		{
-			Id:       "ttcp_1",
-			Status:   "status1",
-			Endpoint: "address1",
-			ScopeId:  "p_123",
-			TargetId: "ttcp_123",
-			UserId:   "u_123",
-			Type:     "tcp",
+			Id:          "ttcp_1",
+			Status:      "status1",
+			Endpoint:    "address1",
+			ScopeId:     "p_123",
+			TargetId:    "ttcp_123",
+			UserId:      "u_123",
+			Type:        "tcp",
+			CreatedTime: now.Add(-3 * time.Hour),
 		},
 		{
-			Id:       "ttcp_2",
-			Status:   "status2",
-			Endpoint: "address2",
-			ScopeId:  "p_123",
-			TargetId: "ttcp_123",
-			UserId:   "u_123",
-			Type:     "tcp",
+			Id:          "ttcp_2",
+			Status:      "status2",
+			Endpoint:    "address2",
+			ScopeId:     "p_123",
+			TargetId:    "ttcp_123",
+			UserId:      "u_123",
+			Type:        "tcp",
+			CreatedTime: now.Add(-time.Hour),
 		},
 		{
-			Id:       "ttcp_3",
-			Status:   "status3",
-			Endpoint: "address3",
-			ScopeId:  "p_123",
-			TargetId: "ttcp_123",
-			UserId:   "u_123",
-			Type:     "tcp",
+			Id:          "ttcp_3",
+			Status:      "status3",
+			Endpoint:    "address3",
+			ScopeId:     "p_123",
+			TargetId:    "ttcp_123",
+			UserId:      "u_123",
+			Type:        "tcp",
+			CreatedTime: now.Add(-30 * time.Minute),
 		},
 	}
 	require.NoError(t, r.refreshSessions(ctx, u1, map[AuthToken]string{{Id: "id"}: "something"},
@@ -479,6 +483,29 @@ func TestRepository_QuerySessions(t *testing.T) {
 		assert.Len(t, l, 2)
 		assert.ElementsMatch(t, l, ss[0:2])
 	})
+	t.Run("created time", func(t *testing.T) {
+		l, err := r.QuerySessions(ctx, kt1.AuthTokenId, `created_time < "-2h" and scope_id = "p_123"`)
+		assert.NoError(t, err)
+		assert.Equal(t, ss[0:1], l)
+	})
+	t.Run("not", func(t *testing.T) {
+		l, err := r.QuerySessions(ctx, kt1.AuthTokenId, `not status = "status1"`)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, ss[1:], l)
+	})
+	t.Run("order limit and offset", func(t *testing.T) {
+		l, err := r.QuerySessions(ctx, kt1.AuthTokenId, `order by created_time desc`)
+		assert.NoError(t, err)
+		assert.Equal(t, []*sessions.Session{ss[2], ss[1], ss[0]}, l)
+
+		l, err = r.QuerySessions(ctx, kt1.AuthTokenId, `target_id = "ttcp_123" order by created_time desc limit 1 offset 1`)
+		assert.NoError(t, err)
+		assert.Equal(t, []*sessions.Session{ss[1]}, l)
+
+		l, err = r.QuerySessions(ctx, kt1.AuthTokenId, `order by id offset 3`)
+		assert.NoError(t, err)
+		assert.Empty(t, l)
+	})
 }
 
 func TestDefaultSessionRetrievalFunc(t *testing.T) {
@@ -8,6 +8,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -18,14 +22,18 @@ import (
 type SearchableResource string
 
 const (
-	Unknown  SearchableResource = "unknown"
-	Targets  SearchableResource = "targets"
-	Sessions SearchableResource = "sessions"
+	Unknown           SearchableResource = "unknown"
+	Targets           SearchableResource = "targets"
+	Sessions          SearchableResource = "sessions"
+	Hosts             SearchableResource = "hosts"
+	HostSets          SearchableResource = "host-sets"
+	Scopes            SearchableResource = "scopes"
+	SessionRecordings SearchableResource = "session-recordings"
 )
 
 func (r SearchableResource) Valid() bool {
 	switch r {
-	case Targets, Sessions:
+	case Targets, Sessions, Hosts, HostSets, Scopes, SessionRecordings:
 		return true
 	}
 	return false
@@ -37,6 +45,14 @@ func ToSearchableResource(s string) SearchableResource {
 		return Targets
 	case strings.EqualFold(s, string(Sessions)):
 		return Sessions
+	case strings.EqualFold(s, string(Hosts)):
+		return Hosts
+	case strings.EqualFold(s, string(HostSets)):
+		return HostSets
+	case strings.EqualFold(s, string(Scopes)):
+		return Scopes
+	case strings.EqualFold(s, string(SessionRecordings)):
+		return SessionRecordings
 	}
 	return Unknown
 }
@@ -47,7 +63,8 @@ type SearchParams struct {
 	Resource SearchableResource
 	// the auth token id for the user id that has resources synced to the cache
 	AuthTokenId string
-	// the optional mql query to use when searching the resources.
+	// the optional query to use when searching the resources. See query.go for
+	// the query syntax.
 	Query string
 	// the optional bexpr filter string that all results will be filtered by
 	Filter string
@@ -55,8 +72,12 @@ type SearchParams struct {
 
 // SearchResult returns the results from searching the cache.
 type SearchResult struct {
-	Targets  []*targets.Target
-	Sessions []*sessions.Session
+	Targets           []*targets.Target
+	Sessions          []*sessions.Session
+	Hosts             []*hosts.Host
+	HostSets          []*hostsets.HostSet
+	Scopes            []*scopes.Scope
+	SessionRecordings []*sessionrecordings.SessionRecording
 }
 
 // SearchService is a domain service that can search across all resources in the
@@ -89,6 +110,34 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 					return &SearchResult{Sessions: s}
 				},
 			},
+			Hosts: &resourceSearchFns[*hosts.Host]{
+				list:  repo.ListHosts,
+				query: repo.QueryHosts,
+				searchResult: func(h []*hosts.Host) *SearchResult {
+					return &SearchResult{Hosts: h}
+				},
+			},
+			HostSets: &resourceSearchFns[*hostsets.HostSet]{
+				list:  repo.ListHostSets,
+				query: repo.QueryHostSets,
+				searchResult: func(hs []*hostsets.HostSet) *SearchResult {
+					return &SearchResult{HostSets: hs}
+				},
+			},
+			Scopes: &resourceSearchFns[*scopes.Scope]{
+				list:  repo.ListScopes,
+				query: repo.QueryScopes,
+				searchResult: func(s []*scopes.Scope) *SearchResult {
+					return &SearchResult{Scopes: s}
+				},
+			},
+			SessionRecordings: &resourceSearchFns[*sessionrecordings.SessionRecording]{
+				list:  repo.ListSessionRecordings,
+				query: repo.QuerySessionRecordings,
+				searchResult: func(sr []*sessionrecordings.SessionRecording) *SearchResult {
+					return &SearchResult{SessionRecordings: sr}
+				},
+			},
 		},
 	}, nil
 }
@@ -152,8 +201,9 @@ type resourceSearchFns[T any] struct {
 	// query takes a context, an auth token, and a query string and returns all
 	// resources for that auth token that matches the provided query parameter.
 	// If the provided auth token is not in the cache an empty slice and no
-	// error is returned.
-	query func(context.Context, string, string) ([]T, error)
+	// error is returned. A filter passed in the options is applied before the
+	// limit and offset of the query.
+	query func(context.Context, string, string, ...Option) ([]T, error)
 	// searchResult is a function which provides a SearchResult based on the
 	// type of T. SearchResult contains different fields for the different
 	// resource types returned, so for example if T is *targets.Target the
@@ -179,33 +229,33 @@ type resourceSearcher interface {
 func (l *resourceSearchFns[T]) search(ctx context.Context, p SearchParams) (*SearchResult, error) {
 	const op = "daemon.(resourceSearchFns).search"
 
+	var matches func(item any) bool
+	if p.Filter != "" {
+		e, err := bexpr.CreateEvaluator(p.Filter, bexpr.WithTagName("json"))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
+		}
+		matches = func(item any) bool {
+			m, err := e.Evaluate(filterItem{item})
+			return err == nil && m
+		}
+	}
+
 	var found []T
 	var err error
 	switch p.Query {
 	case "":
 		found, err = l.list(ctx, p.AuthTokenId)
+		found = filterAndPage(found, options{withFilter: matches})
 	default:
-		found, err = l.query(ctx, p.AuthTokenId, p.Query)
+		// The query filters the results itself, since its limit and offset
+		// must be applied to the filtered results.
+		found, err = l.query(ctx, p.AuthTokenId, p.Query, withFilter(matches))
 	}
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-
-	if p.Filter == "" {
-		return l.searchResult(found), nil
-	}
-
-	e, err := bexpr.CreateEvaluator(p.Filter, bexpr.WithTagName("json"))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
-	}
-	finalResults := make([]T, 0, len(found))
-	for _, item := range found {
-		if m, err := e.Evaluate(filterItem{item}); err == nil && m {
-			finalResults = append(finalResults, item)
-		}
-	}
-	return l.searchResult(finalResults), nil
+	return l.searchResult(found), nil
 }
 
 type filterItem struct {
@@ -9,6 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	cachedb "github.com/hashicorp/boundary/internal/clientcache/internal/db"
@@ -214,6 +218,30 @@ func TestSearch(t *testing.T) {
 			&Session{FkUserId: u.Id, Id: "s_2", Endpoint: "two", Type: "ssh", UserId: "u321", Item: `{"id": "s_2", "endpoint": "two", "type": "ssh", "user_id": "u321"}`},
 		}
 		require.NoError(t, rw.CreateItems(ctx, sessions))
+
+		hosts := []any{
+			&Host{FkUserId: u.Id, Id: "h_1", Name: "one", HostCatalogId: "hc_1", Item: `{"id": "h_1", "name": "one", "host_catalog_id": "hc_1"}`},
+			&Host{FkUserId: u.Id, Id: "h_2", Name: "two", HostCatalogId: "hc_2", Item: `{"id": "h_2", "name": "two", "host_catalog_id": "hc_2"}`},
+		}
+		require.NoError(t, rw.CreateItems(ctx, hosts))
+
+		hostSets := []any{
+			&HostSet{FkUserId: u.Id, Id: "hs_1", Name: "one", HostCatalogId: "hc_1", Item: `{"id": "hs_1", "name": "one", "host_catalog_id": "hc_1"}`},
+			&HostSet{FkUserId: u.Id, Id: "hs_2", Name: "two", HostCatalogId: "hc_2", Item: `{"id": "hs_2", "name": "two", "host_catalog_id": "hc_2"}`},
+		}
+		require.NoError(t, rw.CreateItems(ctx, hostSets))
+
+		scopes := []any{
+			&Scope{FkUserId: u.Id, Id: "o_1", Name: "one", Type: "org", ScopeId: "global", Item: `{"id": "o_1", "name": "one", "type": "org", "scope_id": "global"}`},
+			&Scope{FkUserId: u.Id, Id: "p_1", Name: "two", Type: "project", ScopeId: "o_1", Item: `{"id": "p_1", "name": "two", "type": "project", "scope_id": "o_1"}`},
+		}
+		require.NoError(t, rw.CreateItems(ctx, scopes))
+
+		sessionRecordings := []any{
+			&SessionRecording{FkUserId: u.Id, Id: "sr_1", SessionId: "s_1", State: "available", Item: `{"id": "sr_1", "session_id": "s_1", "state": "available"}`},
+			&SessionRecording{FkUserId: u.Id, Id: "sr_2", SessionId: "s_2", State: "started", Item: `{"id": "sr_2", "session_id": "s_2", "state": "started"}`},
+		}
+		require.NoError(t, rw.CreateItems(ctx, sessionRecordings))
 	}
 
 	r, err := NewRepository(ctx, s, &sync.Map{},
@@ -296,6 +324,30 @@ func TestSearch(t *testing.T) {
 		}}, got)
 	})
 
+	t.Run("Filter targets before limit", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "targets",
+			AuthTokenId: at.Id,
+			Query:       `type="tcp" order by name limit 1`,
+			Filter:      `"/item/name" matches "two"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{Targets: []*targets.Target{
+			{Id: "t_2", Name: "two", Type: "tcp"},
+		}}, got)
+	})
+
+	t.Run("Filter targets before offset", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "targets",
+			AuthTokenId: at.Id,
+			Query:       `order by name offset 1`,
+			Filter:      `"/item/name" matches "two"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{}, got)
+	})
+
 	t.Run("List sessions", func(t *testing.T) {
 		got, err := ss.Search(ctx, SearchParams{
 			Resource:    "sessions",
@@ -355,6 +407,54 @@ func TestSearch(t *testing.T) {
 		}}, got)
 	})
 
+	t.Run("query hosts", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "hosts",
+			AuthTokenId: at.Id,
+			Query:       `host_catalog_id="hc_1"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{Hosts: []*hosts.Host{
+			{Id: "h_1", Name: "one", HostCatalogId: "hc_1"},
+		}}, got)
+	})
+
+	t.Run("Filter host sets", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "host-sets",
+			AuthTokenId: at.Id,
+			Filter:      `"/item/name" matches "two"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{HostSets: []*hostsets.HostSet{
+			{Id: "hs_2", Name: "two", HostCatalogId: "hc_2"},
+		}}, got)
+	})
+
+	t.Run("query scopes", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "scopes",
+			AuthTokenId: at.Id,
+			Query:       `type="project"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{Scopes: []*scopes.Scope{
+			{Id: "p_1", Name: "two", Type: "project", ScopeId: "o_1"},
+		}}, got)
+	})
+
+	t.Run("query session recordings", func(t *testing.T) {
+		got, err := ss.Search(ctx, SearchParams{
+			Resource:    "session-recordings",
+			AuthTokenId: at.Id,
+			Query:       `state="available"`,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, &SearchResult{SessionRecordings: []*sessionrecordings.SessionRecording{
+			{Id: "sr_1", SessionId: "s_1", State: "available"},
+		}}, got)
+	})
+
 	t.Run("unrecognized auth token", func(t *testing.T) {
 		got, err := ss.Search(ctx, SearchParams{
 			Resource:    "targets",
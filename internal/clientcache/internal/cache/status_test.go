@@ -129,6 +129,22 @@ func TestStatus(t *testing.T) {
 							Name:  string(sessionResourceType),
 							Count: 0,
 						},
+						{
+							Name:  string(hostResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(hostSetResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(scopeResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(sessionRecordingResourceType),
+							Count: 0,
+						},
 					},
 				},
 				{
@@ -153,6 +169,22 @@ func TestStatus(t *testing.T) {
 							Name:  string(sessionResourceType),
 							Count: 0,
 						},
+						{
+							Name:  string(hostResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(hostSetResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(scopeResourceType),
+							Count: 0,
+						},
+						{
+							Name:  string(sessionRecordingResourceType),
+							Count: 0,
+						},
 					},
 				},
 			},
@@ -212,19 +244,19 @@ func TestStatus(t *testing.T) {
 
 		assert.Equal(t, Map(got.Users[0].Resources, func(i ResourceStatus) string {
 			return i.Name
-		}), []string{string(targetResourceType), string(sessionResourceType)})
+		}), []string{string(targetResourceType), string(sessionResourceType), string(hostResourceType), string(hostSetResourceType), string(scopeResourceType), string(sessionRecordingResourceType)})
 
 		assert.Equal(t, Map(got.Users[0].Resources, func(i ResourceStatus) int {
 			return i.Count
-		}), []int{4, 3})
+		}), []int{4, 3, 0, 0, 0, 0})
 
 		assert.Equal(t, Map(got.Users[0].Resources, func(i ResourceStatus) bool {
 			return i.LastError == nil
-		}), []bool{false, true}, "expected an error for target resource and none for session resource")
+		}), []bool{false, true, true, true, true, true}, "expected an error for target resource and none for the other resources")
 
 		assert.Equal(t, Map(got.Users[0].Resources, func(i ResourceStatus) bool {
 			return i.RefreshToken == nil
-		}), []bool{false, false})
+		}), []bool{false, false, true, true, true, true})
 
 		// User 2 status
 		assert.Equal(t, Map(got.Users[1].AuthTokens, func(i AuthTokenStatus) string {
@@ -233,19 +265,19 @@ func TestStatus(t *testing.T) {
 
 		assert.Equal(t, Map(got.Users[1].Resources, func(i ResourceStatus) string {
 			return i.Name
-		}), []string{string(targetResourceType), string(sessionResourceType)})
+		}), []string{string(targetResourceType), string(sessionResourceType), string(hostResourceType), string(hostSetResourceType), string(scopeResourceType), string(sessionRecordingResourceType)})
 
 		assert.Equal(t, Map(got.Users[1].Resources, func(i ResourceStatus) int {
 			return i.Count
-		}), []int{2, 0})
+		}), []int{2, 0, 0, 0, 0, 0})
 
 		assert.Equal(t, Map(got.Users[1].Resources, func(i ResourceStatus) bool {
 			return i.LastError == nil
-		}), []bool{true, true})
+		}), []bool{true, true, true, true, true, true})
 
 		assert.Equal(t, Map(got.Users[1].Resources, func(i ResourceStatus) bool {
 			return i.RefreshToken == nil
-		}), []bool{false, true}, "targets expected to have a refresh token and sessions aren't")
+		}), []bool{false, true, true, true, true, true}, "targets expected to have a refresh token and other resources aren't")
 	})
 }
 
@@ -323,6 +355,22 @@ func TestStatus_unsupported(t *testing.T) {
 					Name:  string(sessionResourceType),
 					Count: 0,
 				},
+				{
+					Name:  string(hostResourceType),
+					Count: 0,
+				},
+				{
+					Name:  string(hostSetResourceType),
+					Count: 0,
+				},
+				{
+					Name:  string(scopeResourceType),
+					Count: 0,
+				},
+				{
+					Name:  string(sessionRecordingResourceType),
+					Count: 0,
+				},
 			},
 		},
 	})
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// changesKeepAliveInterval is how often a comment is written to an otherwise
+// idle change stream so clients which have gone away are noticed.
+const changesKeepAliveInterval = 30 * time.Second
+
+// ResourceChange is sent as the data of each event in the change stream.
+type ResourceChange struct {
+	// The searchable resource which changed, such as "targets" or "sessions"
+	Resource string `json:"resource"`
+	// How the resource changed; one of "added", "updated" or "removed"
+	Type string `json:"type"`
+	// The id of the resource which changed
+	Id string `json:"id"`
+	// The resource as returned by boundary. For removed resources this is the
+	// last value which was cached.
+	Item json.RawMessage `json:"item,omitempty"`
+}
+
+// newChangesHandlerFunc returns a handler which streams the changes made to the
+// cached targets and sessions of the user owning the provided auth token as
+// server-sent events. The changes are sent after each refresh of the cache.
+// The stream stays open until the client disconnects, the server shuts down,
+// or the client falls too far behind in reading changes, in which case it
+// should reconnect and search the cache to catch up.
+func newChangesHandlerFunc(ctx context.Context, repo *cache.Repository, notifier *cache.ChangeNotifier) (http.HandlerFunc, error) {
+	const op = "daemon.newChangesHandlerFunc"
+	switch {
+	case util.IsNil(repo):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "repository is missing")
+	case util.IsNil(notifier):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "change notifier is missing")
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodGet {
+			writeError(w, fmt.Sprintf("unsupported method %q", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		authTokenId := r.URL.Query().Get(authTokenIdKey)
+		if authTokenId == "" {
+			writeError(w, fmt.Sprintf("%s is a required field but was empty", authTokenIdKey), http.StatusBadRequest)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeError(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		t, err := repo.LookupToken(ctx, authTokenId, cache.WithUpdateLastAccessedTime(true))
+		if err != nil || t == nil {
+			writeError(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		changes, err := notifier.Subscribe(ctx, t.UserId)
+		if err != nil {
+			writeError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(changesKeepAliveInterval)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case c, ok := <-changes:
+				if !ok {
+					// The subscription was closed because this client isn't
+					// keeping up with the changes.
+					return
+				}
+				if err := writeChangeEvent(w, c); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("when writing change event", "auth_token_id", authTokenId))
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	}, nil
+}
+
+// writeChangeEvent writes the change as a server-sent event named "change".
+func writeChangeEvent(w http.ResponseWriter, c cache.ResourceChange) error {
+	rc := ResourceChange{
+		Resource: string(c.Resource),
+		Type:     string(c.Type),
+		Id:       c.Id,
+	}
+	if c.Item != "" {
+		rc.Item = json.RawMessage(c.Item)
+	}
+	b, err := json.Marshal(rc)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: change\ndata: %s\n\n", b)
+	return err
+}
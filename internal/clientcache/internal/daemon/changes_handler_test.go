@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
+	cachedb "github.com/hashicorp/boundary/internal/clientcache/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangesHandler(t *testing.T) {
+	ctx := context.Background()
+	s, err := cachedb.Open(ctx)
+	require.NoError(t, err)
+
+	at := &authtokens.AuthToken{
+		Id:             "at_1",
+		Token:          "at_1_token",
+		UserId:         "user",
+		ExpirationTime: time.Now().Add(time.Hour),
+	}
+	atMap := map[ringToken]*authtokens.AuthToken{
+		{"k", "t"}: at,
+	}
+	n := cache.NewChangeNotifier()
+	r, err := cache.NewRepository(ctx, s, &sync.Map{}, mapBasedAuthTokenKeyringLookup(atMap), sliceBasedAuthTokenBoundaryReader([]*authtokens.AuthToken{at}), cache.WithChangeNotifier(n))
+	require.NoError(t, err)
+	require.NoError(t, r.AddKeyringToken(ctx, "http://127.0.0.1", cache.KeyringToken{
+		KeyringType: "k",
+		TokenName:   "t",
+		AuthTokenId: at.Id,
+	}))
+
+	h, err := newChangesHandlerFunc(ctx, r, n)
+	require.NoError(t, err)
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	t.Run("missing auth token id", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/v1/changes")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		apiErr := &api.Error{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(apiErr))
+		assert.Contains(t, apiErr.Message, "auth_token_id is a required field but was empty")
+	})
+
+	t.Run("unknown auth token", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/v1/changes?auth_token_id=at_unknown")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("streams changes", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/changes?auth_token_id="+at.Id, nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		tar := &targets.Target{Id: "ttcp_1", Name: "target"}
+		rs, err := cache.NewRefreshService(ctx, r, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, rs.Refresh(ctx,
+			cache.WithTargetRetrievalFunc(func(context.Context, string, string, cache.RefreshTokenValue) ([]*targets.Target, []string, cache.RefreshTokenValue, error) {
+				return []*targets.Target{tar}, nil, "refresh", nil
+			}),
+			cache.WithSessionRetrievalFunc(emptyRetrievalFunc[*sessions.Session](at)),
+			cache.WithHostRetrievalFunc(emptyRetrievalFunc[*hosts.Host](at)),
+			cache.WithHostSetRetrievalFunc(emptyRetrievalFunc[*hostsets.HostSet](at)),
+			cache.WithScopeRetrievalFunc(emptyRetrievalFunc[*scopes.Scope](at)),
+			cache.WithSessionRecordingRetrievalFunc(emptyRetrievalFunc[*sessionrecordings.SessionRecording](at))))
+
+		sc := bufio.NewScanner(resp.Body)
+		require.True(t, sc.Scan())
+		assert.Equal(t, "event: change", sc.Text())
+		require.True(t, sc.Scan())
+		data, ok := strings.CutPrefix(sc.Text(), "data: ")
+		require.True(t, ok)
+
+		var got ResourceChange
+		require.NoError(t, json.Unmarshal([]byte(data), &got))
+		assert.Equal(t, "targets", got.Resource)
+		assert.Equal(t, "added", got.Type)
+		assert.Equal(t, tar.Id, got.Id)
+		var gotTar targets.Target
+		require.NoError(t, json.Unmarshal(got.Item, &gotTar))
+		assert.Equal(t, tar.Name, gotTar.Name)
+	})
+}
@@ -11,6 +11,10 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
@@ -21,8 +25,12 @@ import (
 
 // SearchResult is the struct returned to search requests.
 type SearchResult struct {
-	Targets  []*targets.Target   `json:"targets,omitempty"`
-	Sessions []*sessions.Session `json:"sessions,omitempty"`
+	Targets           []*targets.Target                     `json:"targets,omitempty"`
+	Sessions          []*sessions.Session                   `json:"sessions,omitempty"`
+	Hosts             []*hosts.Host                         `json:"hosts,omitempty"`
+	HostSets          []*hostsets.HostSet                   `json:"host_sets,omitempty"`
+	Scopes            []*scopes.Scope                       `json:"scopes,omitempty"`
+	SessionRecordings []*sessionrecordings.SessionRecording `json:"session_recordings,omitempty"`
 }
 
 const (
@@ -132,8 +140,12 @@ func newSearchHandlerFunc(ctx context.Context, repo *cache.Repository, refreshSe
 // toApiResult converts a domain search result to an api search result
 func toApiResult(sr *cache.SearchResult) *SearchResult {
 	return &SearchResult{
-		Targets:  sr.Targets,
-		Sessions: sr.Sessions,
+		Targets:           sr.Targets,
+		Sessions:          sr.Sessions,
+		Hosts:             sr.Hosts,
+		HostSets:          sr.HostSets,
+		Scopes:            sr.Scopes,
+		SessionRecordings: sr.SessionRecordings,
 	}
 }
 
@@ -63,6 +63,9 @@ type CacheServer struct {
 
 	storeUrl string
 	store    *db.DB
+	// changes is shared by every repository operating on the store so the
+	// changes they make to cached resources can be streamed to clients.
+	changes *cache.ChangeNotifier
 
 	tickerWg *sync.WaitGroup
 	httpSrv  *http.Server
@@ -119,6 +122,7 @@ func New(ctx context.Context, conf *Config) (*CacheServer, error) {
 		conf:         conf,
 		info:         make(map[string]string),
 		infoKeys:     make([]string, 0, 20),
+		changes:      cache.NewChangeNotifier(),
 		tickerWg:     new(sync.WaitGroup),
 		shutdownOnce: new(sync.Once),
 	}
@@ -260,7 +264,7 @@ func (s *CacheServer) Serve(ctx context.Context, cmd Commander, opt ...Option) e
 
 	s.printInfo(ctx)
 
-	repo, err := cache.NewRepository(ctx, s.store, &sync.Map{}, cmd.ReadTokenFromKeyring, opts.withBoundaryTokenReaderFunc)
+	repo, err := cache.NewRepository(ctx, s.store, &sync.Map{}, cmd.ReadTokenFromKeyring, opts.withBoundaryTokenReaderFunc, cache.WithChangeNotifier(s.changes))
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -318,6 +322,12 @@ func (s *CacheServer) Serve(ctx context.Context, cmd Commander, opt ...Option) e
 	}
 	mux.Handle("/v1/stop", serverMetadataInterceptor(stopFn, s.conf.RunningInBackground))
 
+	changesFn, err := newChangesHandlerFunc(ctx, repo, s.changes)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	mux.Handle("/v1/changes", serverMetadataInterceptor(changesFn, s.conf.RunningInBackground))
+
 	// Return custom 404 message when requests don't map to any known path.
 	mux.Handle("/", serverMetadataInterceptor(new404Func(ctx), s.conf.RunningInBackground))
 
@@ -10,6 +10,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
@@ -71,7 +75,7 @@ func (s *TestServer) Serve(t *testing.T, opt ...Option) error {
 func (s *TestServer) AddKeyringToken(t *testing.T, address, keyring, tokenName, tokenId string, atReadFn cache.BoundaryTokenReaderFn) {
 	t.Helper()
 	ctx := context.Background()
-	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn)
+	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn, cache.WithChangeNotifier(s.CacheServer.changes))
 	require.NoError(t, err)
 
 	require.NoError(t, r.AddKeyringToken(ctx, address, cache.KeyringToken{
@@ -86,7 +90,7 @@ func (s *TestServer) AddKeyringToken(t *testing.T, address, keyring, tokenName,
 func (s *TestServer) AddResources(t *testing.T, p *authtokens.AuthToken, tars []*targets.Target, sess []*sessions.Session, atReadFn cache.BoundaryTokenReaderFn) {
 	t.Helper()
 	ctx := context.Background()
-	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn)
+	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn, cache.WithChangeNotifier(s.CacheServer.changes))
 	require.NoError(t, err)
 
 	tarFn := func(ctx context.Context, _, tok string, _ cache.RefreshTokenValue) ([]*targets.Target, []string, cache.RefreshTokenValue, error) {
@@ -103,7 +107,13 @@ func (s *TestServer) AddResources(t *testing.T, p *authtokens.AuthToken, tars []
 	}
 	rs, err := cache.NewRefreshService(ctx, r, 0, 0)
 	require.NoError(t, err)
-	require.NoError(t, rs.Refresh(ctx, cache.WithTargetRetrievalFunc(tarFn), cache.WithSessionRetrievalFunc(sessFn)))
+	require.NoError(t, rs.Refresh(ctx,
+		cache.WithTargetRetrievalFunc(tarFn),
+		cache.WithSessionRetrievalFunc(sessFn),
+		cache.WithHostRetrievalFunc(emptyRetrievalFunc[*hosts.Host](p)),
+		cache.WithHostSetRetrievalFunc(emptyRetrievalFunc[*hostsets.HostSet](p)),
+		cache.WithScopeRetrievalFunc(emptyRetrievalFunc[*scopes.Scope](p)),
+		cache.WithSessionRecordingRetrievalFunc(emptyRetrievalFunc[*sessionrecordings.SessionRecording](p))))
 }
 
 // AddUnsupportedCachingData provides data in a way that simulates it coming from
@@ -113,7 +123,7 @@ func (s *TestServer) AddResources(t *testing.T, p *authtokens.AuthToken, tars []
 func (s *TestServer) AddUnsupportedCachingData(t *testing.T, p *authtokens.AuthToken, atReadFn cache.BoundaryTokenReaderFn) {
 	t.Helper()
 	ctx := context.Background()
-	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn)
+	r, err := cache.NewRepository(ctx, s.CacheServer.store, &sync.Map{}, s.cmd.ReadTokenFromKeyring, atReadFn, cache.WithChangeNotifier(s.CacheServer.changes))
 	require.NoError(t, err)
 
 	tarFn := func(ctx context.Context, _, tok string, _ cache.RefreshTokenValue) ([]*targets.Target, []string, cache.RefreshTokenValue, error) {
@@ -132,6 +142,37 @@ func (s *TestServer) AddUnsupportedCachingData(t *testing.T, p *authtokens.AuthT
 	}
 	rs, err := cache.NewRefreshService(ctx, r, 0, 0)
 	require.NoError(t, err)
-	err = rs.Refresh(ctx, cache.WithTargetRetrievalFunc(tarFn), cache.WithSessionRetrievalFunc(sessFn))
+	err = rs.Refresh(ctx,
+		cache.WithTargetRetrievalFunc(tarFn),
+		cache.WithSessionRetrievalFunc(sessFn),
+		cache.WithHostRetrievalFunc(unsupportedRetrievalFunc[*hosts.Host](p)),
+		cache.WithHostSetRetrievalFunc(unsupportedRetrievalFunc[*hostsets.HostSet](p)),
+		cache.WithScopeRetrievalFunc(unsupportedRetrievalFunc[*scopes.Scope](p)),
+		cache.WithSessionRecordingRetrievalFunc(unsupportedRetrievalFunc[*sessionrecordings.SessionRecording](p)))
 	require.ErrorContains(t, err, "not supported for this controller")
 }
+
+// emptyRetrievalFunc returns a resource retrieval function which returns no
+// resources and a refresh token for the provided auth token, simulating a
+// boundary instance that supports refresh tokens but has no resources of that
+// type.
+func emptyRetrievalFunc[T any](p *authtokens.AuthToken) func(context.Context, string, string, cache.RefreshTokenValue) ([]T, []string, cache.RefreshTokenValue, error) {
+	return func(_ context.Context, _, tok string, _ cache.RefreshTokenValue) ([]T, []string, cache.RefreshTokenValue, error) {
+		if tok != p.Token {
+			return nil, nil, "", nil
+		}
+		return []T{}, nil, "empty", nil
+	}
+}
+
+// unsupportedRetrievalFunc returns a resource retrieval function which
+// simulates a boundary instance that does not support refresh tokens for the
+// provided auth token.
+func unsupportedRetrievalFunc[T any](p *authtokens.AuthToken) func(context.Context, string, string, cache.RefreshTokenValue) ([]T, []string, cache.RefreshTokenValue, error) {
+	return func(_ context.Context, _, tok string, _ cache.RefreshTokenValue) ([]T, []string, cache.RefreshTokenValue, error) {
+		if tok != p.Token {
+			return nil, nil, "", nil
+		}
+		return nil, nil, "", cache.ErrRefreshNotSupported
+	}
+}
@@ -17,14 +17,18 @@ create table if not exists user (
 create table if not exists resource_type_enm(
   string text not null primary key
     constraint only_predefined_resource_types_allowed
-    check(string in ('unknown', 'target', 'session'))
+    check(string in ('unknown', 'target', 'session', 'host', 'host_set', 'scope', 'session_recording'))
 );
 
 insert into resource_type_enm (string)
 values
   ('unknown'),
   ('target'),
-  ('session');
+  ('session'),
+  ('host'),
+  ('host_set'),
+  ('scope'),
+  ('session_recording');
 
 -- Contains refresh tokens for list requests sent by the client daemon to the
 -- boundary instance.
@@ -149,6 +153,104 @@ create table if not exists session (
   -- be different from the fk_user_id which is the id of the boundary user
   -- which synced this record into the cache.
   user_id text,
+  created_time timestamp,
+  expiration_time timestamp,
+  -- item is the json representation of this resource from the perspective of
+  -- of the user whose id is set in fk_user_id
+  item text,
+  primary key (fk_user_id, id)
+);
+
+-- host contains cached boundary host resource for a specific user and with
+-- specific fields extracted to facilitate searching over those fields
+create table if not exists host (
+  -- the boundary user id of the user who has was able to read/list this host
+  fk_user_id text not null
+    references user(id)
+    on delete cascade,
+  -- the boundary id of the host
+  id text not null
+    check (length(id) > 0),
+  -- the following fields are used for searching and are set to the values
+  -- from the boundary resource
+  name text,
+  description text,
+  type text,
+  host_catalog_id text,
+  scope_id text,
+  external_id text,
+  external_name text,
+  -- item is the json representation of this resource from the perspective of
+  -- the the requesting user.
+  item text,
+  primary key (fk_user_id, id)
+);
+
+-- host_set contains cached boundary host set resource for a specific user and
+-- with specific fields extracted to facilitate searching over those fields
+create table if not exists host_set (
+  -- the boundary user id of the user who has was able to read/list this host set
+  fk_user_id text not null
+    references user(id)
+    on delete cascade,
+  -- the boundary id of the host set
+  id text not null
+    check (length(id) > 0),
+  -- the following fields are used for searching and are set to the values
+  -- from the boundary resource
+  name text,
+  description text,
+  type text,
+  host_catalog_id text,
+  scope_id text,
+  -- item is the json representation of this resource from the perspective of
+  -- the the requesting user.
+  item text,
+  primary key (fk_user_id, id)
+);
+
+-- scope contains cached boundary scope resource for a specific user and with
+-- specific fields extracted to facilitate searching over those fields
+create table if not exists scope (
+  -- the boundary user id of the user who has was able to read/list this scope
+  fk_user_id text not null
+    references user(id)
+    on delete cascade,
+  -- the boundary id of the scope
+  id text not null
+    check (length(id) > 0),
+  -- the following fields are used for searching and are set to the values
+  -- from the boundary resource
+  name text,
+  description text,
+  type text,
+  -- scope_id is the id of the parent scope of this scope
+  scope_id text,
+  -- item is the json representation of this resource from the perspective of
+  -- the the requesting user.
+  item text,
+  primary key (fk_user_id, id)
+);
+
+-- session_recording contains cached boundary session recording resource for a
+-- specific user and with specific fields extracted to facilitate searching
+-- over those fields
+create table if not exists session_recording (
+  -- the boundary user id of the user who has was able to read/list this resource
+  fk_user_id text not null
+    references user(id)
+    on delete cascade,
+  -- the boundary id of the session recording
+  id text not null
+    check (length(id) > 0),
+  -- the following fields are used for searching and are set to the values
+  -- from the boundary resource
+  type text,
+  state text,
+  endpoint text,
+  session_id text,
+  storage_bucket_id text,
+  scope_id text,
   -- item is the json representation of this resource from the perspective of
   -- of the user whose id is set in fk_user_id
   item text,
@@ -147,7 +147,7 @@ type Command struct {
 // New returns a new instance of a base.Command type
 func NewCommand(ui cli.Ui, opt ...Option) *Command {
 	opts := GetOpts(opt...)
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(rootContext)
 	ret := &Command{
 		UI:         ui,
 		ShutdownCh: MakeShutdownCh(),
@@ -214,6 +214,9 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 	if c.FlagOutputCurlString {
 		config.OutputCurlString = c.FlagOutputCurlString
 	}
+	for k, v := range c.TraceHeaders() {
+		config.Headers[k] = v
+	}
 
 	c.client, err = api.NewClient(config)
 	if err != nil {
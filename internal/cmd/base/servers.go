@@ -30,6 +30,7 @@ import (
 	berrors "github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
@@ -242,6 +243,35 @@ func (b *Server) SetupEventing(ctx context.Context, logger hclog.Logger, seriali
 	return nil
 }
 
+// SetupTracing will setup exporting spans to the OTLP collector configured in
+// conf, if any, as the named service. The exporter is flushed and stopped by
+// RunShutdownFuncs.
+func (b *Server) SetupTracing(ctx context.Context, conf *config.Tracing, serviceName string) error {
+	const op = "base.(Server).SetupTracing"
+	if conf == nil {
+		return nil
+	}
+	opts := []tracing.Option{
+		tracing.WithEndpoint(conf.OtlpEndpoint),
+		tracing.WithInsecure(conf.OtlpInsecure),
+	}
+	if conf.SampleRatio != nil {
+		opts = append(opts, tracing.WithSampleRatio(*conf.SampleRatio))
+	}
+	shutdown, err := tracing.Setup(ctx, serviceName, opts...)
+	if err != nil {
+		return berrors.Wrap(ctx, err, op, berrors.WithMsg("unable to setup tracing"))
+	}
+	b.ShutdownFuncs = append(b.ShutdownFuncs, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return shutdown(ctx)
+	})
+	b.InfoKeys = append(b.InfoKeys, "tracing otlp endpoint")
+	b.Info["tracing otlp endpoint"] = conf.OtlpEndpoint
+	return nil
+}
+
 // AddEventerToContext will add the server eventer to the context provided
 func (b *Server) AddEventerToContext(ctx context.Context) (context.Context, error) {
 	const op = "base.(Server).AddEventerToContext"
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package base
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvBoundaryCLITracingOtlpEndpoint is the host and port of an OTLP/HTTP
+	// collector to export the spans of CLI invocations to, e.g.
+	// "localhost:4318". Tracing is disabled if it is not set.
+	EnvBoundaryCLITracingOtlpEndpoint = `BOUNDARY_CLI_TRACING_OTLP_ENDPOINT`
+	// EnvBoundaryCLITracingOtlpInsecure exports spans over plain HTTP instead
+	// of HTTPS when set to true.
+	EnvBoundaryCLITracingOtlpInsecure = `BOUNDARY_CLI_TRACING_OTLP_INSECURE`
+)
+
+// rootContext is the context commands are created with. It carries the span
+// of the CLI invocation when tracing is enabled.
+var rootContext = context.Background()
+
+// SetupCliTracing starts a span for the CLI invocation with the given args if
+// EnvBoundaryCLITracingOtlpEndpoint is set. Requests made by commands are
+// part of the span's trace. The returned function ends the span and flushes
+// it to the collector; it must be called before the CLI exits.
+func SetupCliTracing(args []string) func() {
+	endpoint := os.Getenv(EnvBoundaryCLITracingOtlpEndpoint)
+	if endpoint == "" {
+		return func() {}
+	}
+	insecure, _ := strconv.ParseBool(os.Getenv(EnvBoundaryCLITracingOtlpInsecure))
+	shutdown, err := tracing.Setup(context.Background(), "boundary-cli",
+		tracing.WithEndpoint(endpoint),
+		tracing.WithInsecure(insecure))
+	if err != nil {
+		// Tracing is a diagnostic aid; it should never prevent the command
+		// from running.
+		return func() {}
+	}
+
+	// Only the subcommand names are used for the span name since flag
+	// values may contain secrets.
+	name := []string{"boundary"}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		name = append(name, arg)
+	}
+	var span trace.Span
+	rootContext, span = tracing.StartSpan(context.Background(), strings.Join(name, " "))
+	return func() {
+		span.End()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = shutdown(ctx)
+	}
+}
+
+// TraceHeaders returns the headers that propagate the trace of the CLI
+// invocation, or nil if tracing is disabled.
+func (c *Command) TraceHeaders() http.Header {
+	if !tracing.Enabled() {
+		return nil
+	}
+	h := http.Header{}
+	tracing.InjectHeaders(c.Context, h)
+	return h
+}
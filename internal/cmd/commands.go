@@ -52,6 +52,7 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			return &server.Command{
 				Server:    base.NewServer(base.NewServerCommand(serverCmdUi)),
 				SighupCh:  base.MakeSighupCh(),
+				SigUSR1Ch: MakeSigUSR1Ch(),
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
@@ -59,6 +60,7 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			return &dev.Command{
 				Server:    base.NewServer(base.NewServerCommand(serverCmdUi)),
 				SighupCh:  base.MakeSighupCh(),
+				SigUSR1Ch: MakeSigUSR1Ch(),
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
@@ -300,6 +302,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "kube",
 			}),
+		"connect mongo": clientCacheWrapper(
+			&connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "mongo",
+			}),
+		"connect mysql": clientCacheWrapper(
+			&connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "mysql",
+			}),
 		"connect postgres": clientCacheWrapper(
 			&connect.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -310,11 +322,47 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "rdp",
 			}),
+		"connect redis": clientCacheWrapper(
+			&connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "redis",
+			}),
 		"connect ssh": clientCacheWrapper(
 			&connect.Command{
 				Command: base.NewCommand(ui, opts...),
 				Func:    "ssh",
 			}),
+		"connect multi": clientCacheWrapper(
+			&connect.MultiCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"connect socks": clientCacheWrapper(
+			&connect.SocksCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"connect profiles": func() (cli.Command, error) {
+			return &connect.ProfilesCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"connect profiles list": func() (cli.Command, error) {
+			return &connect.ProfilesCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list",
+			}, nil
+		},
+		"connect profiles save": func() (cli.Command, error) {
+			return &connect.ProfilesCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "save",
+			}, nil
+		},
+		"connect profiles delete": func() (cli.Command, error) {
+			return &connect.ProfilesCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "delete",
+			}, nil
+		},
 
 		"database": func() (cli.Command, error) {
 			return &database.Command{
@@ -443,6 +491,10 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credential-stores health": clientCacheWrapper(
+			&credentialstorescmd.HealthCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -722,6 +774,10 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"hosts import": clientCacheWrapper(
+			&hostscmd.ImportCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 
 		"logout": func() (cli.Command, error) {
 			return &logout.LogoutCommand{
@@ -995,6 +1051,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "cancel",
 			}),
+		"sessions extend": clientCacheWrapper(
+			&sessionscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "extend",
+			}),
+		"sessions cancel-connection": clientCacheWrapper(
+			&sessionscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "cancel-connection",
+			}),
 
 		"session-recordings": func() (cli.Command, error) {
 			return &sessionrecordingscmd.Command{
@@ -1141,6 +1207,14 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "set-credential-sources",
 			}),
+		"targets export-ssh-config": clientCacheWrapper(
+			&targetscmd.ExportSshConfigCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"targets export-kubeconfig": clientCacheWrapper(
+			&targetscmd.ExportKubeconfigCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 
 		"update": func() (cli.Command, error) {
 			return &genericcmd.Command{
@@ -1250,6 +1324,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "remove-worker-tags",
 			}),
+		"workers drain": clientCacheWrapper(
+			&workerscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "drain",
+			}),
 		"workers certificate-authority": clientCacheWrapper(
 			&workerscmd.WorkerCACommand{
 				Command: base.NewCommand(ui, opts...),
@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/boundary/api"
 	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/mitchellh/cli"
@@ -60,15 +61,18 @@ var (
 type Command struct {
 	*base.Command
 
-	flagAuthzToken string
-	flagListenAddr string
-	flagListenPort int
-	flagTargetId   string
-	flagTargetName string
-	flagHostId     string
-	flagExec       string
-	flagUsername   string
-	flagDbname     string
+	flagAuthzToken  string
+	flagListenAddr  string
+	flagListenPort  int
+	flagTargetId    string
+	flagTargetName  string
+	flagHostId      string
+	flagExec        string
+	flagUsername    string
+	flagDbname      string
+	flagAutoExtend  bool
+	flagReauthorize bool
+	flagProfile     string
 
 	// HTTP
 	httpFlags
@@ -76,9 +80,18 @@ type Command struct {
 	// Kube
 	kubeFlags
 
+	// MongoDB
+	mongoFlags
+
+	// MySQL
+	mysqlFlags
+
 	// Postgres
 	postgresFlags
 
+	// Redis
+	redisFlags
+
 	// RDP
 	rdpFlags
 
@@ -111,6 +124,12 @@ func (c *Command) Synopsis() string {
 		return sshSynopsis
 	case "kube":
 		return kubeSynopsis
+	case "mysql":
+		return mysqlSynopsis
+	case "redis":
+		return redisSynopsis
+	case "mongo":
+		return mongoSynopsis
 	default:
 		return ""
 	}
@@ -200,6 +219,27 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:      "Target scope name, if authorizing the session via scope parameters and target name. Mutually exclusive with -scope-id.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:       "profile",
+		Target:     &c.flagProfile,
+		EnvVar:     "BOUNDARY_CONNECT_PROFILE",
+		Completion: complete.PredictAnything,
+		Usage:      `The name of a saved connect profile to take options and arguments from. Options given on the command line take precedence over the ones in the profile. Profiles are managed with "boundary connect profiles".`,
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "auto-extend",
+		Target: &c.flagAutoExtend,
+		Usage:  `If set, the session is extended shortly before it expires for as long as the command runs. Requires the "extend:self" action to be granted on the session.`,
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "reauthorize",
+		Target: &c.flagReauthorize,
+		EnvVar: "BOUNDARY_CONNECT_REAUTHORIZE",
+		Usage:  `If set, a new session is authorized against the target whenever the current one expires or runs out of connections, and connections keep being accepted on the same local address. Connections made through a session still end along with it. Credentials brokered for a new session are printed, but a command run by -exec or a helper subcommand keeps using the credentials of the first session. Cannot be used with -authz-token.`,
+	})
+
 	switch c.Func {
 	case "connect":
 		f.StringVar(&base.StringVar{
@@ -232,6 +272,15 @@ func (c *Command) Flags() *base.FlagSets {
 
 	case "kube":
 		kubeOptions(c, set)
+
+	case "mysql":
+		mysqlOptions(c, set)
+
+	case "redis":
+		redisOptions(c, set)
+
+	case "mongo":
+		mongoOptions(c, set)
 	}
 
 	return set
@@ -255,6 +304,33 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 	}
 
+	// The profile has to be applied before the flags are created since it
+	// can change which subcommand's flags are used.
+	profileName := profileFlagValue(args)
+	if profileName == "" {
+		profileName = os.Getenv("BOUNDARY_CONNECT_PROFILE")
+	}
+	if profileName != "" {
+		p, err := lookupProfile(profileName)
+		if err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+		switch {
+		case p.Subcommand == "", p.Subcommand == c.Func:
+		case c.Func == "connect" && validProfileSubcommand(p.Subcommand):
+			c.Func = p.Subcommand
+		default:
+			c.PrintCliError(fmt.Errorf("The connect profile %q is for %q and cannot be used with %q", profileName, "boundary connect "+p.Subcommand, "boundary connect "+c.Func))
+			return base.CommandUserError
+		}
+		// Flags given later take precedence, so the profile's go first.
+		args = append(p.flagArgs(), args...)
+		if passthroughArgs == nil {
+			passthroughArgs = p.Args
+		}
+	}
+
 	f := c.Flags()
 
 	if err := f.Parse(args); err != nil {
@@ -279,6 +355,9 @@ func (c *Command) Run(args []string) (retCode int) {
 		case c.flagTargetName != "":
 			c.PrintCliError(errors.New(`-target-name and -authz-token cannot both be specified`))
 			return base.CommandUserError
+		case c.flagReauthorize:
+			c.PrintCliError(errors.New(`-reauthorize and -authz-token cannot both be specified`))
+			return base.CommandUserError
 		}
 	default:
 		if c.flagTargetId == "" &&
@@ -306,10 +385,17 @@ func (c *Command) Run(args []string) (retCode int) {
 			c.flagExec = c.rdpFlags.defaultExec()
 		case "kube":
 			c.flagExec = c.kubeFlags.defaultExec()
+		case "mysql":
+			c.flagExec = c.mysqlFlags.defaultExec()
+		case "redis":
+			c.flagExec = c.redisFlags.defaultExec()
+		case "mongo":
+			c.flagExec = c.mongoFlags.defaultExec()
 		}
 	}
 
 	authzString := c.flagAuthzToken
+	var targetClient *targets.Client
 	switch {
 	case authzString != "":
 		if authzString == "-" {
@@ -367,23 +453,9 @@ func (c *Command) Run(args []string) (retCode int) {
 			c.PrintCliError(fmt.Errorf("Error creating API client: %s", err))
 			return base.CommandCliError
 		}
-		targetClient := targets.NewClient(client)
-
-		var opts []targets.Option
-		if len(c.flagHostId) != 0 {
-			opts = append(opts, targets.WithHostId(c.flagHostId))
-		}
-		if len(c.flagTargetName) > 0 {
-			opts = append(opts, targets.WithName(c.flagTargetName))
-		}
-		if len(c.FlagScopeId) > 0 {
-			opts = append(opts, targets.WithScopeId(c.FlagScopeId))
-		}
-		if len(c.FlagScopeName) > 0 {
-			opts = append(opts, targets.WithScopeName(c.FlagScopeName))
-		}
+		targetClient = targets.NewClient(client)
 
-		sar, err := targetClient.AuthorizeSession(c.Context, c.flagTargetId, opts...)
+		sar, err := targetClient.AuthorizeSession(c.Context, c.flagTargetId, c.authorizeOptions()...)
 		if err != nil {
 			if apiErr := api.AsServerError(err); apiErr != nil {
 				c.PrintApiError(apiErr, "Error from controller when performing authorize-session action against given target")
@@ -420,10 +492,36 @@ func (c *Command) Run(args []string) (retCode int) {
 	listenAddr = netip.AddrPortFrom(addr, uint16(c.flagListenPort))
 
 	connsLeftCh := make(chan int32)
-	apiProxyOpts := []apiproxy.Option{apiproxy.WithConnectionsLeftCh(connsLeftCh)}
+	apiProxyOpts := []apiproxy.Option{
+		apiproxy.WithConnectionsLeftCh(connsLeftCh),
+		apiproxy.WithHeaders(c.TraceHeaders()),
+	}
 	if listenAddr.IsValid() {
 		apiProxyOpts = append(apiProxyOpts, apiproxy.WithListenAddrPort(listenAddr))
 	}
+	var listener *sharedListener
+	if c.flagReauthorize {
+		// The listener is opened here rather than by the client proxy so that
+		// the client proxies of later sessions can keep using it.
+		if listenAddr.Port() == 0 {
+			// Like the client proxy, use the target's default client port when
+			// no port was given.
+			sad, err := targets.SessionAuthorization{AuthorizationToken: authzString}.GetSessionAuthorizationData()
+			if err != nil {
+				c.PrintCliError(fmt.Errorf("Error decoding session authorization data: %w", err))
+				return base.CommandCliError
+			}
+			listenAddr = netip.AddrPortFrom(addr, uint16(sad.DefaultClientPort))
+		}
+		ln, err := net.ListenTCP("tcp", net.TCPAddrFromAddrPort(listenAddr))
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Unable to start listening: %w", err))
+			return base.CommandCliError
+		}
+		listener = newSharedListener(ln)
+		defer listener.Close()
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithListener(listener.view()))
+	}
 	clientProxy, err := apiproxy.New(
 		c.proxyCtx,
 		authzString,
@@ -435,30 +533,51 @@ func (c *Command) Run(args []string) (retCode int) {
 	}
 	c.sessInfo.Expiration = clientProxy.SessionExpiration()
 
+	var sessionClient *sessions.Client
+	if c.flagAutoExtend {
+		client, err := c.Client()
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error creating API client: %s", err))
+			return base.CommandCliError
+		}
+		sessionClient = sessions.NewClient(client)
+	}
+
 	clientProxyCloseCh := make(chan struct{})
 	connCountCloseCh := make(chan struct{})
 
 	proxyError := new(atomic.Error)
-	go func() {
-		defer close(clientProxyCloseCh)
-		proxyError.Store(clientProxy.Start())
-	}()
-	go func() {
-		defer close(connCountCloseCh)
-		for {
-			select {
-			case <-c.proxyCtx.Done():
-				// When the proxy exits it will cancel this even if we haven't
-				// done it manually
-				return
-			case connsLeft := <-connsLeftCh:
-				c.updateConnsLeft(connsLeft)
-				if connsLeft == 0 {
+	if c.flagReauthorize {
+		go func() {
+			defer close(clientProxyCloseCh)
+			defer close(connCountCloseCh)
+			c.runWithReauthorization(clientProxy, connsLeftCh, listener, targetClient, sessionClient, proxyError)
+		}()
+	} else {
+		if sessionClient != nil {
+			go c.autoExtend(c.proxyCtx, sessionClient, clientProxy, c.sessInfo.SessionId)
+		}
+		go func() {
+			defer close(clientProxyCloseCh)
+			proxyError.Store(clientProxy.Start())
+		}()
+		go func() {
+			defer close(connCountCloseCh)
+			for {
+				select {
+				case <-c.proxyCtx.Done():
+					// When the proxy exits it will cancel this even if we haven't
+					// done it manually
 					return
+				case connsLeft := <-connsLeftCh:
+					c.updateConnsLeft(connsLeft)
+					if connsLeft == 0 {
+						return
+					}
 				}
 			}
-		}
-	}()
+		}()
+	}
 
 	if c.Func == "connect" {
 		// "connect" indicates there is no subcommand to the connect function.
@@ -550,6 +669,25 @@ func (c *Command) Run(args []string) (retCode int) {
 	return
 }
 
+// authorizeOptions returns the options for authorizing a session against the
+// target given by the flags.
+func (c *Command) authorizeOptions() []targets.Option {
+	var opts []targets.Option
+	if len(c.flagHostId) != 0 {
+		opts = append(opts, targets.WithHostId(c.flagHostId))
+	}
+	if len(c.flagTargetName) > 0 {
+		opts = append(opts, targets.WithName(c.flagTargetName))
+	}
+	if len(c.FlagScopeId) > 0 {
+		opts = append(opts, targets.WithScopeId(c.FlagScopeId))
+	}
+	if len(c.FlagScopeName) > 0 {
+		opts = append(opts, targets.WithScopeName(c.FlagScopeName))
+	}
+	return opts
+}
+
 func (c *Command) printCredentials(creds []*targets.SessionCredential) error {
 	if len(creds) == 0 {
 		return nil
@@ -607,6 +745,7 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 		}
 	}
 
+	var leadingArgs []string
 	var args []string
 	var envs []string
 	var argsErr error
@@ -663,6 +802,37 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 			return
 		}
 		args = append(args, kubeArgs...)
+
+	case "mysql":
+		myLeadingArgs, myArgs, myEnvs, myCreds, myErr := c.mysqlFlags.buildArgs(c, port, host, addr, creds)
+		if myErr != nil {
+			argsErr = myErr
+			break
+		}
+		leadingArgs = append(leadingArgs, myLeadingArgs...)
+		args = append(args, myArgs...)
+		envs = append(envs, myEnvs...)
+		creds = myCreds
+
+	case "redis":
+		redisArgs, redisEnvs, redisCreds, redisErr := c.redisFlags.buildArgs(c, port, host, addr, creds)
+		if redisErr != nil {
+			argsErr = redisErr
+			break
+		}
+		args = append(args, redisArgs...)
+		envs = append(envs, redisEnvs...)
+		creds = redisCreds
+
+	case "mongo":
+		mongoArgs, mongoEnvs, mongoCreds, mongoErr := c.mongoFlags.buildArgs(c, port, host, addr, creds)
+		if mongoErr != nil {
+			argsErr = mongoErr
+			break
+		}
+		args = append(args, mongoArgs...)
+		envs = append(envs, mongoEnvs...)
+		creds = mongoCreds
 	}
 
 	if argsErr != nil {
@@ -677,7 +847,7 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 		return
 	}
 
-	args = append(passthroughArgs, args...)
+	args = append(append(leadingArgs, passthroughArgs...), args...)
 
 	stringReplacer := func(in, typ, replacer string) string {
 		for _, style := range []string{
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+const (
+	// autoExtendMinRemaining is the remaining lifetime of the session below
+	// which no further extension is attempted.
+	autoExtendMinRemaining = time.Second
+
+	// autoExtendTimeout bounds a single extend request.
+	autoExtendTimeout = 30 * time.Second
+)
+
+type ExtensionInfo struct {
+	Expiration time.Time `json:"expiration"`
+}
+
+// autoExtend extends the session once most of its remaining lifetime has
+// passed, for as long as the proxy is running. Failed extensions are retried
+// until the session expires.
+func (c *Command) autoExtend(ctx context.Context, sessionClient *sessions.Client, clientProxy *apiproxy.ClientProxy, sessionId string) {
+	for {
+		remaining := time.Until(clientProxy.SessionExpiration())
+		if remaining < autoExtendMinRemaining {
+			return
+		}
+		timer := time.NewTimer(remaining * 4 / 5)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		extendCtx, cancel := context.WithTimeout(ctx, autoExtendTimeout)
+		result, err := sessionClient.Extend(extendCtx, sessionId, 0, 0, sessions.WithAutomaticVersioning(true))
+		cancel()
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error extending session: %w", err))
+			continue
+		}
+		// The session certificate is reissued with the new expiration time
+		// and new connections must present it.
+		if err := clientProxy.SetSessionCertificate(result.GetItem().Certificate); err != nil {
+			c.PrintCliError(fmt.Errorf("Error updating session certificate: %w", err))
+			return
+		}
+		c.updateExpiration(clientProxy.SessionExpiration())
+	}
+}
+
+func (c *Command) updateExpiration(expiration time.Time) {
+	if c.flagExec != "" {
+		return
+	}
+	extInfo := ExtensionInfo{
+		Expiration: expiration,
+	}
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateExtensionInfoTableOutput(extInfo))
+	case "json":
+		out, err := json.Marshal(&extInfo)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error marshaling extension information: %w", err))
+			return
+		}
+		c.UI.Output(string(out))
+	}
+}
+
+func generateExtensionInfoTableOutput(in ExtensionInfo) string {
+	nonAttributeMap := map[string]any{
+		"Expiration": in.Expiration.Local().Format(time.RFC1123),
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	return base.WrapForHelpText([]string{
+		"",
+		"Session extended:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	})
+}
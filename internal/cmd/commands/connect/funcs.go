@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -157,3 +158,27 @@ func generateTerminationInfoTableOutput(in TerminationInfo) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+// writeTempSecretFile writes contents holding secrets to a temporary file,
+// readable only by the user, which is removed when the command exits. This
+// keeps secrets off of the command line of the invoked client.
+func writeTempSecretFile(c *Command, what, pattern, contents string) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("Error creating temporary %s file: %w", what, err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(f.Name()); err != nil {
+			return fmt.Errorf("Error removing temporary %s file; consider removing %s manually: %w", what, f.Name(), err)
+		}
+		return nil
+	})
+	if _, err := f.WriteString(contents); err != nil {
+		f.Close()
+		return "", fmt.Errorf("Error writing %s file to %s: %w", what, f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("Error closing %s file after writing to %s: %w", what, f.Name(), err)
+	}
+	return f.Name(), nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"errors"
+	"net"
+	"sync"
+)
+
+// sharedListener accepts connections on a single local listener and hands them
+// to the views created from it. A client proxy closes its listener when its
+// session ends; giving each proxy its own view lets the proxy for a newly
+// authorized session take over the same local address without clients seeing
+// the listener go away. Connections accepted while no view is accepting wait
+// until the next one is.
+type sharedListener struct {
+	ln        net.Listener
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newSharedListener(ln net.Listener) *sharedListener {
+	l := &sharedListener{
+		ln:    ln,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+	go l.acceptLoop()
+	return l
+}
+
+func (l *sharedListener) acceptLoop() {
+	for {
+		conn, err := l.ln.Accept()
+		if err != nil {
+			var te interface{ Temporary() bool }
+			if errors.As(err, &te) && te.Temporary() {
+				continue
+			}
+			l.Close()
+			return
+		}
+		select {
+		case l.conns <- conn:
+		case <-l.done:
+			conn.Close()
+			return
+		}
+	}
+}
+
+// Addr returns the address of the underlying listener.
+func (l *sharedListener) Addr() net.Addr {
+	return l.ln.Addr()
+}
+
+// Close closes the underlying listener along with all of its views.
+func (l *sharedListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.done)
+		if err := l.ln.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			l.closeErr = err
+		}
+	})
+	return l.closeErr
+}
+
+// view returns a listener which accepts connections from the shared listener
+// until either of them is closed. Closing the view leaves the shared listener
+// open.
+func (l *sharedListener) view() net.Listener {
+	return &listenerView{
+		parent: l,
+		closed: make(chan struct{}),
+	}
+}
+
+type listenerView struct {
+	parent    *sharedListener
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (v *listenerView) Accept() (net.Conn, error) {
+	select {
+	case <-v.closed:
+		return nil, net.ErrClosed
+	case <-v.parent.done:
+		return nil, net.ErrClosed
+	default:
+	}
+	select {
+	case conn := <-v.parent.conns:
+		return conn, nil
+	case <-v.closed:
+		return nil, net.ErrClosed
+	case <-v.parent.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (v *listenerView) Close() error {
+	v.closeOnce.Do(func() { close(v.closed) })
+	return nil
+}
+
+func (v *listenerView) Addr() net.Addr {
+	return v.parent.Addr()
+}
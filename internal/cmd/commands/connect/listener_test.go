@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedListener(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := newSharedListener(ln)
+	t.Cleanup(func() { l.Close() })
+
+	dialAndAccept := func(t *testing.T, v net.Listener, msg string) {
+		t.Helper()
+		client, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		defer client.Close()
+		_, err = client.Write([]byte(msg))
+		require.NoError(t, err)
+
+		conn, err := v.Accept()
+		require.NoError(t, err)
+		defer conn.Close()
+		buf := make([]byte, len(msg))
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, msg, string(buf))
+	}
+
+	v1 := l.view()
+	assert.Equal(t, l.Addr(), v1.Addr())
+	dialAndAccept(t, v1, "one")
+
+	// Closing a view leaves the shared listener open for the next view
+	require.NoError(t, v1.Close())
+	_, err = v1.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+
+	v2 := l.view()
+	dialAndAccept(t, v2, "two")
+
+	// A connection made while no view is accepting waits for the next one
+	client, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, v2.Close())
+	v3 := l.view()
+	conn, err := v3.Accept()
+	require.NoError(t, err)
+	conn.Close()
+
+	// Closing the shared listener closes its views
+	accepted := make(chan error)
+	go func() {
+		_, err := v3.Accept()
+		accepted <- err
+	}()
+	require.NoError(t, l.Close())
+	select {
+	case err := <-accepted:
+		assert.ErrorIs(t, err, net.ErrClosed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("accept did not return after the listener was closed")
+	}
+	_, err = net.DialTimeout("tcp", ln.Addr().String(), time.Second)
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+const (
+	mongoSynopsis = "Authorize a session against a target and invoke a MongoDB client to connect"
+)
+
+func mongoOptions(c *Command, set *base.FlagSets) {
+	f := set.NewFlagSet("MongoDB Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "style",
+		Target:     &c.flagMongoStyle,
+		EnvVar:     "BOUNDARY_CONNECT_MONGO_STYLE",
+		Completion: complete.PredictSet("mongosh"),
+		Default:    "mongosh",
+		Usage:      `Specifies how the CLI will attempt to invoke a MongoDB client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mongosh".`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "username",
+		Target:     &c.flagUsername,
+		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the username to pass through to the client. May be overridden by credentials sourced from a credential store.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "dbname",
+		Target:     &c.flagDbname,
+		EnvVar:     "BOUNDARY_CONNECT_DBNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the database name to pass through to the client. Users are authenticated against this database unless the client is told otherwise.`,
+	})
+}
+
+type mongoFlags struct {
+	flagMongoStyle string
+}
+
+func (m *mongoFlags) defaultExec() string {
+	return strings.ToLower(m.flagMongoStyle)
+}
+
+func (m *mongoFlags) buildArgs(c *Command, port, ip, _ string, creds proxy.Credentials) (args, envs []string, retCreds proxy.Credentials, retErr error) {
+	var username, password string
+
+	retCreds = creds
+	if len(retCreds.UsernamePassword) > 0 {
+		// Mark credential as consumed so it is not printed to user
+		retCreds.UsernamePassword[0].Consumed = true
+
+		// For now just grab the first username password credential brokered
+		username = retCreds.UsernamePassword[0].Username
+		password = retCreds.UsernamePassword[0].Password
+	}
+
+	switch m.flagMongoStyle {
+	case "mongosh":
+		host := ip
+		if port != "" {
+			host = net.JoinHostPort(ip, port)
+		}
+		// The client must not try to reach the other members of a replica
+		// set directly, since only the proxied member is reachable.
+		connUrl := &url.URL{
+			Scheme:   "mongodb",
+			Host:     host,
+			Path:     "/" + c.flagDbname,
+			RawQuery: "directConnection=true",
+		}
+		if username == "" {
+			username = c.flagUsername
+		}
+
+		if password == "" {
+			args = append(args, connUrl.String())
+			if username != "" {
+				args = append(args, "--username", username)
+			}
+			return
+		}
+
+		// The client only accepts a password on the command line, so connect
+		// from a script instead and enter the shell once it has run.
+		connUrl.User = url.UserPassword(username, password)
+		connStr, err := json.Marshal(connUrl.String())
+		if err != nil {
+			return nil, nil, proxy.Credentials{}, fmt.Errorf("Error encoding MongoDB connection string: %w", err)
+		}
+		script, err := writeTempSecretFile(c, "MongoDB connection script", "*.js", fmt.Sprintf("db = connect(%s);\n", connStr))
+		if err != nil {
+			return nil, nil, proxy.Credentials{}, err
+		}
+		args = append(args, "--nodb", "--shell", script)
+	}
+	return
+}
@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/hcl"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+const (
+	// multiStatusDelay is how long status changes are collected before the
+	// status table is printed, so that a burst of changes prints one table.
+	multiStatusDelay = 500 * time.Millisecond
+)
+
+var (
+	_ cli.Command             = (*MultiCommand)(nil)
+	_ cli.CommandAutocomplete = (*MultiCommand)(nil)
+)
+
+// tunnelState describes what a tunnel is currently doing.
+type tunnelState string
+
+const (
+	tunnelAuthorizing tunnelState = "authorizing"
+	tunnelActive      tunnelState = "active"
+	tunnelRetrying    tunnelState = "retrying"
+	tunnelStopped     tunnelState = "stopped"
+)
+
+// multiConfig is the configuration file read by "boundary connect multi".
+type multiConfig struct {
+	Tunnels []*tunnelConfig `hcl:"tunnel"`
+}
+
+// tunnelConfig is a single tunnel within the configuration file.
+type tunnelConfig struct {
+	Name            string `hcl:",key"`
+	Profile         string `hcl:"profile"`
+	TargetId        string `hcl:"target_id"`
+	TargetName      string `hcl:"target_name"`
+	TargetScopeId   string `hcl:"target_scope_id"`
+	TargetScopeName string `hcl:"target_scope_name"`
+	HostId          string `hcl:"host_id"`
+	ListenAddr      string `hcl:"listen_addr"`
+	ListenPort      int    `hcl:"listen_port"`
+}
+
+// TunnelStatus is the status of a tunnel, reported whenever it changes.
+type TunnelStatus struct {
+	Name            string    `json:"name"`
+	Target          string    `json:"target"`
+	Address         string    `json:"address,omitempty"`
+	State           string    `json:"state"`
+	SessionId       string    `json:"session_id,omitempty"`
+	Expiration      time.Time `json:"expiration,omitempty"`
+	ConnectionsLeft int32     `json:"connections_left,omitempty"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// parseMultiConfig parses the tunnels from the contents of a configuration
+// file, filling in the options from any profiles they reference.
+func parseMultiConfig(contents string) ([]*tunnelConfig, error) {
+	var cfg multiConfig
+	if err := hcl.Decode(&cfg, contents); err != nil {
+		return nil, fmt.Errorf("Error parsing config: %w", err)
+	}
+	if len(cfg.Tunnels) == 0 {
+		return nil, errors.New("No tunnels are defined in the config")
+	}
+	names := make(map[string]struct{}, len(cfg.Tunnels))
+	for _, t := range cfg.Tunnels {
+		if t.Name == "" {
+			return nil, errors.New("Every tunnel must have a name")
+		}
+		if _, ok := names[t.Name]; ok {
+			return nil, fmt.Errorf("More than one tunnel is named %q", t.Name)
+		}
+		names[t.Name] = struct{}{}
+		if t.Profile != "" {
+			p, err := lookupProfile(t.Profile)
+			if err != nil {
+				return nil, fmt.Errorf("Error loading profile for tunnel %q: %w", t.Name, err)
+			}
+			t.applyProfile(p)
+		}
+		if err := t.validate(); err != nil {
+			return nil, fmt.Errorf("Invalid tunnel %q: %w", t.Name, err)
+		}
+	}
+	return cfg.Tunnels, nil
+}
+
+// applyProfile fills in the target, host and listen port of the tunnel from
+// the profile where the tunnel doesn't set them itself.
+func (t *tunnelConfig) applyProfile(p *Profile) {
+	if t.TargetId == "" && t.TargetName == "" {
+		t.TargetId = p.TargetId
+		t.TargetName = p.TargetName
+		t.TargetScopeId = p.TargetScopeId
+		t.TargetScopeName = p.TargetScopeName
+	}
+	if t.HostId == "" {
+		t.HostId = p.HostId
+	}
+	if t.ListenPort == 0 {
+		t.ListenPort = p.ListenPort
+	}
+}
+
+func (t *tunnelConfig) validate() error {
+	switch {
+	case t.TargetId == "" && (t.TargetName == "" || (t.TargetScopeId == "" && t.TargetScopeName == "")):
+		return errors.New("target_id or a combination of target_name and target_scope_id or target_scope_name must be set")
+	case t.TargetId != "" && (t.TargetName != "" || t.TargetScopeId != "" || t.TargetScopeName != ""):
+		return errors.New("target_id cannot be set along with other target lookup parameters")
+	case t.TargetScopeId != "" && t.TargetScopeName != "":
+		return errors.New("target_scope_id and target_scope_name cannot both be set")
+	case t.ListenPort < 0 || t.ListenPort > math.MaxUint16:
+		return fmt.Errorf("listen_port %d is not a valid port", t.ListenPort)
+	}
+	if _, err := t.listenAddrPort(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *tunnelConfig) listenAddrPort() (netip.AddrPort, error) {
+	listenAddr := t.ListenAddr
+	if listenAddr == "" {
+		listenAddr = "127.0.0.1"
+	}
+	addr, err := netip.ParseAddr(listenAddr)
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("listen_addr is not a valid IP address: %w", err)
+	}
+	return netip.AddrPortFrom(addr, uint16(t.ListenPort)), nil
+}
+
+// target returns how the tunnel's target is shown in its status.
+func (t *tunnelConfig) target() string {
+	switch {
+	case t.TargetId != "":
+		return t.TargetId
+	case t.TargetScopeId != "":
+		return fmt.Sprintf("%s/%s", t.TargetScopeId, t.TargetName)
+	default:
+		return fmt.Sprintf("%s/%s", t.TargetScopeName, t.TargetName)
+	}
+}
+
+// authorize authorizes a new session against the tunnel's target.
+func (t *tunnelConfig) authorize(ctx context.Context, client *targets.Client) (*targets.SessionAuthorization, error) {
+	var opts []targets.Option
+	if t.HostId != "" {
+		opts = append(opts, targets.WithHostId(t.HostId))
+	}
+	if t.TargetName != "" {
+		opts = append(opts, targets.WithName(t.TargetName))
+	}
+	if t.TargetScopeId != "" {
+		opts = append(opts, targets.WithScopeId(t.TargetScopeId))
+	}
+	if t.TargetScopeName != "" {
+		opts = append(opts, targets.WithScopeName(t.TargetScopeName))
+	}
+	sar, err := client.AuthorizeSession(ctx, t.TargetId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			return nil, fmt.Errorf("Error from controller when performing authorize-session action against given target: %s", apiErr.Message)
+		}
+		return nil, fmt.Errorf("Error trying to authorize a session against target: %w", err)
+	}
+	return sar.GetItem().(*targets.SessionAuthorization), nil
+}
+
+// MultiCommand opens tunnels to several targets at once, authorizing a new
+// session for a tunnel whenever its current one ends.
+type MultiCommand struct {
+	*base.Command
+
+	flagConfig string
+
+	statusLock sync.Mutex
+	statuses   map[string]TunnelStatus
+	statusCh   chan TunnelStatus
+}
+
+func (c *MultiCommand) Synopsis() string {
+	return "Open tunnels to several targets through Boundary workers at once"
+}
+
+func (c *MultiCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary connect multi [options]",
+		"",
+		"  This command opens a local listener for each tunnel in the given config file and proxies connections to it through a session authorized against the tunnel's target. When a session expires or runs out of connections, a new one is authorized and the same local listener keeps being used. A table with the status of every tunnel is printed whenever one changes.",
+		"",
+		"  Example config file:",
+		"",
+		`      tunnel "prod-db" {`,
+		`        target_id   = "ttcp_1234567890"`,
+		`        listen_port = 5432`,
+		`      }`,
+		"",
+		`      tunnel "prod-web" {`,
+		`        target_name       = "web"`,
+		`        target_scope_name = "prod"`,
+		`        listen_port       = 8080`,
+		`      }`,
+		"",
+		`      tunnel "cache" {`,
+		`        profile = "prod-cache"`,
+		`      }`,
+		"",
+		`  A tunnel can also set "host_id" and "listen_addr", or take its target, host and listen port from a connect profile with "profile".`,
+		"",
+		"  Example:",
+		"",
+		`      $ boundary connect multi -config tunnels.hcl`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *MultiCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "config",
+		Target:     &c.flagConfig,
+		EnvVar:     "BOUNDARY_CONNECT_MULTI_CONFIG",
+		Completion: complete.PredictFiles("*.hcl"),
+		Usage:      `The path of an HCL file with a "tunnel" block for each target to connect to.`,
+	})
+
+	return set
+}
+
+func (c *MultiCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *MultiCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *MultiCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if c.flagConfig == "" {
+		c.PrintCliError(errors.New("A config file must be provided with -config"))
+		return base.CommandUserError
+	}
+	contents, err := os.ReadFile(c.flagConfig)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading config file: %w", err))
+		return base.CommandUserError
+	}
+	tunnels, err := parseMultiConfig(string(contents))
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %s", err))
+		return base.CommandCliError
+	}
+	targetClient := targets.NewClient(client)
+
+	// All listeners are opened up front so that a port which is in use is
+	// reported before any sessions are authorized.
+	listeners := make([]*sharedListener, 0, len(tunnels))
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	for _, t := range tunnels {
+		addrPort, _ := t.listenAddrPort()
+		ln, err := net.ListenTCP("tcp", net.TCPAddrFromAddrPort(addrPort))
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error listening on %s for tunnel %q: %w", addrPort, t.Name, err))
+			return base.CommandCliError
+		}
+		listeners = append(listeners, newSharedListener(ln))
+	}
+
+	c.statuses = make(map[string]TunnelStatus, len(tunnels))
+	c.statusCh = make(chan TunnelStatus)
+	printerDone := make(chan struct{})
+	go func() {
+		defer close(printerDone)
+		c.printStatuses()
+	}()
+
+	var wg sync.WaitGroup
+	for i, t := range tunnels {
+		wg.Add(1)
+		go func(t *tunnelConfig, l *sharedListener) {
+			defer wg.Done()
+			c.runTunnel(c.Context, targetClient, t, l)
+		}(t, listeners[i])
+	}
+	wg.Wait()
+	close(c.statusCh)
+	<-printerDone
+
+	return base.CommandSuccess
+}
+
+// runTunnel proxies connections on the tunnel's listener until the context
+// is done, authorizing a new session each time the previous one ends.
+func (c *MultiCommand) runTunnel(ctx context.Context, client *targets.Client, t *tunnelConfig, l *sharedListener) {
+	c.runTunnelSessions(ctx, t, l, func(ctx context.Context, ln net.Listener) (*reauthorizedSession, error) {
+		sa, err := t.authorize(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		connsLeftCh := make(chan int32)
+		proxy, err := apiproxy.New(ctx, sa.AuthorizationToken,
+			apiproxy.WithListener(ln),
+			apiproxy.WithConnectionsLeftCh(connsLeftCh),
+			apiproxy.WithHeaders(c.TraceHeaders()))
+		if err != nil {
+			return nil, fmt.Errorf("Could not create client proxy: %w", err)
+		}
+		return &reauthorizedSession{
+			id:          sa.SessionId,
+			proxy:       proxy,
+			expiration:  proxy.SessionExpiration(),
+			connsLeft:   proxy.ConnectionsLeft(),
+			connsLeftCh: connsLeftCh,
+		}, nil
+	})
+}
+
+// runTunnelSessions runs the tunnel using the sessions returned by authorize
+// and reports the tunnel's status as they change.
+func (c *MultiCommand) runTunnelSessions(
+	ctx context.Context,
+	t *tunnelConfig,
+	l *sharedListener,
+	authorize func(ctx context.Context, ln net.Listener) (*reauthorizedSession, error),
+) {
+	status := TunnelStatus{
+		Name:    t.Name,
+		Target:  t.target(),
+		Address: l.Addr().String(),
+	}
+	update := func(state tunnelState, err error) {
+		status.State = string(state)
+		status.Error = ""
+		if err != nil {
+			status.Error = err.Error()
+		}
+		c.statusCh <- status
+	}
+	clearSession := func() {
+		status.SessionId = ""
+		status.ConnectionsLeft = 0
+		status.Expiration = time.Time{}
+	}
+
+	r := &reauthorizer{
+		listener:  l,
+		authorize: authorize,
+		authorizing: func() {
+			update(tunnelAuthorizing, nil)
+		},
+		started: func(_ context.Context, s *reauthorizedSession) {
+			status.SessionId = s.id
+			status.Expiration = s.expiration
+			status.ConnectionsLeft = s.connsLeft
+			update(tunnelActive, nil)
+		},
+		connsLeft: func(connsLeft int32) {
+			status.ConnectionsLeft = connsLeft
+			update(tunnelActive, nil)
+		},
+		failed: func(err error) {
+			clearSession()
+			update(tunnelRetrying, err)
+		},
+	}
+	r.run(ctx, nil)
+
+	clearSession()
+	update(tunnelStopped, nil)
+}
+
+// printStatuses records the status updates from the tunnels and prints all of
+// the tunnels' statuses once updates stop arriving for a moment. It returns
+// once the status channel is closed.
+func (c *MultiCommand) printStatuses() {
+	timer := time.NewTimer(math.MaxInt64)
+	defer timer.Stop()
+	for {
+		select {
+		case s, ok := <-c.statusCh:
+			if !ok {
+				c.outputStatuses()
+				return
+			}
+			c.statusLock.Lock()
+			c.statuses[s.Name] = s
+			c.statusLock.Unlock()
+			if base.Format(c.UI) == "json" {
+				if b, err := json.Marshal(s); err == nil {
+					c.UI.Output(string(b))
+				}
+				continue
+			}
+			timer.Reset(multiStatusDelay)
+		case <-timer.C:
+			c.outputStatuses()
+		}
+	}
+}
+
+func (c *MultiCommand) outputStatuses() {
+	if base.Format(c.UI) != "table" {
+		return
+	}
+	c.statusLock.Lock()
+	statuses := make([]TunnelStatus, 0, len(c.statuses))
+	for _, s := range c.statuses {
+		statuses = append(statuses, s)
+	}
+	c.statusLock.Unlock()
+	c.UI.Output(generateTunnelStatusTableOutput(statuses))
+}
+
+func generateTunnelStatusTableOutput(statuses []TunnelStatus) string {
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTARGET\tADDRESS\tSTATE\tSESSION ID\tEXPIRATION\tCONNECTIONS LEFT\tERROR")
+	for _, s := range statuses {
+		var expiration, connsLeft string
+		if !s.Expiration.IsZero() {
+			expiration = s.Expiration.Local().Format(time.RFC1123)
+		}
+		if s.State == string(tunnelActive) {
+			connsLeft = "unlimited"
+			if s.ConnectionsLeft >= 0 {
+				connsLeft = strconv.Itoa(int(s.ConnectionsLeft))
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Target, s.Address, s.State, s.SessionId, expiration, connsLeft, s.Error)
+	}
+	tw.Flush()
+	return "\n" + strings.TrimRight(sb.String(), "\n")
+}
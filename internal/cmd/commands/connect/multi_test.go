@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMultiConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), profilesFilename)
+	t.Setenv(EnvConnectProfilesPath, path)
+	require.NoError(t, writeProfiles(path, []*Profile{
+		{Name: "cache", TargetId: "ttcp_cache", HostId: "hst_1", ListenPort: 6379, Subcommand: "ssh"},
+	}))
+
+	got, err := parseMultiConfig(`
+tunnel "db" {
+  target_id   = "ttcp_db"
+  listen_port = 5432
+}
+
+tunnel "web" {
+  target_name       = "web"
+  target_scope_name = "prod"
+  listen_addr       = "::1"
+}
+
+tunnel "cache" {
+  profile     = "cache"
+  listen_port = 16379
+}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []*tunnelConfig{
+		{Name: "db", TargetId: "ttcp_db", ListenPort: 5432},
+		{Name: "web", TargetName: "web", TargetScopeName: "prod", ListenAddr: "::1"},
+		{Name: "cache", Profile: "cache", TargetId: "ttcp_cache", HostId: "hst_1", ListenPort: 16379},
+	}, got)
+	assert.Equal(t, "prod/web", got[1].target())
+	addr, err := got[1].listenAddrPort()
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:0", addr.String())
+
+	errCases := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{name: "invalid hcl", config: `tunnel "a" {`, wantErr: "Error parsing config"},
+		{name: "no tunnels", config: ``, wantErr: "No tunnels are defined"},
+		{name: "duplicate", config: `tunnel "a" { target_id = "ttcp_1" }` + "\n" + `tunnel "a" { target_id = "ttcp_2" }`, wantErr: `More than one tunnel is named "a"`},
+		{name: "no target", config: `tunnel "a" { listen_port = 1 }`, wantErr: "target_id or a combination"},
+		{name: "name without scope", config: `tunnel "a" { target_name = "web" }`, wantErr: "target_id or a combination"},
+		{name: "id and name", config: `tunnel "a" { target_id = "ttcp_1"` + "\n" + `target_name = "web" }`, wantErr: "cannot be set along with"},
+		{name: "both scopes", config: `tunnel "a" { target_name = "web"` + "\n" + `target_scope_id = "p_1"` + "\n" + `target_scope_name = "prod" }`, wantErr: "cannot both be set"},
+		{name: "bad port", config: `tunnel "a" { target_id = "ttcp_1"` + "\n" + `listen_port = 70000 }`, wantErr: "70000 is not a valid port"},
+		{name: "bad addr", config: `tunnel "a" { target_id = "ttcp_1"` + "\n" + `listen_addr = "localhost" }`, wantErr: "listen_addr is not a valid IP address"},
+		{name: "unknown profile", config: `tunnel "a" { profile = "nope" }`, wantErr: `No connect profile named "nope"`},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseMultiConfig(tc.config)
+			assert.Nil(t, got)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestGenerateTunnelStatusTableOutput(t *testing.T) {
+	exp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local)
+	got := generateTunnelStatusTableOutput([]TunnelStatus{
+		{Name: "web", Target: "prod/web", Address: "127.0.0.1:8080", State: "retrying", Error: "no workers"},
+		{Name: "db", Target: "ttcp_db", Address: "127.0.0.1:5432", State: "active", SessionId: "s_1", Expiration: exp, ConnectionsLeft: -1},
+	})
+	lines := strings.Split(got, "\n")
+	require.Len(t, lines, 4)
+	assert.Empty(t, lines[0])
+	assert.Equal(t, []string{"NAME", "TARGET", "ADDRESS", "STATE", "SESSION", "ID", "EXPIRATION", "CONNECTIONS", "LEFT", "ERROR"}, strings.Fields(lines[1]))
+	assert.Equal(t, append(append([]string{"db", "ttcp_db", "127.0.0.1:5432", "active", "s_1"}, strings.Fields(exp.Format(time.RFC1123))...), "unlimited"), strings.Fields(lines[2]))
+	assert.Equal(t, []string{"web", "prod/web", "127.0.0.1:8080", "retrying", "no", "workers"}, strings.Fields(lines[3]))
+	// The columns line up
+	assert.Equal(t, strings.Index(lines[1], "ERROR"), strings.Index(lines[3], "no workers"))
+}
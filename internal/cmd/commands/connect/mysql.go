@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+const (
+	mysqlSynopsis = "Authorize a session against a target and invoke a MySQL client to connect"
+)
+
+func mysqlOptions(c *Command, set *base.FlagSets) {
+	f := set.NewFlagSet("MySQL Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "style",
+		Target:     &c.flagMysqlStyle,
+		EnvVar:     "BOUNDARY_CONNECT_MYSQL_STYLE",
+		Completion: complete.PredictSet("mysql"),
+		Default:    "mysql",
+		Usage:      `Specifies how the CLI will attempt to invoke a MySQL client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mysql".`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "username",
+		Target:     &c.flagUsername,
+		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the username to pass through to the client. May be overridden by credentials sourced from a credential store.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "dbname",
+		Target:     &c.flagDbname,
+		EnvVar:     "BOUNDARY_CONNECT_DBNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the database name to pass through to the client.`,
+	})
+}
+
+type mysqlFlags struct {
+	flagMysqlStyle string
+}
+
+func (m *mysqlFlags) defaultExec() string {
+	return strings.ToLower(m.flagMysqlStyle)
+}
+
+// buildArgs returns the arguments to invoke the client with. The leading
+// arguments must come before any other argument, including the ones passed
+// through to the client.
+func (m *mysqlFlags) buildArgs(c *Command, port, ip, _ string, creds proxy.Credentials) (leadingArgs, args, envs []string, retCreds proxy.Credentials, retErr error) {
+	var username, password string
+
+	retCreds = creds
+	if len(retCreds.UsernamePassword) > 0 {
+		// Mark credential as consumed so it is not printed to user
+		retCreds.UsernamePassword[0].Consumed = true
+
+		// For now just grab the first username password credential brokered
+		username = retCreds.UsernamePassword[0].Username
+		password = retCreds.UsernamePassword[0].Password
+	}
+
+	switch m.flagMysqlStyle {
+	case "mysql":
+		// The password is passed in an option file, which the client only
+		// reads if it's given as the very first argument.
+		if password != "" {
+			optionFile, err := writeTempSecretFile(c, "MySQL option", "*.cnf", fmt.Sprintf("[client]\npassword=%s\n", mysqlOptionValue(password)))
+			if err != nil {
+				return nil, nil, nil, proxy.Credentials{}, err
+			}
+			leadingArgs = append(leadingArgs, fmt.Sprintf("--defaults-extra-file=%s", optionFile))
+		}
+
+		// Without an explicit protocol the client connects through a local
+		// socket file when the host is localhost.
+		args = append(args, "--protocol=TCP", "-h", ip)
+		if port != "" {
+			args = append(args, "-P", port)
+		}
+
+		switch {
+		case username != "":
+			args = append(args, "-u", username)
+		case c.flagUsername != "":
+			args = append(args, "-u", c.flagUsername)
+		}
+
+		if c.flagDbname != "" {
+			args = append(args, "-D", c.flagDbname)
+		}
+	}
+	return
+}
+
+// mysqlOptionValue quotes a value for use in a MySQL option file. Quotes are
+// only stripped from the ends of a value, so quotes within it are left as is,
+// while backslashes start escape sequences and must be escaped.
+func mysqlOptionValue(v string) string {
+	v = strings.NewReplacer(
+		`\`, `\\`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(v)
+	return fmt.Sprintf(`"%s"`, v)
+}
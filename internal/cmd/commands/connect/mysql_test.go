@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMysqlBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "mysql"}
+	c.flagMysqlStyle = "mysql"
+	c.flagUsername = "ignored"
+	c.flagDbname = "app"
+	defer func() {
+		for _, f := range c.cleanupFuncs {
+			require.NoError(t, f())
+		}
+	}()
+
+	leadingArgs, args, envs, creds, err := c.mysqlFlags.buildArgs(c, "3306", "127.0.0.1", "127.0.0.1:3306", proxy.Credentials{
+		UsernamePassword: []proxy.UsernamePassword{{Username: "admin", Password: `p"a\ss#`}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, envs)
+	assert.True(t, creds.UsernamePassword[0].Consumed)
+	assert.Equal(t, []string{"--protocol=TCP", "-h", "127.0.0.1", "-P", "3306", "-u", "admin", "-D", "app"}, args)
+	for _, arg := range append(leadingArgs, args...) {
+		assert.NotContains(t, arg, "p\"a")
+	}
+
+	require.Len(t, leadingArgs, 1)
+	optionFile, ok := strings.CutPrefix(leadingArgs[0], "--defaults-extra-file=")
+	require.True(t, ok)
+	contents, err := os.ReadFile(optionFile)
+	require.NoError(t, err)
+	assert.Equal(t, "[client]\npassword=\"p\"a\\\\ss#\"\n", string(contents))
+	info, err := os.Stat(optionFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Without brokered credentials the given username is used
+	leadingArgs, args, _, _, err = c.mysqlFlags.buildArgs(c, "3306", "127.0.0.1", "127.0.0.1:3306", proxy.Credentials{})
+	require.NoError(t, err)
+	assert.Empty(t, leadingArgs)
+	assert.Equal(t, []string{"--protocol=TCP", "-h", "127.0.0.1", "-P", "3306", "-u", "ignored", "-D", "app"}, args)
+}
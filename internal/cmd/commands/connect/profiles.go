@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-homedir"
+	"github.com/posener/complete"
+)
+
+const (
+	// EnvConnectProfilesPath can be set to use a file other than the default
+	// one for storing connect profiles.
+	EnvConnectProfilesPath = "BOUNDARY_CONNECT_PROFILES_PATH"
+
+	profilesDotDirname = ".boundary"
+	profilesFilename   = "connect-profiles.json"
+)
+
+var (
+	_ cli.Command             = (*ProfilesCommand)(nil)
+	_ cli.CommandAutocomplete = (*ProfilesCommand)(nil)
+)
+
+// profileSubcommands are the connect subcommands a profile can be saved for.
+// The empty string is the plain "boundary connect" command.
+var profileSubcommands = []string{"", "http", "kube", "mongo", "mysql", "postgres", "rdp", "redis", "ssh"}
+
+// Profile is a named set of options for "boundary connect" which is saved in
+// the user's profiles file so it doesn't need to be specified every time.
+type Profile struct {
+	Name string `json:"name"`
+	// The connect helper the profile is for, such as "ssh". Empty for plain
+	// "boundary connect".
+	Subcommand      string `json:"subcommand,omitempty"`
+	TargetId        string `json:"target_id,omitempty"`
+	TargetName      string `json:"target_name,omitempty"`
+	TargetScopeId   string `json:"target_scope_id,omitempty"`
+	TargetScopeName string `json:"target_scope_name,omitempty"`
+	HostId          string `json:"host_id,omitempty"`
+	ListenPort      int    `json:"listen_port,omitempty"`
+	Exec            string `json:"exec,omitempty"`
+	// Any other options for the subcommand, such as "-username=admin"
+	Flags []string `json:"flags,omitempty"`
+	// The arguments passed through to the executed client after "--"
+	Args []string `json:"args,omitempty"`
+}
+
+type profilesFile struct {
+	Profiles []*Profile `json:"profiles"`
+}
+
+// flagArgs returns the profile's options as command line flags.
+func (p *Profile) flagArgs() []string {
+	var args []string
+	add := func(name, value string) {
+		if value != "" {
+			args = append(args, fmt.Sprintf("-%s=%s", name, value))
+		}
+	}
+	add("target-id", p.TargetId)
+	add("target-name", p.TargetName)
+	add("target-scope-id", p.TargetScopeId)
+	add("target-scope-name", p.TargetScopeName)
+	add("host-id", p.HostId)
+	if p.ListenPort != 0 {
+		add("listen-port", strconv.Itoa(p.ListenPort))
+	}
+	add("exec", p.Exec)
+	return append(args, p.Flags...)
+}
+
+// command returns the full command the profile runs.
+func (p *Profile) command() string {
+	cmd := []string{"boundary", "connect"}
+	if p.Subcommand != "" {
+		cmd = append(cmd, p.Subcommand)
+	}
+	cmd = append(cmd, p.flagArgs()...)
+	if len(p.Args) > 0 {
+		cmd = append(cmd, "--")
+		for _, a := range p.Args {
+			if a == "" || strings.ContainsAny(a, " \t\"'") {
+				a = strconv.Quote(a)
+			}
+			cmd = append(cmd, a)
+		}
+	}
+	return strings.Join(cmd, " ")
+}
+
+// newProfile returns a profile with the options which were set when parsing
+// the flags of a connect command for the given subcommand.
+func newProfile(name, subcommand string, set *base.FlagSets, passthroughArgs []string) (*Profile, error) {
+	p := &Profile{
+		Name:       name,
+		Subcommand: subcommand,
+		Args:       passthroughArgs,
+	}
+	var err error
+	set.Visit(func(f *flag.Flag) {
+		v := f.Value.String()
+		switch f.Name {
+		case "profile":
+			err = errors.New("A profile cannot be saved with the -profile option")
+		case "authz-token":
+			err = errors.New("A profile cannot be saved with the -authz-token option")
+		case "target-id":
+			p.TargetId = v
+		case "target-name":
+			p.TargetName = v
+		case "target-scope-id":
+			p.TargetScopeId = v
+		case "target-scope-name":
+			p.TargetScopeName = v
+		case "host-id":
+			p.HostId = v
+		case "listen-port":
+			p.ListenPort, _ = strconv.Atoi(v)
+		case "exec":
+			p.Exec = v
+		default:
+			p.Flags = append(p.Flags, fmt.Sprintf("-%s=%s", f.Name, v))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// profileFlagValue returns the value of the -profile flag in args, if any.
+func profileFlagValue(args []string) string {
+	for i, a := range args {
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(a, "-"), "-"), "=")
+		if !strings.HasPrefix(a, "-") || name != "profile" {
+			continue
+		}
+		if hasValue {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// ProfilesPath returns the path of the file connect profiles are stored in.
+// This is a file in the ".boundary" directory in the user's home directory
+// unless overridden with the BOUNDARY_CONNECT_PROFILES_PATH environment
+// variable.
+func ProfilesPath() (string, error) {
+	if p := os.Getenv(EnvConnectProfilesPath); p != "" {
+		return p, nil
+	}
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("Error finding home directory: %w", err)
+	}
+	return filepath.Join(homeDir, profilesDotDirname, profilesFilename), nil
+}
+
+// readProfiles returns the profiles stored in the file at path sorted by name.
+// No profiles are returned if the file doesn't exist.
+func readProfiles(path string) ([]*Profile, error) {
+	b, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("Error reading connect profiles: %w", err)
+	}
+	var pf profilesFile
+	if err := json.Unmarshal(b, &pf); err != nil {
+		return nil, fmt.Errorf("Error parsing connect profiles in %q: %w", path, err)
+	}
+	sort.Slice(pf.Profiles, func(i, j int) bool { return pf.Profiles[i].Name < pf.Profiles[j].Name })
+	return pf.Profiles, nil
+}
+
+// writeProfiles replaces the profiles stored in the file at path, creating the
+// file and its directory if needed.
+func writeProfiles(path string, profiles []*Profile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("Error creating connect profiles directory: %w", err)
+	}
+	if profiles == nil {
+		profiles = []*Profile{}
+	}
+	b, err := json.MarshalIndent(profilesFile{Profiles: profiles}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error encoding connect profiles: %w", err)
+	}
+	// Write to a temporary file first so the profiles are never left half
+	// written.
+	tmp, err := os.CreateTemp(filepath.Dir(path), profilesFilename+".*")
+	if err != nil {
+		return fmt.Errorf("Error writing connect profiles: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(b, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("Error writing connect profiles: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("Error writing connect profiles: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("Error writing connect profiles: %w", err)
+	}
+	return nil
+}
+
+// lookupProfile returns the profile with the given name from the user's
+// profiles file.
+func lookupProfile(name string) (*Profile, error) {
+	path, err := ProfilesPath()
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := readProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("No connect profile named %q was found in %q", name, path)
+}
+
+// ProfilesCommand lists, saves and deletes connect profiles.
+type ProfilesCommand struct {
+	*base.Command
+
+	Func string
+}
+
+func (c *ProfilesCommand) Synopsis() string {
+	switch c.Func {
+	case "list":
+		return "List saved connect profiles"
+	case "save":
+		return "Save options for connect as a named profile"
+	case "delete":
+		return "Delete a saved connect profile"
+	default:
+		return "Manage named profiles of options for connect"
+	}
+}
+
+func (c *ProfilesCommand) Help() string {
+	switch c.Func {
+	case "list":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect profiles list [options]",
+			"",
+			"  List the saved connect profiles. Example:",
+			"",
+			"    $ boundary connect profiles list",
+			"",
+			"",
+		}) + c.Flags().Help()
+	case "save":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect profiles save <name> [subcommand] [options] [args]",
+			"",
+			`  Save the given options for "boundary connect" or one of its subcommands as a named profile. Any of the options of the subcommand can be saved, as can arguments which follow " -- ". A profile with the same name is replaced. Example:`,
+			"",
+			"    $ boundary connect profiles save prod-db postgres -target-id ttcp_1234567890 -username admin -- -c 'select 1'",
+			"",
+			`  The profile is then used with "boundary connect -profile prod-db". Options given along with -profile take precedence over the ones in the profile.`,
+			"",
+			fmt.Sprintf("  Profiles are stored in %q in the user's home directory unless the %s environment variable is set to another path.", filepath.Join(profilesDotDirname, profilesFilename), EnvConnectProfilesPath),
+		})
+	case "delete":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect profiles delete <name>",
+			"",
+			"  Delete the named connect profile. Example:",
+			"",
+			"    $ boundary connect profiles delete prod-db",
+		})
+	default:
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect profiles <subcommand> [options] [args]",
+			"",
+			`  This command groups subcommands for managing named profiles of options for "boundary connect". Example:`,
+			"",
+			"    Save a profile:",
+			"",
+			"      $ boundary connect profiles save prod-db postgres -target-id ttcp_1234567890",
+			"",
+			"    Connect using the profile:",
+			"",
+			"      $ boundary connect -profile prod-db",
+			"",
+			"  Please see the profiles subcommand help for detailed usage information.",
+		})
+	}
+}
+
+func (c *ProfilesCommand) Flags() *base.FlagSets {
+	if c.Func == "list" {
+		return c.FlagSet(base.FlagSetOutputFormat)
+	}
+	return c.FlagSet(base.FlagSetNone)
+}
+
+func (c *ProfilesCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ProfilesCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProfilesCommand) Run(args []string) int {
+	switch c.Func {
+	case "list":
+		return c.list(args)
+	case "save":
+		return c.save(args)
+	case "delete":
+		return c.delete(args)
+	default:
+		return cli.RunResultHelp
+	}
+}
+
+func (c *ProfilesCommand) list(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	path, err := ProfilesPath()
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	profiles, err := readProfiles(path)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if profiles == nil {
+			profiles = []*Profile{}
+		}
+		b, err := json.Marshal(profiles)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error formatting as JSON: %w", err))
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(generateProfilesTableOutput(profiles))
+	}
+	return base.CommandSuccess
+}
+
+func (c *ProfilesCommand) save(args []string) int {
+	var passthroughArgs []string
+	for i, v := range args {
+		if v == "--" {
+			passthroughArgs = args[i+1:]
+			args = args[:i]
+			break
+		}
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		c.PrintCliError(errors.New("A profile name must be provided"))
+		return base.CommandUserError
+	}
+	name := args[0]
+	args = args[1:]
+
+	subcommand := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		subcommand = args[0]
+		args = args[1:]
+		if subcommand == "connect" || !validProfileSubcommand(subcommand) {
+			c.PrintCliError(fmt.Errorf("Unknown connect subcommand %q", subcommand))
+			return base.CommandUserError
+		}
+	}
+
+	// Parse the options using the connect command's own flags so that exactly
+	// the options it accepts can be saved.
+	cc := &Command{Command: c.Command, Func: subcommand}
+	if cc.Func == "" {
+		cc.Func = "connect"
+	}
+	f := cc.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if len(f.Args()) > 0 {
+		c.PrintCliError(fmt.Errorf("Unexpected arguments %q; arguments for the executed client must follow \" -- \"", f.Args()))
+		return base.CommandUserError
+	}
+	p, err := newProfile(name, subcommand, f, passthroughArgs)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	path, err := ProfilesPath()
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	profiles, err := readProfiles(path)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	var replaced bool
+	for i, existing := range profiles {
+		if existing.Name == name {
+			profiles[i] = p
+			replaced = true
+		}
+	}
+	if !replaced {
+		profiles = append(profiles, p)
+	}
+	if err := writeProfiles(path, profiles); err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	c.UI.Output(fmt.Sprintf("The connect profile %q was saved.", name))
+	return base.CommandSuccess
+}
+
+func (c *ProfilesCommand) delete(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if len(f.Args()) != 1 {
+		c.PrintCliError(errors.New("Exactly one profile name must be provided"))
+		return base.CommandUserError
+	}
+	name := f.Args()[0]
+
+	path, err := ProfilesPath()
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	profiles, err := readProfiles(path)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	remaining := make([]*Profile, 0, len(profiles))
+	for _, p := range profiles {
+		if p.Name != name {
+			remaining = append(remaining, p)
+		}
+	}
+	if len(remaining) == len(profiles) {
+		c.PrintCliError(fmt.Errorf("No connect profile named %q was found", name))
+		return base.CommandUserError
+	}
+	if err := writeProfiles(path, remaining); err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	c.UI.Output(fmt.Sprintf("The connect profile %q was deleted.", name))
+	return base.CommandSuccess
+}
+
+func validProfileSubcommand(s string) bool {
+	for _, sub := range profileSubcommands {
+		if s == sub {
+			return true
+		}
+	}
+	return false
+}
+
+func generateProfilesTableOutput(profiles []*Profile) string {
+	if len(profiles) == 0 {
+		return "No connect profiles found"
+	}
+	output := []string{
+		"",
+		"Connect profiles:",
+	}
+	for i, p := range profiles {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Name:                  %s", p.Name),
+			fmt.Sprintf("    Command:             %s", p.command()),
+		)
+	}
+	return strings.Join(output, "\n")
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileFlagValue(t *testing.T) {
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{args: nil, want: ""},
+		{args: []string{"-target-id", "ttcp_1"}, want: ""},
+		{args: []string{"-profile", "prod"}, want: "prod"},
+		{args: []string{"--profile", "prod"}, want: "prod"},
+		{args: []string{"-target-id=ttcp_1", "-profile=prod"}, want: "prod"},
+		{args: []string{"--profile=prod"}, want: "prod"},
+		{args: []string{"profile", "prod"}, want: ""},
+		{args: []string{"-profiles", "prod"}, want: ""},
+		{args: []string{"-profile"}, want: ""},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, profileFlagValue(tc.args), "args: %q", tc.args)
+	}
+}
+
+func TestProfilesCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", profilesFilename)
+	t.Setenv(EnvConnectProfilesPath, path)
+
+	run := func(t *testing.T, f string, args ...string) (int, *cli.MockUi) {
+		t.Helper()
+		ui := cli.NewMockUi()
+		c := &ProfilesCommand{Command: base.NewCommand(ui), Func: f}
+		return c.Run(args), ui
+	}
+
+	code, ui := run(t, "list")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+	assert.Equal(t, "No connect profiles found\n", ui.OutputWriter.String())
+
+	code, ui = run(t, "save", "prod-db", "postgres", "-target-id", "ttcp_1234567890", "-username=admin", "--", "-c", "select 1")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+	code, ui = run(t, "save", "web", "-target-name", "web", "-target-scope-name=proj", "-listen-port", "8080")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+
+	got, err := readProfiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, []*Profile{
+		{
+			Name:       "prod-db",
+			Subcommand: "postgres",
+			TargetId:   "ttcp_1234567890",
+			Flags:      []string{"-username=admin"},
+			Args:       []string{"-c", "select 1"},
+		},
+		{
+			Name:            "web",
+			TargetName:      "web",
+			TargetScopeName: "proj",
+			ListenPort:      8080,
+		},
+	}, got)
+	assert.Equal(t, []string{"-target-id=ttcp_1234567890", "-username=admin"}, got[0].flagArgs())
+	assert.Equal(t, `boundary connect postgres -target-id=ttcp_1234567890 -username=admin -- -c "select 1"`, got[0].command())
+
+	code, ui = run(t, "list")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+	assert.Contains(t, ui.OutputWriter.String(), "boundary connect -target-name=web -target-scope-name=proj -listen-port=8080")
+
+	p, err := lookupProfile("web")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-target-name=web", "-target-scope-name=proj", "-listen-port=8080"}, p.flagArgs())
+
+	// Saving with an existing name replaces the profile
+	code, ui = run(t, "save", "web", "-target-id", "ttcp_web")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+	p, err = lookupProfile("web")
+	require.NoError(t, err)
+	assert.Equal(t, &Profile{Name: "web", TargetId: "ttcp_web"}, p)
+
+	code, ui = run(t, "delete", "web")
+	require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+	_, err = lookupProfile("web")
+	assert.ErrorContains(t, err, `No connect profile named "web" was found`)
+
+	errCases := []struct {
+		name    string
+		f       string
+		args    []string
+		wantErr string
+	}{
+		{name: "save without name", f: "save", args: []string{"-target-id", "ttcp_1"}, wantErr: "A profile name must be provided"},
+		{name: "save unknown subcommand", f: "save", args: []string{"x", "telnet"}, wantErr: `Unknown connect subcommand "telnet"`},
+		{name: "save unknown flag", f: "save", args: []string{"x", "ssh", "-listen-port", "1"}, wantErr: "flag provided but not defined: -listen-port"},
+		{name: "save profile", f: "save", args: []string{"x", "-profile", "prod-db"}, wantErr: "cannot be saved with the -profile option"},
+		{name: "save stray args", f: "save", args: []string{"x", "-target-id", "ttcp_1", "extra"}, wantErr: `Unexpected arguments ["extra"]`},
+		{name: "delete missing", f: "delete", args: []string{"nope"}, wantErr: `No connect profile named "nope" was found`},
+		{name: "delete without name", f: "delete", wantErr: "Exactly one profile name must be provided"},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, ui := run(t, tc.f, tc.args...)
+			assert.Equal(t, base.CommandUserError, code)
+			assert.Contains(t, ui.ErrorWriter.String(), tc.wantErr)
+		})
+	}
+}
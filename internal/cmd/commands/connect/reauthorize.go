@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"go.uber.org/atomic"
+)
+
+const (
+	reauthorizeMinBackoff = time.Second
+	reauthorizeMaxBackoff = time.Minute
+)
+
+// reauthorizedSession is an authorized session along with the client proxy for
+// it.
+type reauthorizedSession struct {
+	id          string
+	proxy       sessionProxy
+	expiration  time.Time
+	connsLeft   int32
+	connsLeftCh chan int32
+}
+
+// sessionProxy is the part of a client proxy used to run a session.
+type sessionProxy interface {
+	Start() error
+}
+
+// reauthorizer runs client proxies on a shared listener, authorizing a new
+// session as soon as the current one expires or has no connections left. The
+// client proxy of an earlier session keeps running until the connections made
+// through it end. It is used by both "boundary connect -reauthorize" and
+// "boundary connect multi".
+type reauthorizer struct {
+	listener *sharedListener
+	// authorize authorizes a new session whose client proxy accepts
+	// connections from ln and sends the number of connections left in the
+	// session on the session's connsLeftCh.
+	authorize func(ctx context.Context, ln net.Listener) (*reauthorizedSession, error)
+
+	// The following are called, if set, as the sessions change. Except for
+	// stopped they are only called from the goroutine calling run.
+
+	// authorizing is called before authorizing a new session.
+	authorizing func()
+	// started is called once the client proxy of a session is started. ctx is
+	// canceled once the session is replaced or its client proxy stops.
+	started func(ctx context.Context, s *reauthorizedSession)
+	// connsLeft is called when the number of connections left in the current
+	// session changes.
+	connsLeft func(connsLeft int32)
+	// failed is called with the error from authorizing a session or from the
+	// client proxy of the current session, before waiting to try again.
+	failed func(err error)
+	// stopped is called with the error returned by each client proxy.
+	stopped func(err error)
+}
+
+// run runs the client proxy of each session until ctx is done, starting with
+// the given session if it isn't nil. It returns once every client proxy has
+// stopped.
+func (r *reauthorizer) run(ctx context.Context, s *reauthorizedSession) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	backoff := reauthorizeMinBackoff
+	retry := func(err error) bool {
+		if r.failed != nil {
+			r.failed(err)
+		}
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+		backoff = min(backoff*2, reauthorizeMaxBackoff)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		}
+	}
+
+	for ctx.Err() == nil {
+		if s == nil {
+			if r.authorizing != nil {
+				r.authorizing()
+			}
+			var err error
+			s, err = r.authorize(ctx, r.listener.view())
+			if err != nil {
+				if ctx.Err() != nil || !retry(err) {
+					return
+				}
+				continue
+			}
+		}
+
+		sessionCtx, sessionCancel := context.WithCancel(ctx)
+		proxyDone := make(chan error, 1)
+		wg.Add(1)
+		go func(p sessionProxy) {
+			defer wg.Done()
+			err := p.Start()
+			if r.stopped != nil {
+				r.stopped(err)
+			}
+			proxyDone <- err
+		}(s.proxy)
+		if r.started != nil {
+			r.started(sessionCtx, s)
+		}
+
+		var proxyErr error
+	session:
+		for {
+			select {
+			case connsLeft := <-s.connsLeftCh:
+				if r.connsLeft != nil {
+					r.connsLeft(connsLeft)
+				}
+				if connsLeft == 0 {
+					// The client proxy stops accepting connections but keeps
+					// running until the ones made through it end, so don't
+					// wait for it before authorizing the next session.
+					break session
+				}
+			case proxyErr = <-proxyDone:
+				break session
+			}
+		}
+		sessionCancel()
+		s = nil
+
+		if ctx.Err() != nil {
+			return
+		}
+		if proxyErr != nil {
+			if !retry(fmt.Errorf("Error from proxy client: %w", proxyErr)) {
+				return
+			}
+			continue
+		}
+		// The session expired or ran out of connections, which is expected,
+		// so a new one is authorized right away.
+		backoff = reauthorizeMinBackoff
+	}
+}
+
+// runWithReauthorization runs the client proxy of the first session and of
+// each session authorized to replace it on the shared listener. It returns
+// once the proxy context is canceled and every client proxy has stopped.
+func (c *Command) runWithReauthorization(
+	clientProxy *apiproxy.ClientProxy,
+	connsLeftCh chan int32,
+	listener *sharedListener,
+	targetClient *targets.Client,
+	sessionClient *sessions.Client,
+	proxyError *atomic.Error,
+) {
+	r := &reauthorizer{
+		listener: listener,
+		authorize: func(ctx context.Context, ln net.Listener) (*reauthorizedSession, error) {
+			return c.reauthorize(ctx, targetClient, ln)
+		},
+		connsLeft: c.updateConnsLeft,
+		failed:    c.PrintCliError,
+		stopped: func(err error) {
+			if err != nil {
+				proxyError.Store(err)
+			}
+		},
+	}
+	if sessionClient != nil {
+		r.started = func(ctx context.Context, s *reauthorizedSession) {
+			go c.autoExtend(ctx, sessionClient, s.proxy.(*apiproxy.ClientProxy), s.id)
+		}
+	}
+	r.run(c.proxyCtx, &reauthorizedSession{
+		id:          c.sessInfo.SessionId,
+		proxy:       clientProxy,
+		expiration:  clientProxy.SessionExpiration(),
+		connsLeft:   clientProxy.ConnectionsLeft(),
+		connsLeftCh: connsLeftCh,
+	})
+}
+
+// reauthorize authorizes a new session against the target and returns it
+// along with a client proxy for it which accepts connections from ln.
+func (c *Command) reauthorize(ctx context.Context, targetClient *targets.Client, ln net.Listener) (*reauthorizedSession, error) {
+	sar, err := targetClient.AuthorizeSession(ctx, c.flagTargetId, c.authorizeOptions()...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			return nil, fmt.Errorf("Error from controller when performing authorize-session action against given target: %s", apiErr.Message)
+		}
+		return nil, fmt.Errorf("Error trying to authorize a session against target: %w", err)
+	}
+	sa := sar.GetItem().(*targets.SessionAuthorization)
+
+	connsLeftCh := make(chan int32)
+	clientProxy, err := apiproxy.New(
+		ctx,
+		sa.AuthorizationToken,
+		apiproxy.WithListener(ln),
+		apiproxy.WithConnectionsLeftCh(connsLeftCh),
+		apiproxy.WithHeaders(c.TraceHeaders()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create client proxy: %w", err)
+	}
+
+	sessInfo := SessionInfo{
+		Protocol:        sa.Type,
+		Expiration:      clientProxy.SessionExpiration(),
+		ConnectionLimit: sa.ConnectionLimit,
+		SessionId:       sa.SessionId,
+		Credentials:     sa.Credentials,
+	}
+	if host, port, err := net.SplitHostPort(ln.Addr().String()); err == nil {
+		sessInfo.Address = host
+		sessInfo.Port, _ = strconv.Atoi(port)
+	}
+	c.updateSessionInfo(sessInfo)
+
+	return &reauthorizedSession{
+		id:          sa.SessionId,
+		proxy:       clientProxy,
+		expiration:  clientProxy.SessionExpiration(),
+		connsLeft:   clientProxy.ConnectionsLeft(),
+		connsLeftCh: connsLeftCh,
+	}, nil
+}
+
+// updateSessionInfo reports the session which was authorized to replace the
+// previous one. A command run by -exec or a helper subcommand was started with
+// the credentials brokered for the first session and can't be given the ones
+// brokered for the new session, so they are printed for the user instead.
+func (c *Command) updateSessionInfo(sessInfo SessionInfo) {
+	if c.flagExec != "" {
+		if len(sessInfo.Credentials) == 0 {
+			return
+		}
+		c.UI.Warn(fmt.Sprintf("Session %s was authorized with new credentials which are not passed to the running command.", sessInfo.SessionId))
+		if err := c.printCredentials(sessInfo.Credentials); err != nil {
+			c.PrintCliError(fmt.Errorf("Failed to print credentials: %w", err))
+		}
+		return
+	}
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateReauthorizationInfoTableOutput(sessInfo))
+	case "json":
+		out, err := json.Marshal(&sessInfo)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error marshaling session information: %w", err))
+			return
+		}
+		c.UI.Output(string(out))
+	}
+}
+
+func generateReauthorizationInfoTableOutput(in SessionInfo) string {
+	nonAttributeMap := map[string]any{
+		"Session ID":       in.SessionId,
+		"Protocol":         in.Protocol,
+		"Address":          in.Address,
+		"Port":             in.Port,
+		"Expiration":       in.Expiration.Local().Format(time.RFC1123),
+		"Connection Limit": in.ConnectionLimit,
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	ret := []string{
+		"",
+		"Session reauthorized:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	if len(in.Credentials) > 0 {
+		ret = append(ret, "")
+		ret = append(ret, generateCredentialTableOutputSlice(2, in.Credentials)...)
+	}
+	return base.WrapForHelpText(ret)
+}
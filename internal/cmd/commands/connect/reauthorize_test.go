@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReauthorizeRequiresTarget(t *testing.T) {
+	ui := cli.NewMockUi()
+	c := &Command{Command: base.NewCommand(ui), Func: "connect"}
+	assert.Equal(t, base.CommandUserError, c.Run([]string{"-authz-token", "token", "-reauthorize"}))
+	assert.Contains(t, ui.ErrorWriter.String(), "-reauthorize and -authz-token cannot both be specified")
+}
+
+func TestGenerateReauthorizationInfoTableOutput(t *testing.T) {
+	got := generateReauthorizationInfoTableOutput(SessionInfo{
+		SessionId:       "s_1234567890",
+		Protocol:        "tcp",
+		Address:         "127.0.0.1",
+		Port:            5432,
+		ConnectionLimit: -1,
+	})
+	assert.Contains(t, got, "Session reauthorized:")
+	assert.Contains(t, got, "Session ID:          s_1234567890")
+	assert.Contains(t, got, "Port:                5432")
+	assert.NotContains(t, got, "Credentials:")
+}
+
+func TestUpdateSessionInfoCredentials(t *testing.T) {
+	creds := []*targets.SessionCredential{
+		{
+			CredentialSource: &targets.CredentialSource{
+				Id:                "clvlt_1234567890",
+				CredentialStoreId: "csvlt_1234567890",
+				Type:              "vault-generic",
+			},
+			Secret:     &targets.SessionSecret{Raw: []byte(`"secret"`)},
+			Credential: map[string]any{"username": "user", "password": "new-password"},
+		},
+	}
+	sessInfo := SessionInfo{SessionId: "s_1234567890", Credentials: creds}
+
+	t.Run("table", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := &Command{Command: base.NewCommand(ui)}
+		c.updateSessionInfo(sessInfo)
+		assert.Contains(t, ui.OutputWriter.String(), "Session reauthorized:")
+		assert.Contains(t, ui.OutputWriter.String(), "Credentials:")
+		assert.Contains(t, ui.OutputWriter.String(), "new-password")
+	})
+	t.Run("exec", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := &Command{Command: base.NewCommand(ui), flagExec: "psql"}
+		c.updateSessionInfo(sessInfo)
+		assert.Contains(t, ui.ErrorWriter.String(), "Session s_1234567890 was authorized with new credentials which are not passed to the running command.")
+		assert.NotContains(t, ui.OutputWriter.String(), "Session reauthorized:")
+		assert.Contains(t, ui.OutputWriter.String(), "new-password")
+	})
+	t.Run("exec without credentials", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := &Command{Command: base.NewCommand(ui), flagExec: "psql"}
+		c.updateSessionInfo(SessionInfo{SessionId: "s_1234567890"})
+		assert.Empty(t, ui.ErrorWriter.String())
+		assert.Empty(t, ui.OutputWriter.String())
+	})
+}
+
+// fakeSessionProxy is a client proxy for a session with a connection limit
+// which writes the session's ID to each connection it accepts.
+type fakeSessionProxy struct {
+	ctx         context.Context
+	id          string
+	ln          net.Listener
+	connsLeft   int32
+	connsLeftCh chan int32
+}
+
+func (p *fakeSessionProxy) Start() error {
+	stop := context.AfterFunc(p.ctx, func() { p.ln.Close() })
+	defer stop()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for p.connsLeft > 0 {
+		conn, err := p.ln.Accept()
+		if err != nil {
+			return nil
+		}
+		p.connsLeft--
+		p.connsLeftCh <- p.connsLeft
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			conn.Write([]byte(p.id))
+			io.Copy(io.Discard, conn)
+		}()
+	}
+	return p.ln.Close()
+}
+
+func TestReauthorizer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := newSharedListener(ln)
+	t.Cleanup(func() { l.Close() })
+
+	var authorized atomic.Int32
+	var connsLeft []int32
+	r := &reauthorizer{
+		listener: l,
+		authorize: func(ctx context.Context, ln net.Listener) (*reauthorizedSession, error) {
+			id := fmt.Sprintf("s_%d", authorized.Add(1))
+			connsLeftCh := make(chan int32)
+			return &reauthorizedSession{
+				id:        id,
+				connsLeft: 1,
+				proxy: &fakeSessionProxy{
+					ctx:         ctx,
+					id:          id,
+					ln:          ln,
+					connsLeft:   1,
+					connsLeftCh: connsLeftCh,
+				},
+				connsLeftCh: connsLeftCh,
+			}, nil
+		},
+		connsLeft: func(n int32) {
+			connsLeft = append(connsLeft, n)
+		},
+		failed: func(err error) {
+			t.Errorf("unexpected failure: %v", err)
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.run(ctx, nil)
+	}()
+
+	dial := func(t *testing.T) (net.Conn, string) {
+		t.Helper()
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		buf := make([]byte, len("s_1"))
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		return conn, string(buf)
+	}
+
+	first, sessionId := dial(t)
+	defer first.Close()
+	assert.Equal(t, "s_1", sessionId)
+
+	// The first session's only connection is still open, so its client proxy
+	// is still running, but the next connection is served by a new session.
+	second, sessionId := dial(t)
+	defer second.Close()
+	assert.Equal(t, "s_2", sessionId)
+
+	first.Close()
+	second.Close()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reauthorizer did not stop after the context was canceled")
+	}
+	assert.Equal(t, []int32{0, 0}, connsLeft)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "redis"}
+	c.flagRedisStyle = "redis-cli"
+	c.flagDbname = "2"
+
+	args, envs, creds, err := c.redisFlags.buildArgs(c, "6379", "127.0.0.1", "127.0.0.1:6379", proxy.Credentials{
+		UsernamePassword: []proxy.UsernamePassword{{Username: "admin", Password: "secret"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, creds.UsernamePassword[0].Consumed)
+	assert.Equal(t, []string{"-h", "127.0.0.1", "-p", "6379", "--user", "admin", "-n", "2"}, args)
+	assert.Equal(t, []string{"REDISCLI_AUTH=secret"}, envs)
+}
@@ -0,0 +1,558 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+const (
+	// socksTargetsMaxAge is how long the list of targets is used before it is
+	// listed again.
+	socksTargetsMaxAge = time.Minute
+
+	// socksTargetsMinAge is how long the list of targets is used before a
+	// request for an unknown host causes it to be listed again.
+	socksTargetsMinAge = 5 * time.Second
+
+	// socksHandshakeTimeout bounds how long a client has to send its request.
+	socksHandshakeTimeout = 30 * time.Second
+)
+
+var (
+	_ cli.Command             = (*SocksCommand)(nil)
+	_ cli.CommandAutocomplete = (*SocksCommand)(nil)
+)
+
+// SocksSessionInfo is reported whenever a session is authorized for a target.
+type SocksSessionInfo struct {
+	TargetId        string    `json:"target_id"`
+	TargetName      string    `json:"target_name,omitempty"`
+	SessionId       string    `json:"session_id"`
+	Expiration      time.Time `json:"expiration"`
+	ConnectionLimit int32     `json:"connection_limit"`
+}
+
+// SocksCommand runs a local SOCKS5 and HTTP CONNECT proxy which connects to
+// Boundary targets by ID, name or address, authorizing sessions as they are
+// needed and reusing them for later connections to the same target.
+type SocksCommand struct {
+	*base.Command
+
+	flagListenAddr string
+	flagListenPort int
+
+	resolver *targetResolver
+	sessions *socksSessions
+}
+
+func (c *SocksCommand) Synopsis() string {
+	return "Run a local SOCKS5 and HTTP CONNECT proxy to Boundary targets"
+}
+
+func (c *SocksCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary connect socks [options]",
+		"",
+		"  This command runs a local proxy which accepts both SOCKS5 and HTTP CONNECT requests. The requested host is matched against the ID, name and address of the targets the user can authorize sessions against, and the connection is proxied through a session for the matching target. A target matched by name or address must have the requested port as its default port, unless it has no default port. If more than one target matches, the one whose default port is the requested port is used. Sessions are authorized when first needed and reused until they expire or run out of connections.",
+		"",
+		"  Example:",
+		"",
+		`      $ boundary connect socks -listen-port 1080`,
+		"",
+		`      $ curl --proxy socks5h://127.0.0.1:1080 http://web-server/`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SocksCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "listen-addr",
+		Target:     &c.flagListenAddr,
+		Default:    "127.0.0.1",
+		EnvVar:     "BOUNDARY_CONNECT_SOCKS_LISTEN_ADDR",
+		Completion: complete.PredictAnything,
+		Usage:      `The IP address the proxy listens on.`,
+	})
+
+	f.IntVar(&base.IntVar{
+		Name:       "listen-port",
+		Target:     &c.flagListenPort,
+		Default:    1080,
+		EnvVar:     "BOUNDARY_CONNECT_SOCKS_LISTEN_PORT",
+		Completion: complete.PredictAnything,
+		Usage:      `The port the proxy listens on.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "scope-id",
+		Target:     &c.FlagScopeId,
+		Default:    scope.Global.String(),
+		EnvVar:     "BOUNDARY_SCOPE_ID",
+		Completion: complete.PredictAnything,
+		Usage:      `The scope in which to look for targets. Targets in its child scopes are included.`,
+	})
+
+	return set
+}
+
+func (c *SocksCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *SocksCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SocksCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if c.flagListenPort < 0 || c.flagListenPort > math.MaxUint16 {
+		c.PrintCliError(errors.New("Invalid listen port supplied"))
+		return base.CommandUserError
+	}
+	addr, err := netip.ParseAddr(c.flagListenAddr)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing listen address: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %s", err))
+		return base.CommandCliError
+	}
+	targetClient := targets.NewClient(client)
+	c.resolver = &targetResolver{
+		list: func(ctx context.Context) ([]*targets.Target, error) {
+			result, err := targetClient.List(ctx, c.FlagScopeId, targets.WithRecursive(true))
+			if err != nil {
+				return nil, err
+			}
+			return result.GetItems(), nil
+		},
+	}
+	c.sessions = &socksSessions{
+		ctx:       c.Context,
+		authorize: c.authorizeFunc(targetClient),
+		entries:   make(map[string]*socksSessionEntry),
+	}
+
+	ln, err := net.ListenTCP("tcp", net.TCPAddrFromAddrPort(netip.AddrPortFrom(addr, uint16(c.flagListenPort))))
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Unable to start listening: %w", err))
+		return base.CommandCliError
+	}
+	c.printListenerInfo(ln.Addr().(*net.TCPAddr))
+
+	go func() {
+		<-c.Context.Done()
+		ln.Close()
+	}()
+
+	var wg sync.WaitGroup
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if c.Context.Err() != nil || errors.Is(err, net.ErrClosed) {
+				break
+			}
+			var te interface{ Temporary() bool }
+			if errors.As(err, &te) && te.Temporary() {
+				continue
+			}
+			c.PrintCliError(fmt.Errorf("Error accepting connection: %w", err))
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.handleConn(conn); err != nil {
+				c.PrintCliError(err)
+			}
+		}()
+	}
+	wg.Wait()
+	c.sessions.wait()
+
+	return base.CommandSuccess
+}
+
+// handleConn reads the client's request from conn and hands the connection to
+// the client proxy of a session for the requested target.
+func (c *SocksCommand) handleConn(conn net.Conn) error {
+	conn.SetDeadline(time.Now().Add(socksHandshakeTimeout))
+	req, err := readProxyRequest(conn)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("Error from proxy client %s: %w", conn.RemoteAddr(), err)
+	}
+	conn.SetDeadline(time.Time{})
+
+	t, err := c.resolver.resolve(c.Context, req.host, req.port)
+	if err == nil {
+		err = c.sessions.forward(t, req.conn, req.reply)
+	} else {
+		req.reply(err)
+	}
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("Error connecting to %s: %w", net.JoinHostPort(req.host, fmt.Sprint(req.port)), err)
+	}
+	return nil
+}
+
+// authorizeFunc returns the function used to authorize a session for a target
+// and start a client proxy for it which accepts connections from ln.
+func (c *SocksCommand) authorizeFunc(targetClient *targets.Client) func(ctx context.Context, t *targets.Target, ln net.Listener) (socksProxy, error) {
+	return func(ctx context.Context, t *targets.Target, ln net.Listener) (socksProxy, error) {
+		sar, err := targetClient.AuthorizeSession(ctx, t.Id)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				return nil, fmt.Errorf("error from controller when performing authorize-session action against target %s: %s", t.Id, apiErr.Message)
+			}
+			return nil, fmt.Errorf("error trying to authorize a session against target %s: %w", t.Id, err)
+		}
+		sa := sar.GetItem().(*targets.SessionAuthorization)
+		p, err := apiproxy.New(ctx, sa.AuthorizationToken,
+			apiproxy.WithListener(ln),
+			apiproxy.WithHeaders(c.TraceHeaders()))
+		if err != nil {
+			return nil, fmt.Errorf("could not create client proxy: %w", err)
+		}
+		c.printSessionInfo(SocksSessionInfo{
+			TargetId:        t.Id,
+			TargetName:      t.Name,
+			SessionId:       sa.SessionId,
+			Expiration:      p.SessionExpiration(),
+			ConnectionLimit: sa.ConnectionLimit,
+		})
+		return p, nil
+	}
+}
+
+func (c *SocksCommand) printListenerInfo(addr *net.TCPAddr) {
+	switch base.Format(c.UI) {
+	case "table":
+		m := map[string]any{
+			"Address": addr.IP.String(),
+			"Port":    addr.Port,
+		}
+		c.UI.Output(base.WrapForHelpText([]string{
+			"",
+			"Proxy listening information:",
+			base.WrapMap(2, base.MaxAttributesLength(m, nil, nil)+2, m),
+		}))
+	case "json":
+		out, err := json.Marshal(map[string]any{
+			"address": addr.IP.String(),
+			"port":    addr.Port,
+		})
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error marshaling listener information: %w", err))
+			return
+		}
+		c.UI.Output(string(out))
+	}
+}
+
+func (c *SocksCommand) printSessionInfo(in SocksSessionInfo) {
+	switch base.Format(c.UI) {
+	case "table":
+		m := map[string]any{
+			"Target ID":        in.TargetId,
+			"Session ID":       in.SessionId,
+			"Expiration":       in.Expiration.Local().Format(time.RFC1123),
+			"Connection Limit": in.ConnectionLimit,
+		}
+		if in.TargetName != "" {
+			m["Target Name"] = in.TargetName
+		}
+		c.UI.Output(base.WrapForHelpText([]string{
+			"",
+			"Session authorized:",
+			base.WrapMap(2, base.MaxAttributesLength(m, nil, nil)+2, m),
+		}))
+	case "json":
+		out, err := json.Marshal(&in)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error marshaling session information: %w", err))
+			return
+		}
+		c.UI.Output(string(out))
+	}
+}
+
+// targetResolver finds the target for a requested host using a periodically
+// refreshed list of targets.
+type targetResolver struct {
+	list func(context.Context) ([]*targets.Target, error)
+
+	lock      sync.Mutex
+	targets   []*targets.Target
+	refreshed time.Time
+}
+
+func (r *targetResolver) resolve(ctx context.Context, host string, port int) (*targets.Target, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if time.Since(r.refreshed) > socksTargetsMaxAge {
+		if err := r.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+	t, err := matchTarget(r.targets, host, port)
+	if errors.Is(err, errUnknownTarget) && time.Since(r.refreshed) > socksTargetsMinAge {
+		// The target may have been created since the targets were listed.
+		if err := r.refresh(ctx); err != nil {
+			return nil, err
+		}
+		t, err = matchTarget(r.targets, host, port)
+	}
+	return t, err
+}
+
+func (r *targetResolver) refresh(ctx context.Context) error {
+	ts, err := r.list(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing targets: %w", err)
+	}
+	r.targets = ts
+	r.refreshed = time.Now()
+	return nil
+}
+
+// matchTarget returns the target which the user can authorize sessions against
+// whose ID, name or address is the host. A target whose ID is the host is
+// returned regardless of the port since the ID identifies a single target.
+// Otherwise the target's default port must be the requested port, unless it has
+// no default port. If more than one target matches, the one whose default port
+// is the requested port is returned.
+func matchTarget(ts []*targets.Target, host string, port int) (*targets.Target, error) {
+	var matches []*targets.Target
+	for _, t := range ts {
+		if !slices.Contains(t.AuthorizedActions, "authorize-session") {
+			continue
+		}
+		if t.Id == host {
+			return t, nil
+		}
+		if strings.EqualFold(t.Name, host) || (t.Address != "" && strings.EqualFold(t.Address, host)) {
+			matches = append(matches, t)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w %q", errUnknownTarget, host)
+	}
+	var onPort, anyPort []*targets.Target
+	for _, t := range matches {
+		switch targetDefaultPort(t) {
+		case port:
+			onPort = append(onPort, t)
+		case 0:
+			anyPort = append(anyPort, t)
+		}
+	}
+	candidates := onPort
+	if len(candidates) == 0 {
+		candidates = anyPort
+	}
+	switch len(candidates) {
+	case 0:
+		ports := make([]string, 0, len(matches))
+		for _, t := range matches {
+			ports = append(ports, fmt.Sprintf("%s on port %d", t.Id, targetDefaultPort(t)))
+		}
+		return nil, fmt.Errorf("%w %q on port %d: %s", errUnknownTarget, host, port, strings.Join(ports, ", "))
+	case 1:
+		return candidates[0], nil
+	default:
+		ids := make([]string, 0, len(candidates))
+		for _, t := range candidates {
+			ids = append(ids, t.Id)
+		}
+		return nil, fmt.Errorf("more than one target matches %q: %s", host, strings.Join(ids, ", "))
+	}
+}
+
+// targetDefaultPort returns the default port from the target's attributes, or
+// 0 if it has none.
+func targetDefaultPort(t *targets.Target) int {
+	if p, ok := t.Attributes["default_port"].(float64); ok {
+		return int(p)
+	}
+	return 0
+}
+
+// socksSessions holds the sessions which were authorized for targets so that
+// they can be reused for later connections.
+type socksSessions struct {
+	ctx       context.Context
+	authorize func(context.Context, *targets.Target, net.Listener) (socksProxy, error)
+
+	lock    sync.Mutex
+	entries map[string]*socksSessionEntry
+	wg      sync.WaitGroup
+}
+
+// socksProxy is the client proxy of a session, which proxies the connections
+// accepted from its listener once started.
+type socksProxy interface {
+	Start() error
+}
+
+type socksSessionEntry struct {
+	// lock is held while authorizing a session so that concurrent connections
+	// to the same target share one session.
+	lock sync.Mutex
+	ln   *connListener
+}
+
+// forward hands conn to the client proxy of the target's current session,
+// authorizing a new session if the target has none or it has ended. The
+// client is sent a reply once a session is available.
+func (s *socksSessions) forward(t *targets.Target, conn net.Conn, reply func(error) error) error {
+	s.lock.Lock()
+	e, ok := s.entries[t.Id]
+	if !ok {
+		e = &socksSessionEntry{}
+		s.entries[t.Id] = e
+	}
+	s.lock.Unlock()
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var replied bool
+	// A session can end between checking it and handing it a connection, in
+	// which case a new session is authorized and the handoff retried.
+	for i := 0; i < 2; i++ {
+		if e.ln == nil || e.ln.isClosed() {
+			ln := newConnListener()
+			p, err := s.authorize(s.ctx, t, ln)
+			if err != nil {
+				if !replied {
+					reply(err)
+				}
+				return err
+			}
+			e.ln = ln
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				// The client proxy closes its listener when it stops, which
+				// marks the session as ended.
+				defer ln.Close()
+				p.Start()
+			}()
+		}
+		if !replied {
+			if err := reply(nil); err != nil {
+				return err
+			}
+			replied = true
+		}
+		if e.ln.push(conn) {
+			return nil
+		}
+	}
+	return errors.New("session ended before the connection could be proxied")
+}
+
+// wait waits for the client proxies of all sessions to stop.
+func (s *socksSessions) wait() {
+	s.wg.Wait()
+}
+
+// connListener is a listener for connections which were accepted elsewhere and
+// are handed to it with push.
+type connListener struct {
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnListener() *connListener {
+	return &connListener{
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+// push hands conn to the next call to Accept, returning false if the listener
+// is closed first.
+func (l *connListener) push(conn net.Conn) bool {
+	if l.isClosed() {
+		return false
+	}
+	select {
+	case l.conns <- conn:
+		return true
+	case <-l.closed:
+		return false
+	}
+}
+
+func (l *connListener) isClosed() bool {
+	select {
+	case <-l.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return connListenerAddr{}
+}
+
+type connListenerAddr struct{}
+
+func (connListenerAddr) Network() string { return "pipe" }
+func (connListenerAddr) String() string  { return "pipe" }
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+const (
+	socks5Version = 0x05
+
+	socks5MethodNoAuth       = 0x00
+	socks5MethodNoAcceptable = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5ReplySucceeded           = 0x00
+	socks5ReplyGeneralFailure      = 0x01
+	socks5ReplyHostUnreachable     = 0x04
+	socks5ReplyCmdNotSupported     = 0x07
+	socks5ReplyAddrTypeUnsupported = 0x08
+)
+
+// errUnknownTarget is returned when no target matches the requested host.
+var errUnknownTarget = errors.New("no target matches the requested host")
+
+// proxyRequest is a request to connect to a host made by a SOCKS5 or HTTP
+// CONNECT client.
+type proxyRequest struct {
+	host string
+	port int
+	// conn is the client's connection, which should be used in place of the
+	// accepted one as it may return data read along with the request.
+	conn net.Conn
+	// reply tells the client whether its request succeeded.
+	reply func(err error) error
+}
+
+// readProxyRequest reads the request of a SOCKS5 or HTTP CONNECT client from
+// conn, telling the two apart by the first byte sent.
+func readProxyRequest(conn net.Conn) (*proxyRequest, error) {
+	br := bufio.NewReader(conn)
+	first, err := br.Peek(1)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request: %w", err)
+	}
+	rw := &bufferedConn{Conn: conn, r: br}
+	if first[0] == socks5Version {
+		return readSocks5Request(rw)
+	}
+	return readHttpConnectRequest(rw, br)
+}
+
+func readSocks5Request(conn *bufferedConn) (*proxyRequest, error) {
+	// The greeting is the version followed by the authentication methods the
+	// client supports. Only connecting without authentication is supported
+	// since the listener is local and sessions are authorized with the
+	// user's own token.
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, fmt.Errorf("error reading SOCKS greeting: %w", err)
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return nil, fmt.Errorf("error reading SOCKS authentication methods: %w", err)
+	}
+	var noAuth bool
+	for _, m := range methods {
+		if m == socks5MethodNoAuth {
+			noAuth = true
+		}
+	}
+	if !noAuth {
+		conn.Write([]byte{socks5Version, socks5MethodNoAcceptable})
+		return nil, errors.New("SOCKS client does not support connecting without authentication")
+	}
+	if _, err := conn.Write([]byte{socks5Version, socks5MethodNoAuth}); err != nil {
+		return nil, fmt.Errorf("error writing SOCKS method selection: %w", err)
+	}
+
+	// The request is the version, command, a reserved byte and the address
+	// type, followed by the address and port.
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(conn, req); err != nil {
+		return nil, fmt.Errorf("error reading SOCKS request: %w", err)
+	}
+	if req[0] != socks5Version {
+		return nil, fmt.Errorf("unsupported SOCKS version %d", req[0])
+	}
+	if req[1] != socks5CmdConnect {
+		writeSocks5Reply(conn, socks5ReplyCmdNotSupported)
+		return nil, fmt.Errorf("unsupported SOCKS command %d", req[1])
+	}
+	var host string
+	switch req[3] {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if req[3] == socks5AddrIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return nil, fmt.Errorf("error reading SOCKS address: %w", err)
+		}
+		host = ip.String()
+	case socks5AddrDomain:
+		l := make([]byte, 1)
+		if _, err := io.ReadFull(conn, l); err != nil {
+			return nil, fmt.Errorf("error reading SOCKS address: %w", err)
+		}
+		domain := make([]byte, l[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return nil, fmt.Errorf("error reading SOCKS address: %w", err)
+		}
+		host = string(domain)
+	default:
+		writeSocks5Reply(conn, socks5ReplyAddrTypeUnsupported)
+		return nil, fmt.Errorf("unsupported SOCKS address type %d", req[3])
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return nil, fmt.Errorf("error reading SOCKS port: %w", err)
+	}
+
+	return &proxyRequest{
+		host: host,
+		port: int(binary.BigEndian.Uint16(port)),
+		conn: conn,
+		reply: func(err error) error {
+			switch {
+			case err == nil:
+				return writeSocks5Reply(conn, socks5ReplySucceeded)
+			case errors.Is(err, errUnknownTarget):
+				return writeSocks5Reply(conn, socks5ReplyHostUnreachable)
+			default:
+				return writeSocks5Reply(conn, socks5ReplyGeneralFailure)
+			}
+		},
+	}, nil
+}
+
+// writeSocks5Reply writes a reply with the given code. The bound address is
+// left unspecified since the connection is proxied through a worker.
+func writeSocks5Reply(w io.Writer, code byte) error {
+	_, err := w.Write([]byte{socks5Version, code, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+func readHttpConnectRequest(conn *bufferedConn, br *bufio.Reader) (*proxyRequest, error) {
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		writeHttpReply(conn, http.StatusBadRequest, err.Error())
+		return nil, fmt.Errorf("error reading HTTP request: %w", err)
+	}
+	if req.Method != http.MethodConnect {
+		writeHttpReply(conn, http.StatusMethodNotAllowed, "only CONNECT requests are supported")
+		return nil, fmt.Errorf("unsupported HTTP method %q", req.Method)
+	}
+	host, portStr, err := net.SplitHostPort(req.Host)
+	if err != nil {
+		writeHttpReply(conn, http.StatusBadRequest, err.Error())
+		return nil, fmt.Errorf("error parsing requested address %q: %w", req.Host, err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		writeHttpReply(conn, http.StatusBadRequest, fmt.Sprintf("invalid port %q", portStr))
+		return nil, fmt.Errorf("error parsing requested port %q: %w", portStr, err)
+	}
+
+	return &proxyRequest{
+		host: host,
+		port: int(port),
+		conn: conn,
+		reply: func(err error) error {
+			switch {
+			case err == nil:
+				_, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+				return err
+			case errors.Is(err, errUnknownTarget):
+				return writeHttpReply(conn, http.StatusNotFound, err.Error())
+			default:
+				return writeHttpReply(conn, http.StatusBadGateway, err.Error())
+			}
+		},
+	}, nil
+}
+
+func writeHttpReply(w io.Writer, code int, msg string) error {
+	_, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\nContent-Type: text/plain\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s\n",
+		code, http.StatusText(code), len(msg)+1, msg)
+	return err
+}
+
+// bufferedConn is a connection whose reads are served from a buffered reader
+// so that data buffered while reading the request isn't lost.
+type bufferedConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
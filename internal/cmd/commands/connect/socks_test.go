@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProxyRequest(t *testing.T) {
+	// serve reads a request from the server side of a pipe while the client
+	// function runs against the client side.
+	serve := func(t *testing.T, client func(net.Conn)) (*proxyRequest, error) {
+		t.Helper()
+		c, s := net.Pipe()
+		t.Cleanup(func() {
+			c.Close()
+			s.Close()
+		})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			client(c)
+		}()
+		req, err := readProxyRequest(s)
+		if err != nil {
+			s.Close()
+		}
+		<-done
+		return req, err
+	}
+
+	t.Run("socks5 domain", func(t *testing.T) {
+		var methodReply []byte
+		req, err := serve(t, func(c net.Conn) {
+			c.Write([]byte{0x05, 0x02, 0x02, 0x00})
+			methodReply = make([]byte, 2)
+			io.ReadFull(c, methodReply)
+			c.Write(append(append([]byte{0x05, 0x01, 0x00, 0x03, 0x02}, "db"...), 0x15, 0x38))
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x05, 0x00}, methodReply)
+		assert.Equal(t, "db", req.host)
+		assert.Equal(t, 5432, req.port)
+	})
+
+	t.Run("socks5 ipv4 reply", func(t *testing.T) {
+		c, s := net.Pipe()
+		defer c.Close()
+		defer s.Close()
+		go func() {
+			c.Write([]byte{0x05, 0x01, 0x00})
+			io.ReadFull(c, make([]byte, 2))
+			c.Write([]byte{0x05, 0x01, 0x00, 0x01, 10, 0, 0, 1, 0x00, 0x16})
+		}()
+		req, err := readProxyRequest(s)
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1", req.host)
+		assert.Equal(t, 22, req.port)
+
+		for _, tc := range []struct {
+			err  error
+			code byte
+		}{
+			{err: nil, code: socks5ReplySucceeded},
+			{err: errUnknownTarget, code: socks5ReplyHostUnreachable},
+			{err: errors.New("boom"), code: socks5ReplyGeneralFailure},
+		} {
+			go req.reply(tc.err)
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(c, reply)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{0x05, tc.code, 0x00, 0x01, 0, 0, 0, 0, 0, 0}, reply)
+		}
+	})
+
+	t.Run("socks5 without no auth", func(t *testing.T) {
+		var methodReply []byte
+		_, err := serve(t, func(c net.Conn) {
+			c.Write([]byte{0x05, 0x01, 0x02})
+			methodReply = make([]byte, 2)
+			io.ReadFull(c, methodReply)
+		})
+		assert.ErrorContains(t, err, "does not support connecting without authentication")
+		assert.Equal(t, []byte{0x05, 0xff}, methodReply)
+	})
+
+	t.Run("socks5 bind", func(t *testing.T) {
+		var reply []byte
+		_, err := serve(t, func(c net.Conn) {
+			c.Write([]byte{0x05, 0x01, 0x00})
+			io.ReadFull(c, make([]byte, 2))
+			c.Write([]byte{0x05, 0x02, 0x00, 0x01})
+			reply = make([]byte, 10)
+			io.ReadFull(c, reply)
+		})
+		assert.ErrorContains(t, err, "unsupported SOCKS command 2")
+		assert.Equal(t, byte(socks5ReplyCmdNotSupported), reply[1])
+	})
+
+	t.Run("http connect", func(t *testing.T) {
+		c, s := net.Pipe()
+		defer c.Close()
+		defer s.Close()
+		go c.Write([]byte("CONNECT db:5432 HTTP/1.1\r\nHost: db:5432\r\n\r\nhello"))
+		req, err := readProxyRequest(s)
+		require.NoError(t, err)
+		assert.Equal(t, "db", req.host)
+		assert.Equal(t, 5432, req.port)
+
+		go req.reply(nil)
+		resp, err := http.ReadResponse(bufio.NewReader(c), nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// Data sent along with the request is not lost
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(req.conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(buf))
+	})
+
+	t.Run("http connect unknown target", func(t *testing.T) {
+		c, s := net.Pipe()
+		defer c.Close()
+		defer s.Close()
+		go c.Write([]byte("CONNECT db:5432 HTTP/1.1\r\nHost: db:5432\r\n\r\n"))
+		req, err := readProxyRequest(s)
+		require.NoError(t, err)
+		go req.reply(fmt.Errorf("%w %q", errUnknownTarget, "db"))
+		resp, err := http.ReadResponse(bufio.NewReader(c), nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("http get", func(t *testing.T) {
+		var resp *http.Response
+		_, err := serve(t, func(c net.Conn) {
+			c.Write([]byte("GET http://db/ HTTP/1.1\r\nHost: db\r\n\r\n"))
+			resp, _ = http.ReadResponse(bufio.NewReader(c), nil)
+		})
+		assert.ErrorContains(t, err, `unsupported HTTP method "GET"`)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func TestMatchTarget(t *testing.T) {
+	authorizable := []string{"read", "authorize-session"}
+	ts := []*targets.Target{
+		{Id: "ttcp_web1", Name: "web", AuthorizedActions: authorizable, Attributes: map[string]any{"default_port": float64(80)}},
+		{Id: "ttcp_web2", Name: "web", AuthorizedActions: authorizable, Attributes: map[string]any{"default_port": float64(443)}},
+		{Id: "ttcp_db", Name: "DB", AuthorizedActions: authorizable, Address: "10.0.0.5"},
+		{Id: "ttcp_ro", Name: "readonly", AuthorizedActions: []string{"read"}},
+		{Id: "ttcp_a1", Name: "a", AuthorizedActions: authorizable},
+		{Id: "ttcp_a2", Name: "a", AuthorizedActions: authorizable},
+		{Id: "ttcp_named", Name: "ttcp_a1", AuthorizedActions: authorizable, Attributes: map[string]any{"default_port": float64(22)}},
+		{Id: "ttcp_ro2", Name: "ro", AuthorizedActions: []string{"read"}},
+		{Id: "ttcp_pg", Name: "pg", AuthorizedActions: authorizable, Attributes: map[string]any{"default_port": float64(5432)}},
+	}
+	cases := []struct {
+		host    string
+		port    int
+		wantId  string
+		wantErr string
+	}{
+		{host: "db", port: 5432, wantId: "ttcp_db"},
+		{host: "pg", port: 5432, wantId: "ttcp_pg"},
+		{host: "pg", port: 5433, wantErr: "no target matches the requested host \"pg\" on port 5433: ttcp_pg on port 5432"},
+		{host: "ttcp_web2", port: 8080, wantId: "ttcp_web2"},
+		{host: "ttcp_a1", port: 22, wantId: "ttcp_a1"},
+		{host: "ttcp_ro2", port: 1, wantErr: "no target matches the requested host \"ttcp_ro2\""},
+		{host: "TTCP_WEB2", port: 1, wantErr: "no target matches the requested host"},
+		{host: "10.0.0.5", port: 5432, wantId: "ttcp_db"},
+		{host: "web", port: 443, wantId: "ttcp_web2"},
+		{host: "web", port: 80, wantId: "ttcp_web1"},
+		{host: "web", port: 8080, wantErr: "no target matches the requested host \"web\" on port 8080: ttcp_web1 on port 80, ttcp_web2 on port 443"},
+		{host: "a", port: 1, wantErr: "more than one target matches"},
+		{host: "readonly", port: 1, wantErr: "no target matches the requested host \"readonly\""},
+		{host: "nope", port: 1, wantErr: "no target matches the requested host"},
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s:%d", tc.host, tc.port), func(t *testing.T) {
+			got, err := matchTarget(ts, tc.host, tc.port)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantId, got.Id)
+		})
+	}
+}
+
+func TestTargetResolver(t *testing.T) {
+	var lists int
+	ts := []*targets.Target{}
+	r := &targetResolver{
+		list: func(context.Context) ([]*targets.Target, error) {
+			lists++
+			return ts, nil
+		},
+	}
+	ctx := context.Background()
+	_, err := r.resolve(ctx, "web", 80)
+	assert.ErrorIs(t, err, errUnknownTarget)
+	assert.Equal(t, 1, lists)
+
+	// A target created since the targets were listed is found once they are
+	// old enough to be listed again.
+	ts = append(ts, &targets.Target{Id: "ttcp_web", Name: "web", AuthorizedActions: []string{"authorize-session"}})
+	_, err = r.resolve(ctx, "web", 80)
+	assert.ErrorIs(t, err, errUnknownTarget)
+	assert.Equal(t, 1, lists)
+	r.refreshed = r.refreshed.Add(-socksTargetsMinAge)
+	got, err := r.resolve(ctx, "web", 80)
+	require.NoError(t, err)
+	assert.Equal(t, "ttcp_web", got.Id)
+	assert.Equal(t, 2, lists)
+}
+
+// testSocksProxy echoes the data of the connections accepted from its
+// listener until the listener is closed or the connection limit is reached.
+type testSocksProxy struct {
+	ln    net.Listener
+	limit int
+}
+
+func (p *testSocksProxy) Start() error {
+	defer p.ln.Close()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for i := 0; p.limit < 0 || i < p.limit; i++ {
+		conn, err := p.ln.Accept()
+		if err != nil {
+			return nil
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			io.Copy(conn, conn)
+		}()
+	}
+	return nil
+}
+
+func TestSocksSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var authorized int
+	limit := -1
+	s := &socksSessions{
+		ctx: ctx,
+		authorize: func(ctx context.Context, t *targets.Target, ln net.Listener) (socksProxy, error) {
+			if t.Id == "ttcp_denied" {
+				return nil, errors.New("permission denied")
+			}
+			authorized++
+			p := &testSocksProxy{ln: ln, limit: limit}
+			go func() {
+				<-ctx.Done()
+				ln.Close()
+			}()
+			return p, nil
+		},
+		entries: make(map[string]*socksSessionEntry),
+	}
+
+	echo := func(t *testing.T, target *targets.Target) {
+		t.Helper()
+		c, srv := net.Pipe()
+		defer c.Close()
+		var replyErr error
+		replied := false
+		require.NoError(t, s.forward(target, srv, func(err error) error {
+			replied = true
+			replyErr = err
+			return nil
+		}))
+		assert.True(t, replied)
+		assert.NoError(t, replyErr)
+		go c.Write([]byte("ping"))
+		buf := make([]byte, 4)
+		_, err := io.ReadFull(c, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "ping", string(buf))
+	}
+
+	web := &targets.Target{Id: "ttcp_web"}
+	echo(t, web)
+	echo(t, web)
+	assert.Equal(t, 1, authorized, "the session is reused")
+
+	// A new session is authorized once the previous one runs out of
+	// connections.
+	limit = 1
+	echo(t, &targets.Target{Id: "ttcp_db"})
+	assert.Equal(t, 2, authorized)
+	s.entries["ttcp_db"].ln.Close()
+	echo(t, &targets.Target{Id: "ttcp_db"})
+	assert.Equal(t, 3, authorized)
+
+	var replyErr error
+	_, srv := net.Pipe()
+	err := s.forward(&targets.Target{Id: "ttcp_denied"}, srv, func(err error) error {
+		replyErr = err
+		return nil
+	})
+	assert.ErrorContains(t, err, "permission denied")
+	assert.ErrorContains(t, replyErr, "permission denied")
+
+	cancel()
+	s.wait()
+}
+
+func TestConnListener(t *testing.T) {
+	l := newConnListener()
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		conn, _ := l.Accept()
+		accepted <- conn
+	}()
+	assert.True(t, l.push(c1))
+	assert.Equal(t, c1, <-accepted)
+
+	require.NoError(t, l.Close())
+	assert.True(t, l.isClosed())
+	assert.False(t, l.push(c2))
+	_, err := l.Accept()
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
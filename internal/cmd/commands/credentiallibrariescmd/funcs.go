@@ -206,6 +206,7 @@ var genericKeySubstMap = map[string]string{
 	"path":              "Path",
 	"http_method":       "HTTP Method",
 	"http_request_body": "HTTP Request Body",
+	"kv_version":        "KV Version",
 }
 
 var sshCertKeySubstMap = map[string]string{
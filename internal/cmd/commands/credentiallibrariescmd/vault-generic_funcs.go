@@ -19,6 +19,7 @@ const (
 	pathFlagName              = "vault-path"
 	httpMethodFlagName        = "vault-http-method"
 	httpRequestBodyFlagName   = "vault-http-request-body"
+	kvVersionFlagName         = "vault-kv-version"
 	credentialTypeFlagName    = "credential-type"
 	credentialMappingFlagName = "credential-mapping-override"
 )
@@ -27,6 +28,7 @@ type extraVaultGenericCmdVars struct {
 	flagPath              string
 	flagHttpMethod        string
 	flagHttpRequestBody   string
+	flagKvVersion         string
 	flagCredentialType    string
 	flagCredentialMapping []base.CombinedSliceFlagValue
 }
@@ -37,6 +39,7 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			kvVersionFlagName,
 			credentialTypeFlagName,
 			credentialMappingFlagName,
 		},
@@ -44,6 +47,7 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			kvVersionFlagName,
 			credentialMappingFlagName,
 		},
 	}
@@ -73,6 +77,12 @@ func extraVaultGenericFlagsFuncImpl(c *VaultGenericCommand, set *base.FlagSets,
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case kvVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvVersionFlagName,
+				Target: &c.flagKvVersion,
+				Usage:  `If set, the vault path is a KV version 2 secret and the library reads the given version of the secret, or the current version if set to "latest". The secret's data is returned without the KV version 2 response envelope.`,
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -111,6 +121,13 @@ func extraVaultGenericFlagHandlingFuncImpl(c *VaultGenericCommand, _ *base.FlagS
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagKvVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvVersion())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvVersion(c.flagKvVersion))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -44,6 +44,12 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case kvVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvVersionFlagName,
+				Target: &c.flagKvVersion,
+				Usage:  `If set, the vault path is a KV version 2 secret and the library reads the given version of the secret, or the current version if set to "latest". The secret's data is returned without the KV version 2 response envelope.`,
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -82,6 +88,13 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, _ *base.FlagSets, opts *[]c
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagKvVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvVersion())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvVersion(c.flagKvVersion))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -4,6 +4,7 @@
 package credentialscmd
 
 import (
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"os"
@@ -137,6 +138,10 @@ func extraSshPrivateKeyFlagHandlingFuncImpl(c *SshPrivateKeyCommand, _ *base.Fla
 	}
 
 	if _, err = ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(privateKeyPassphrase)); err != nil {
+		if errors.Is(err, x509.IncorrectPasswordError) {
+			c.UI.Error("Incorrect private key passphrase")
+			return false
+		}
 		c.UI.Error(fmt.Sprintf("Error parsing private key passphrase: %v", err))
 		return false
 	}
@@ -212,4 +212,10 @@ var keySubstMap = map[string]string{
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"auth_method":                 "Auth Method",
+	"auth_mount_path":             "Auth Mount Path",
+	"approle_role_id":             "AppRole Role ID",
+	"approle_secret_id_hmac":      "AppRole Secret ID HMAC",
+	"kubernetes_role":             "Kubernetes Role",
+	"kubernetes_jwt_hmac":         "Kubernetes JWT HMAC",
 }
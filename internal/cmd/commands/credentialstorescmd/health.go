@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialstorescmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*HealthCommand)(nil)
+	_ cli.CommandAutocomplete = (*HealthCommand)(nil)
+)
+
+type HealthCommand struct {
+	*base.Command
+}
+
+func (c *HealthCommand) Synopsis() string {
+	return wordwrap.WrapString("Show the health of a Vault credential store", base.TermWidth)
+}
+
+func (c *HealthCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credential-stores health [args]",
+		"",
+		"  Show the results of the most recent health check of a Vault credential store. Health checks",
+		"  are run periodically by the controller and verify that Vault is reachable, when the store's",
+		"  token will expire, and that the token has the capabilities Boundary requires. Example:",
+		"",
+		`    $ boundary credential-stores health -id csvlt_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *HealthCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the Vault credential store to show the health of",
+	})
+
+	return set
+}
+
+func (c *HealthCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *HealthCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *HealthCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	csClient := credentialstores.NewClient(client)
+	result, err := csClient.Read(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when reading credential store")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to read credential store: %w", err))
+		return base.CommandCliError
+	}
+	item := result.GetItem()
+	if item.Type != "vault" {
+		c.PrintCliError(fmt.Errorf("Health is only available for vault-type credential stores, %s is of type %s", item.Id, item.Type))
+		return base.CommandUserError
+	}
+	health, err := healthFromAttributes(item.Attributes)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading credential store health: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(health)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error formatting as JSON: %w", err))
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+
+	default:
+		c.UI.Output(printHealthTable(item.Id, health))
+	}
+
+	return base.CommandSuccess
+}
+
+// healthFromAttributes returns the health status contained in the attributes
+// of a vault-type credential store. It returns nil if the store has not been
+// checked yet.
+func healthFromAttributes(attrs map[string]any) (*credentialstores.VaultCredentialStoreHealth, error) {
+	raw, ok := attrs["health"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var h credentialstores.VaultCredentialStoreHealth
+	if err := json.Unmarshal(b, &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func printHealthTable(id string, h *credentialstores.VaultCredentialStoreHealth) string {
+	if h == nil {
+		return fmt.Sprintf("Credential store %s has not been checked yet", id)
+	}
+
+	nonAttributeMap := map[string]any{
+		"ID":     id,
+		"Status": h.Status,
+	}
+	if h.VaultReachable != nil {
+		nonAttributeMap["Vault Reachable"] = *h.VaultReachable
+	}
+	if !h.TokenExpirationTime.IsZero() {
+		nonAttributeMap["Token Expiration Time"] = h.TokenExpirationTime.Local().Format(time.RFC1123)
+	}
+	if !h.LastCheckTime.IsZero() {
+		nonAttributeMap["Last Check Time"] = h.LastCheckTime.Local().Format(time.RFC1123)
+	}
+	if h.LastCheckError != "" {
+		nonAttributeMap["Last Check Error"] = h.LastCheckError
+	}
+	if h.LastRenewalError != "" {
+		nonAttributeMap["Last Renewal Error"] = h.LastRenewalError
+	}
+	if !h.LastRenewalErrorTime.IsZero() {
+		nonAttributeMap["Last Renewal Error Time"] = h.LastRenewalErrorTime.Local().Format(time.RFC1123)
+	}
+
+	ret := []string{
+		"",
+		"Credential Store health:",
+		base.WrapMap(2, 0, nonAttributeMap),
+	}
+
+	if h.MissingCapabilities != "" {
+		ret = append(ret,
+			"",
+			"  Missing Capabilities:",
+		)
+		for _, l := range strings.Split(h.MissingCapabilities, "\n") {
+			ret = append(ret, "    "+strings.ReplaceAll(l, "\t", "  "))
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
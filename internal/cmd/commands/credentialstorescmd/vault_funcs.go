@@ -4,6 +4,7 @@
 package credentialstorescmd
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/boundary/api/credentialstores"
@@ -28,6 +29,11 @@ const (
 	clientCertificateFlagName    = "vault-client-certificate"
 	clientCertificateKeyFlagName = "vault-client-certificate-key"
 	workerFilterFlagName         = "worker-filter"
+	authMountPathFlagName        = "vault-auth-mount-path"
+	approleRoleIdFlagName        = "vault-approle-role-id"
+	approleSecretIdFlagName      = "vault-approle-secret-id"
+	kubernetesRoleFlagName       = "vault-kubernetes-role"
+	kubernetesJwtFlagName        = "vault-kubernetes-jwt"
 )
 
 type extraVaultCmdVars struct {
@@ -40,6 +46,11 @@ type extraVaultCmdVars struct {
 	flagTlsServerName string
 	flagTlsSkipVerify bool
 	flagWorkerFilter  string
+	flagAuthMountPath string
+	flagApproleRoleId string
+	flagApproleSecret string
+	flagK8sRole       string
+	flagK8sJwt        string
 }
 
 func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
@@ -54,6 +65,11 @@ func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
 			clientCertificateFlagName,
 			clientCertificateKeyFlagName,
 			workerFilterFlagName,
+			authMountPathFlagName,
+			approleRoleIdFlagName,
+			approleSecretIdFlagName,
+			kubernetesRoleFlagName,
+			kubernetesJwtFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -119,6 +135,36 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagWorkerFilter,
 				Usage:  `A boolean expression to filter which workers can handle Vault commands for this credential store.`,
 			})
+		case authMountPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authMountPathFlagName,
+				Target: &c.flagAuthMountPath,
+				Usage:  "The path the approle or kubernetes auth method is mounted at in vault. Defaults to the name of the auth method.",
+			})
+		case approleRoleIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   approleRoleIdFlagName,
+				Target: &c.flagApproleRoleId,
+				Usage:  "The role ID boundary uses to log in to vault with the approle auth method.",
+			})
+		case approleSecretIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   approleSecretIdFlagName,
+				Target: &c.flagApproleSecret,
+				Usage:  "The secret ID boundary uses to log in to vault with the approle auth method. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case kubernetesRoleFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubernetesRoleFlagName,
+				Target: &c.flagK8sRole,
+				Usage:  "The role boundary uses to log in to vault with the kubernetes auth method.",
+			})
+		case kubernetesJwtFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubernetesJwtFlagName,
+				Target: &c.flagK8sJwt,
+				Usage:  "The kubernetes service account token boundary uses to log in to vault with the kubernetes auth method. Required when using the kubernetes auth method. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
 		}
 	}
 }
@@ -176,6 +222,43 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, f *base.FlagSets, opts *[]c
 		}
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreWorkerFilter(c.flagWorkerFilter))
 	}
+	switch c.flagAuthMountPath {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthMountPath(c.flagAuthMountPath))
+	}
+	switch c.flagApproleRoleId {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreApproleRoleId(c.flagApproleRoleId))
+	}
+	switch c.flagApproleSecret {
+	case "":
+	default:
+		secret, err := parseutil.ParsePath(c.flagApproleSecret)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing approle secret ID: %s", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreApproleSecretId(secret))
+	}
+	switch c.flagK8sRole {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreKubernetesRole(c.flagK8sRole))
+	}
+	switch c.flagK8sJwt {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreKubernetesJwt())
+	default:
+		jwt, err := parseutil.ParsePath(c.flagK8sJwt)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing kubernetes JWT: %s", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreKubernetesJwt(jwt))
+	}
 	if c.flagTlsSkipVerify {
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreTlsSkipVerify(c.flagTlsSkipVerify))
 	}
@@ -194,6 +277,10 @@ func (c *VaultCommand) extraVaultHelpFunc(helpMap map[string]func() string) stri
 			"",
 			`    $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-token "s.s0m3t0k3n"`,
 			"",
+			"  Create a vault-type credential store which logs in to vault with the approle auth method. Example:",
+			"",
+			`    $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-approle-role-id "r0l3-1d" -vault-approle-secret-id "env://VAULT_SECRET_ID"`,
+			"",
 			"",
 		})
 
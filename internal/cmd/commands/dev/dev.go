@@ -60,6 +60,7 @@ type Command struct {
 	SighupCh      chan struct{}
 	childSighupCh []chan struct{}
 	ReloadedCh    chan struct{}
+	SigUSR1Ch     chan struct{}
 	SigUSR2Ch     chan struct{}
 
 	Config     *config.Config
@@ -1071,6 +1072,14 @@ func (c *Command) Run(args []string) int {
 		case <-c.SighupCh:
 			c.UI.Output("==> Boundary dev environment does not support configuration reloading, taking no action")
 
+		case <-c.SigUSR1Ch:
+			if c.worker == nil {
+				c.UI.Output("==> Boundary dev environment is not running a worker, ignoring drain request")
+				break
+			}
+			c.UI.Output("==> Boundary worker drain triggered")
+			c.worker.Drain()
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
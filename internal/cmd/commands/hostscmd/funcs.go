@@ -25,6 +25,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary hosts read -id hst_1234567890`,
 			"",
+			"    Import static hosts and host sets from an inventory file:",
+			"",
+			`      $ boundary hosts import -host-catalog-id hcst_1234567890 -file inventory.yaml`,
+			"",
 			"  Please see the hosts subcommand help for detailed usage information.",
 		})
 	case "create":
@@ -178,6 +182,29 @@ func printItemTable(item *hosts.Host, resp *api.Response) string {
 		)
 	}
 
+	if item.Health != nil {
+		healthMap := map[string]any{
+			"Status": item.Health.Status,
+		}
+		if item.Health.Port != 0 {
+			healthMap["Port"] = item.Health.Port
+		}
+		if !item.Health.LastCheckTime.IsZero() {
+			healthMap["Last Check Time"] = item.Health.LastCheckTime.Local().Format(time.RFC1123)
+		}
+		if item.Health.LastCheckError != "" {
+			healthMap["Last Check Error"] = item.Health.LastCheckError
+		}
+		if item.Health.LastCheckWorkerId != "" {
+			healthMap["Last Check Worker ID"] = item.Health.LastCheckWorkerId
+		}
+		ret = append(ret,
+			"",
+			"  Health:",
+			base.WrapMap(4, maxLength, healthMap),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostscmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ImportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ImportCommand)(nil)
+)
+
+type ImportCommand struct {
+	*base.Command
+
+	flagHostCatalogId string
+	flagFile          string
+	flagFormat        string
+}
+
+func (c *ImportCommand) Synopsis() string {
+	return wordwrap.WrapString("Import static hosts and host sets from an inventory file", base.TermWidth)
+}
+
+func (c *ImportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary hosts import [options] [args]",
+		"",
+		"  Make the hosts and host sets of a static host catalog match an inventory file. Hosts",
+		"  and host sets are matched by name. Hosts and host sets in the catalog which are not in",
+		"  the file are deleted. All changes are made in a single transaction. Example:",
+		"",
+		`    $ boundary hosts import -host-catalog-id hcst_1234567890 -file inventory.yaml`,
+		"",
+		"  The format of the file is chosen by its extension unless -format is set:",
+		"",
+		"    yaml: A list of hosts with a name, address, description, and the names of",
+		"          their host_sets, and a list of host_sets with a name, description, and",
+		"          the names of their hosts.",
+		"",
+		"    csv: A header row followed by one row per host. The name and address columns",
+		"         are required. The description column is optional. The optional host_sets",
+		"         column contains the names of the host's host sets separated by semicolons.",
+		"",
+		"    ansible: An Ansible INI inventory. Each group is imported as a host set. The",
+		"             ansible_host variable is used as a host's address if it is set.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ImportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "host-catalog-id",
+		Target: &c.flagHostCatalogId,
+		Usage:  "The id of the static host catalog to import the hosts into.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "file",
+		Target: &c.flagFile,
+		Usage:  "The path of the inventory file to import.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "format",
+		Target:     &c.flagFormat,
+		Usage:      `The format of the inventory file, one of "yaml", "csv", or "ansible". Defaults to the format matching the extension of the file, or "ansible" if the extension is unknown.`,
+		Completion: complete.PredictSet(inventoryFormatYaml, inventoryFormatCsv, inventoryFormatAnsible),
+	})
+
+	return set
+}
+
+func (c *ImportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ImportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ImportCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.flagHostCatalogId == "":
+		c.PrintCliError(errors.New("Host catalog ID must be provided via -host-catalog-id"))
+		return base.CommandUserError
+	case c.flagFile == "":
+		c.PrintCliError(errors.New("Inventory file must be provided via -file"))
+		return base.CommandUserError
+	}
+	format := c.flagFormat
+	if format == "" {
+		format = inventoryFormat(c.flagFile)
+	}
+
+	file, err := os.Open(c.flagFile)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error opening inventory file: %w", err))
+		return base.CommandUserError
+	}
+	defer file.Close()
+	inv, err := parseInventory(file, format)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading inventory file: %w", err))
+		return base.CommandUserError
+	}
+	hosts, hostSets, err := inv.toImport()
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error in inventory file: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	hcClient := hostcatalogs.NewClient(client)
+	result, err := hcClient.ImportHosts(c.Context, c.flagHostCatalogId, hosts, hostSets)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing import on hosts")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to import hosts: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printImportTable(c.flagHostCatalogId, result))
+	}
+
+	return base.CommandSuccess
+}
+
+func printImportTable(hostCatalogId string, result *hostcatalogs.ImportHostsResult) string {
+	nonAttributeMap := map[string]any{
+		"Host Catalog ID":   hostCatalogId,
+		"Hosts Created":     result.HostsCreated,
+		"Hosts Updated":     result.HostsUpdated,
+		"Hosts Deleted":     result.HostsDeleted,
+		"Host Sets Created": result.HostSetsCreated,
+		"Host Sets Updated": result.HostSetsUpdated,
+		"Host Sets Deleted": result.HostSetsDeleted,
+	}
+
+	ret := []string{
+		"",
+		"Host import information:",
+		base.WrapMap(2, 0, nonAttributeMap),
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostscmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported inventory file formats.
+const (
+	inventoryFormatCsv     = "csv"
+	inventoryFormatYaml    = "yaml"
+	inventoryFormatAnsible = "ansible"
+)
+
+// inventory contains the hosts and host sets read from an inventory file.
+type inventory struct {
+	Hosts    []*inventoryHost    `yaml:"hosts"`
+	HostSets []*inventoryHostSet `yaml:"host_sets"`
+}
+
+type inventoryHost struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Address     string   `yaml:"address"`
+	HostSets    []string `yaml:"host_sets"`
+}
+
+type inventoryHostSet struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Hosts       []string `yaml:"hosts"`
+}
+
+// inventoryFormat returns the format of the inventory file at path based on
+// its extension. Files without a known extension are read as Ansible INI
+// inventories.
+func inventoryFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return inventoryFormatCsv
+	case ".yaml", ".yml":
+		return inventoryFormatYaml
+	default:
+		return inventoryFormatAnsible
+	}
+}
+
+// parseInventory reads an inventory in format from r.
+func parseInventory(r io.Reader, format string) (*inventory, error) {
+	switch format {
+	case inventoryFormatCsv:
+		return parseCsvInventory(r)
+	case inventoryFormatYaml:
+		return parseYamlInventory(r)
+	case inventoryFormatAnsible:
+		return parseAnsibleInventory(r)
+	default:
+		return nil, fmt.Errorf("unknown inventory format %q", format)
+	}
+}
+
+// parseYamlInventory reads a YAML inventory with a list of hosts and a list
+// of host sets:
+//
+//	hosts:
+//	  - name: web-1
+//	    address: 10.0.0.1
+//	    host_sets: [web]
+//	host_sets:
+//	  - name: web
+//	    description: Web servers
+//	    hosts: [web-1]
+func parseYamlInventory(r io.Reader) (*inventory, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	inv := &inventory{}
+	if err := dec.Decode(inv); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing yaml inventory: %w", err)
+	}
+	return inv, nil
+}
+
+// parseCsvInventory reads a CSV inventory. The first row is a header which
+// must contain a name and an address column and may contain a description
+// and a host_sets column. The host_sets column contains the names of the
+// host sets the host is a member of, separated by semicolons.
+func parseCsvInventory(r io.Reader) (*inventory, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return &inventory{}, nil
+		}
+		return nil, fmt.Errorf("error reading csv header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, required := range []string{"name", "address"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv header is missing the %q column", required)
+		}
+	}
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	inv := &inventory{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading csv record: %w", err)
+		}
+		h := &inventoryHost{
+			Name:        field(record, "name"),
+			Address:     field(record, "address"),
+			Description: field(record, "description"),
+		}
+		for _, s := range strings.Split(field(record, "host_sets"), ";") {
+			if s = strings.TrimSpace(s); s != "" {
+				h.HostSets = append(h.HostSets, s)
+			}
+		}
+		inv.Hosts = append(inv.Hosts, h)
+	}
+	return inv, nil
+}
+
+// parseAnsibleInventory reads an Ansible INI inventory. Each group is
+// imported as a host set containing the hosts of the group and of its
+// children. The ansible_host variable of a host is used as its address if
+// it is set, otherwise the name of the host is used. Group variables are
+// ignored.
+func parseAnsibleInventory(r io.Reader) (*inventory, error) {
+	type group struct {
+		hosts    []string
+		children []string
+	}
+	var groupNames []string
+	groups := make(map[string]*group)
+	getGroup := func(name string) *group {
+		g, ok := groups[name]
+		if !ok {
+			g = &group{}
+			groups[name] = g
+			groupNames = append(groupNames, name)
+		}
+		return g
+	}
+
+	inv := &inventory{}
+	hosts := make(map[string]*inventoryHost)
+	var current *group
+	var section string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid group header %q", n, line)
+			}
+			name, kind, _ := strings.Cut(strings.TrimSpace(line[1:len(line)-1]), ":")
+			section = kind
+			switch kind {
+			case "", "children":
+				current = getGroup(name)
+			case "vars":
+				current = nil
+			default:
+				return nil, fmt.Errorf("line %d: unknown group section %q", n, kind)
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		switch {
+		case section == "vars":
+			continue
+		case section == "children":
+			current.children = append(current.children, fields[0])
+			continue
+		}
+
+		name := fields[0]
+		h, ok := hosts[name]
+		if !ok {
+			h = &inventoryHost{Name: name, Address: name}
+			hosts[name] = h
+			inv.Hosts = append(inv.Hosts, h)
+		}
+		for _, v := range fields[1:] {
+			if k, val, ok := strings.Cut(v, "="); ok && k == "ansible_host" {
+				h.Address = strings.Trim(val, `"'`)
+			}
+		}
+		if current != nil && !slices.Contains(current.hosts, name) {
+			current.hosts = append(current.hosts, name)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading ansible inventory: %w", err)
+	}
+
+	// members returns the hosts of the group name and of all of its
+	// descendants.
+	var members func(name string, seen map[string]bool) []string
+	members = func(name string, seen map[string]bool) []string {
+		if seen[name] {
+			return nil
+		}
+		seen[name] = true
+		g, ok := groups[name]
+		if !ok {
+			return nil
+		}
+		ret := slices.Clone(g.hosts)
+		for _, c := range g.children {
+			for _, h := range members(c, seen) {
+				if !slices.Contains(ret, h) {
+					ret = append(ret, h)
+				}
+			}
+		}
+		return ret
+	}
+	for _, name := range groupNames {
+		// The implicit groups contain every host and are not imported.
+		if name == "all" || name == "ungrouped" {
+			continue
+		}
+		inv.HostSets = append(inv.HostSets, &inventoryHostSet{
+			Name:  name,
+			Hosts: members(name, map[string]bool{}),
+		})
+	}
+	return inv, nil
+}
+
+// toImport returns the hosts and host sets of inv for an ImportHosts
+// request. Host sets which are only referenced by the host_sets of a host
+// are added to the returned host sets.
+func (inv *inventory) toImport() ([]hostcatalogs.ImportHost, []hostcatalogs.ImportHostSet, error) {
+	hosts := make([]hostcatalogs.ImportHost, 0, len(inv.Hosts))
+	hostNames := make(map[string]bool, len(inv.Hosts))
+	for _, h := range inv.Hosts {
+		if h.Name == "" {
+			return nil, nil, errors.New("each host must have a name")
+		}
+		if hostNames[h.Name] {
+			return nil, nil, fmt.Errorf("duplicate host name %q", h.Name)
+		}
+		hostNames[h.Name] = true
+		hosts = append(hosts, hostcatalogs.ImportHost{
+			Name:        h.Name,
+			Description: h.Description,
+			Address:     h.Address,
+		})
+	}
+
+	sets := make([]hostcatalogs.ImportHostSet, 0, len(inv.HostSets))
+	setIdx := make(map[string]int, len(inv.HostSets))
+	for _, s := range inv.HostSets {
+		if s.Name == "" {
+			return nil, nil, errors.New("each host set must have a name")
+		}
+		if _, ok := setIdx[s.Name]; ok {
+			return nil, nil, fmt.Errorf("duplicate host set name %q", s.Name)
+		}
+		setIdx[s.Name] = len(sets)
+		sets = append(sets, hostcatalogs.ImportHostSet{
+			Name:        s.Name,
+			Description: s.Description,
+			HostNames:   slices.Clone(s.Hosts),
+		})
+	}
+	for _, h := range inv.Hosts {
+		for _, s := range h.HostSets {
+			i, ok := setIdx[s]
+			if !ok {
+				i = len(sets)
+				setIdx[s] = i
+				sets = append(sets, hostcatalogs.ImportHostSet{Name: s})
+			}
+			if !slices.Contains(sets[i].HostNames, h.Name) {
+				sets[i].HostNames = append(sets[i].HostNames, h.Name)
+			}
+		}
+	}
+	for _, s := range sets {
+		for _, h := range s.HostNames {
+			if !hostNames[h] {
+				return nil, nil, fmt.Errorf("host set %q contains unknown host %q", s.Name, h)
+			}
+		}
+	}
+	return hosts, sets, nil
+}
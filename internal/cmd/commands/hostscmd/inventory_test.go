@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostscmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryFormat(t *testing.T) {
+	assert.Equal(t, inventoryFormatCsv, inventoryFormat("hosts.CSV"))
+	assert.Equal(t, inventoryFormatYaml, inventoryFormat("/tmp/inventory.yml"))
+	assert.Equal(t, inventoryFormatYaml, inventoryFormat("inventory.yaml"))
+	assert.Equal(t, inventoryFormatAnsible, inventoryFormat("hosts"))
+	assert.Equal(t, inventoryFormatAnsible, inventoryFormat("hosts.ini"))
+}
+
+func TestParseInventory(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       string
+		in           string
+		wantHosts    []hostcatalogs.ImportHost
+		wantHostSets []hostcatalogs.ImportHostSet
+		wantErr      string
+	}{
+		{
+			name:   "yaml",
+			format: inventoryFormatYaml,
+			in: `
+hosts:
+  - name: web-1
+    address: 10.0.0.1
+    description: first web server
+    host_sets: [web, all-servers]
+  - name: db-1
+    address: db-1.example.com
+host_sets:
+  - name: web
+    description: web servers
+  - name: databases
+    hosts: [db-1]
+`,
+			wantHosts: []hostcatalogs.ImportHost{
+				{Name: "web-1", Address: "10.0.0.1", Description: "first web server"},
+				{Name: "db-1", Address: "db-1.example.com"},
+			},
+			wantHostSets: []hostcatalogs.ImportHostSet{
+				{Name: "web", Description: "web servers", HostNames: []string{"web-1"}},
+				{Name: "databases", HostNames: []string{"db-1"}},
+				{Name: "all-servers", HostNames: []string{"web-1"}},
+			},
+		},
+		{
+			name:    "yaml-unknown-field",
+			format:  inventoryFormatYaml,
+			in:      "hosts:\n  - name: web-1\n    ip: 10.0.0.1\n",
+			wantErr: "field ip not found",
+		},
+		{
+			name:   "csv",
+			format: inventoryFormatCsv,
+			in: `# exported from the cmdb
+Name,Address,Description,Host_Sets
+web-1,10.0.0.1,"first, web server",web;all-servers
+db-1, db-1.example.com,,
+`,
+			wantHosts: []hostcatalogs.ImportHost{
+				{Name: "web-1", Address: "10.0.0.1", Description: "first, web server"},
+				{Name: "db-1", Address: "db-1.example.com"},
+			},
+			wantHostSets: []hostcatalogs.ImportHostSet{
+				{Name: "web", HostNames: []string{"web-1"}},
+				{Name: "all-servers", HostNames: []string{"web-1"}},
+			},
+		},
+		{
+			name:    "csv-missing-address",
+			format:  inventoryFormatCsv,
+			in:      "name,description\nweb-1,web\n",
+			wantErr: `missing the "address" column`,
+		},
+		{
+			name:   "ansible",
+			format: inventoryFormatAnsible,
+			in: `
+bastion.example.com
+
+[web]
+web-1 ansible_host=10.0.0.1 ansible_user=admin
+web-2 ansible_host="10.0.0.2"
+
+[db]
+db-1
+
+[prod:children]
+web
+db
+
+[prod:vars]
+ansible_user=root
+
+[all]
+web-1
+`,
+			wantHosts: []hostcatalogs.ImportHost{
+				{Name: "bastion.example.com", Address: "bastion.example.com"},
+				{Name: "web-1", Address: "10.0.0.1"},
+				{Name: "web-2", Address: "10.0.0.2"},
+				{Name: "db-1", Address: "db-1"},
+			},
+			wantHostSets: []hostcatalogs.ImportHostSet{
+				{Name: "web", HostNames: []string{"web-1", "web-2"}},
+				{Name: "db", HostNames: []string{"db-1"}},
+				{Name: "prod", HostNames: []string{"web-1", "web-2", "db-1"}},
+			},
+		},
+		{
+			name:    "unknown-host-in-set",
+			format:  inventoryFormatYaml,
+			in:      "hosts:\n  - name: web-1\n    address: 10.0.0.1\nhost_sets:\n  - name: web\n    hosts: [web-2]\n",
+			wantErr: `host set "web" contains unknown host "web-2"`,
+		},
+		{
+			name:    "duplicate-host",
+			format:  inventoryFormatCsv,
+			in:      "name,address\nweb-1,10.0.0.1\nweb-1,10.0.0.2\n",
+			wantErr: `duplicate host name "web-1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			inv, err := parseInventory(strings.NewReader(tt.in), tt.format)
+			var hosts []hostcatalogs.ImportHost
+			var hostSets []hostcatalogs.ImportHostSet
+			if err == nil {
+				hosts, hostSets, err = inv.toImport()
+			}
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantHosts, hosts)
+			assert.Equal(tt.wantHostSets, hostSets)
+		})
+	}
+}
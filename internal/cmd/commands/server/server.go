@@ -47,6 +47,7 @@ type Command struct {
 	opsServer *ops.Server
 
 	SighupCh  chan struct{}
+	SigUSR1Ch chan struct{}
 	SigUSR2Ch chan struct{}
 
 	Config *config.Config
@@ -205,6 +206,10 @@ func (c *Command) Run(args []string) int {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
+	if err := c.SetupTracing(c.Context, c.Config.Tracing, "boundary-"+strings.Join(serverTypes, "-")); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
 	c.WorkerAuthDebuggingEnabled.Store(c.Config.EnableWorkerAuthDebugging)
 
 	base.StartMemProfiler(c.Context)
@@ -810,6 +815,14 @@ func (c *Command) WaitForInterrupt() int {
 				c.UI.Error(fmt.Errorf("Error(s) were encountered during reload: %w", err).Error())
 			}
 
+		case <-c.SigUSR1Ch:
+			if c.worker == nil {
+				c.UI.Output("==> Boundary server is not running a worker, ignoring drain request")
+				break
+			}
+			c.UI.Output("==> Boundary worker drain triggered")
+			c.worker.Drain()
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
@@ -17,24 +17,40 @@ import (
 
 const (
 	flagIncludeTerminated = "include-terminated"
+	flagExtension         = "extension"
+	flagConnectionId      = "connection-id"
 )
 
 func init() {
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
 }
 
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "cancel-connection":
+		return "Cancel a single connection of a session"
+	default:
+		return ""
+	}
+}
+
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":            {"id"},
+		"extend":            {"id", flagExtension},
+		"cancel-connection": {"id", flagConnectionId},
+		"list":              {flagIncludeTerminated},
 	}
 }
 
 type extraCmdVars struct {
 	flagIncludeTerminated bool
+	flagExtension         string
+	flagConnectionId      string
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -46,6 +62,18 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIncludeTerminated,
 				Usage:  "If set, terminated sessions will be included in the results.",
 			})
+		case flagExtension:
+			f.StringVar(&base.StringVar{
+				Name:   flagExtension,
+				Target: &c.flagExtension,
+				Usage:  "The time from now at which the session should expire. Can be specified as an integer number of seconds or a duration string. If not set, or longer than the original lifetime of the session, the original lifetime of the session is used.",
+			})
+		case flagConnectionId:
+			f.StringVar(&base.StringVar{
+				Name:   flagConnectionId,
+				Target: &c.flagConnectionId,
+				Usage:  "The ID of the session connection to cancel.",
+			})
 		}
 	}
 }
@@ -54,9 +82,35 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]sessions.O
 	if c.flagIncludeTerminated {
 		*opts = append(*opts, sessions.WithIncludeTerminated(c.flagIncludeTerminated))
 	}
+	if c.Func == "cancel-connection" && c.flagConnectionId == "" {
+		c.UI.Error("Connection ID must be passed in via -connection-id")
+		return false
+	}
+	if c.flagExtension != "" {
+		if _, err := parseExtension(c.flagExtension); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagExtension, err))
+			return false
+		}
+	}
 	return true
 }
 
+// parseExtension parses an extension given as an integer number of seconds or
+// a duration string into a number of seconds.
+func parseExtension(in string) (uint32, error) {
+	if secs, err := strconv.ParseUint(in, 10, 32); err == nil {
+		return uint32(secs), nil
+	}
+	dur, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, err
+	}
+	if dur < 0 {
+		return 0, fmt.Errorf("extension must not be negative")
+	}
+	return uint32(dur.Seconds()), nil
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -84,6 +138,28 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "extend":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions extend [options] [args]",
+			"",
+			"  Extend the expiration of the session specified by ID. A single extension is limited to the original lifetime of the session and the total lifetime of the session is limited by the controller configuration. Clients must use the reissued session certificate for new connections once the original one expires. Example:",
+			"",
+			`    $ boundary sessions extend -id s_1234567890 -extension 2h`,
+			"",
+			"",
+		})
+
+	case "cancel-connection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions cancel-connection [options] [args]",
+			"",
+			"  Cancel a single connection of the session specified by ID, leaving the session and its other connections open. Connection IDs are shown when reading the session. Example:",
+			"",
+			`    $ boundary sessions cancel-connection -id s_1234567890 -connection-id sc_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -99,6 +175,25 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "extend":
+		var extension uint32
+		if c.flagExtension != "" {
+			var err error
+			if extension, err = parseExtension(c.flagExtension); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		result, err := sessionClient.Extend(c.Context, c.FlagId, version, extension, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	case "cancel-connection":
+		result, err := sessionClient.CancelConnection(c.Context, c.FlagId, c.flagConnectionId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -215,6 +310,9 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	if len(strings.TrimSpace(item.TerminationReason)) > 0 {
 		nonAttributeMap["Termination Reason"] = item.TerminationReason
 	}
+	if item.KvSecretVersionChanged {
+		nonAttributeMap["KV Secret Version Changed"] = item.KvSecretVersionChanged
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -238,11 +336,15 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	var connectionsMaps []map[string]any
 	for _, sc := range item.Connections {
 		cm := map[string]any{
+			"ID":               sc.Id,
 			"Client Address":   net.JoinHostPort(sc.ClientTcpAddress, strconv.FormatUint(uint64(sc.ClientTcpPort), 10)),
 			"Endpoint Address": net.JoinHostPort(sc.EndpointTcpAddress, strconv.FormatUint(uint64(sc.EndpointTcpPort), 10)),
 			"Bytes Up":         sc.BytesUp,
 			"Bytes Down":       sc.BytesDown,
 		}
+		if !sc.CreatedTime.IsZero() {
+			cm["Created Time"] = sc.CreatedTime.Local().Format(time.RFC1123)
+		}
 		if len(sc.ClosedReason) != 0 {
 			cm["Closed Reason"] = sc.ClosedReason
 		}
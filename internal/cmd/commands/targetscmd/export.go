@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/posener/complete"
+)
+
+// exportTarget is a target written to a generated client configuration along
+// with the name the configuration refers to it by.
+type exportTarget struct {
+	name   string
+	target *targets.Target
+}
+
+// exportFlags are the flags shared by the commands which export targets to a
+// client configuration.
+type exportFlags struct {
+	flagPrefix string
+}
+
+func exportOptions(c *base.Command, e *exportFlags, set *base.FlagSets, prefixName, prefixUsage string) {
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "scope-id",
+		Target:     &c.FlagScopeId,
+		Default:    scope.Global.String(),
+		EnvVar:     "BOUNDARY_SCOPE_ID",
+		Completion: complete.PredictAnything,
+		Usage:      `The scope in which to look for targets. Targets in its child scopes are included.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "filter",
+		Target: &c.FlagFilter,
+		Usage:  "If set, the targets matching this boolean expression are exported instead of the default selection. The expression is evaluated against the targets as returned when listing them.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:    "output",
+		Target:  &c.FlagOutputFile,
+		Usage:   `The file to write the configuration to. Use "-" or leave unset for stdout. The file is replaced if it exists.`,
+		Aliases: []string{"o"},
+	})
+	f.StringVar(&base.StringVar{
+		Name:   prefixName,
+		Target: &e.flagPrefix,
+		Usage:  prefixUsage,
+	})
+}
+
+// listExportTargets lists the targets the user can authorize sessions against
+// in the scope and its child scopes. Unless a filter is given only the targets
+// for which include returns true are returned.
+func listExportTargets(ctx context.Context, client *targets.Client, scopeId, filter, prefix string, include func(*targets.Target) bool) ([]exportTarget, error) {
+	opts := []targets.Option{targets.WithRecursive(true)}
+	if filter != "" {
+		opts = append(opts, targets.WithFilter(filter))
+		include = nil
+	}
+	result, err := client.List(ctx, scopeId, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return selectExportTargets(result.GetItems(), prefix, include), nil
+}
+
+// selectExportTargets returns the targets the user can authorize sessions
+// against for which include, if set, returns true. Each target is named after
+// the target's name, qualified by its scope's name if more than one target has
+// the same name, and its ID if that isn't enough to tell them apart.
+func selectExportTargets(items []*targets.Target, prefix string, include func(*targets.Target) bool) []exportTarget {
+	var ts []*targets.Target
+	for _, t := range items {
+		if !slices.Contains(t.AuthorizedActions, "authorize-session") {
+			continue
+		}
+		if include != nil && !include(t) {
+			continue
+		}
+		ts = append(ts, t)
+	}
+
+	names := make(map[string]int, len(ts))
+	for _, t := range ts {
+		names[exportName(t.Name, t.Id)]++
+	}
+	qualified := make(map[string]int, len(ts))
+	for _, t := range ts {
+		if name := exportName(t.Name, t.Id); names[name] > 1 {
+			qualified[qualifiedExportName(t)]++
+		}
+	}
+	ret := make([]exportTarget, 0, len(ts))
+	for _, t := range ts {
+		name := exportName(t.Name, t.Id)
+		if names[name] > 1 {
+			name = qualifiedExportName(t)
+			if qualified[name] > 1 {
+				name = t.Id
+			}
+		}
+		ret = append(ret, exportTarget{name: prefix + name, target: t})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].name < ret[j].name
+	})
+	return ret
+}
+
+var exportNameInvalidChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// exportName returns the name with the characters which are not safe to use
+// in client configurations replaced, or the fallback if the name is empty.
+func exportName(name, fallback string) string {
+	name = exportNameInvalidChars.ReplaceAllString(name, "-")
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+func qualifiedExportName(t *targets.Target) string {
+	scopeName := t.ScopeId
+	if t.Scope != nil {
+		scopeName = exportName(t.Scope.Name, t.ScopeId)
+	}
+	return fmt.Sprintf("%s.%s", exportName(t.Name, t.Id), scopeName)
+}
+
+// exportTargetDefaultPort returns the default port from the target's
+// attributes, or 0 if it has none.
+func exportTargetDefaultPort(t *targets.Target) int {
+	if p, ok := t.Attributes["default_port"].(float64); ok {
+		return int(p)
+	}
+	return 0
+}
+
+// writeExport writes the configuration to stdout or, when a path is given, to
+// the file at the path, which is replaced at once so that clients reading it
+// never see a partial configuration.
+func writeExport(path string, contents []byte) error {
+	if path == "" || path == "-" {
+		_, err := os.Stdout.Write(contents)
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
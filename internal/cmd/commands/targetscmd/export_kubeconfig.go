@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ cli.Command             = (*ExportKubeconfigCommand)(nil)
+	_ cli.CommandAutocomplete = (*ExportKubeconfigCommand)(nil)
+)
+
+// kubeDefaultPort is the port Kubernetes API servers listen on by default.
+const kubeDefaultPort = 6443
+
+type ExportKubeconfigCommand struct {
+	*base.Command
+	exportFlags
+
+	flagProxyUrl              string
+	flagKubeUser              string
+	flagCertificateAuthority  string
+	flagInsecureSkipTlsVerify bool
+}
+
+func (c *ExportKubeconfigCommand) Synopsis() string {
+	return wordwrap.WrapString("Generate a kubeconfig for the accessible targets", base.TermWidth)
+}
+
+func (c *ExportKubeconfigCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary targets export-kubeconfig [options]",
+		"",
+		`  Generate a kubeconfig file with a cluster and context for each Kubernetes target the user can authorize sessions against. Kubernetes targets are TCP targets whose default port is 6443. Each cluster reaches its target through the proxy run by "boundary connect socks", which authorizes sessions as they are needed. Example:`,
+		"",
+		`    $ boundary targets export-kubeconfig -o ~/.kube/boundary`,
+		"",
+		`    $ boundary connect socks &`,
+		"",
+		`    $ KUBECONFIG=~/.kube/config:~/.kube/boundary kubectl --context prod get pods`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ExportKubeconfigCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	exportOptions(c.Command, &c.exportFlags, set, "context-prefix", "A prefix to add to the name of each cluster and context.")
+
+	f := set.NewFlagSet("Kubernetes Options")
+	f.StringVar(&base.StringVar{
+		Name:       "proxy-url",
+		Target:     &c.flagProxyUrl,
+		Default:    "socks5://127.0.0.1:1080",
+		Completion: complete.PredictAnything,
+		Usage:      `The URL of the proxy run by "boundary connect socks" which the clusters are reached through.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "kube-user",
+		Target:     &c.flagKubeUser,
+		Completion: complete.PredictAnything,
+		Usage:      "The name of a user from another kubeconfig file for the contexts to authenticate as. If not set, the contexts have no user.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "certificate-authority",
+		Target:     &c.flagCertificateAuthority,
+		Completion: complete.PredictFiles("*"),
+		Usage:      "The path to a PEM encoded file with the certificate authorities which issued the certificates of the Kubernetes API servers. The certificate authorities are embedded in each cluster. If not set, the certificates are verified using the system's certificate authorities.",
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "insecure-skip-tls-verify",
+		Target: &c.flagInsecureSkipTlsVerify,
+		Usage:  "If set, the clusters do not verify the certificates of the Kubernetes API servers. Targets without an address are only exported when this is set, since there is no name to verify their certificates against.",
+	})
+	return set
+}
+
+func (c *ExportKubeconfigCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExportKubeconfigCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExportKubeconfigCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if _, err := url.Parse(c.flagProxyUrl); err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing proxy URL: %w", err))
+		return base.CommandUserError
+	}
+	opts := kubeconfigOptions{
+		proxyUrl:              c.flagProxyUrl,
+		user:                  c.flagKubeUser,
+		insecureSkipTlsVerify: c.flagInsecureSkipTlsVerify,
+	}
+	if c.flagCertificateAuthority != "" {
+		if c.flagInsecureSkipTlsVerify {
+			c.PrintCliError(errors.New("-certificate-authority and -insecure-skip-tls-verify cannot both be specified"))
+			return base.CommandUserError
+		}
+		var err error
+		opts.certificateAuthority, err = readCertificateAuthority(c.flagCertificateAuthority)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error reading certificate authority: %w", err))
+			return base.CommandUserError
+		}
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	ts, err := listExportTargets(c.Context, targets.NewClient(client), c.FlagScopeId, c.FlagFilter, c.flagPrefix, isKubeTarget)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing targets")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing targets: %w", err))
+		return base.CommandCliError
+	}
+
+	out, skipped, err := generateKubeconfig(ts, opts)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error generating kubeconfig: %w", err))
+		return base.CommandCliError
+	}
+	for _, t := range skipped {
+		c.UI.Warn(fmt.Sprintf("Skipping target %s (%s) since it has no address to verify the Kubernetes API server's certificate against. Set -insecure-skip-tls-verify to export it.", t.target.Id, t.name))
+	}
+	if err := writeExport(c.FlagOutputFile, out); err != nil {
+		c.PrintCliError(fmt.Errorf("Error writing kubeconfig: %w", err))
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+// readCertificateAuthority returns the contents of the PEM encoded certificate
+// authority file at the path.
+func readCertificateAuthority(path string) ([]byte, error) {
+	ca, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(ca)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s does not contain a PEM encoded certificate", path)
+	}
+	return ca, nil
+}
+
+// isKubeTarget reports whether the target is likely to be a Kubernetes API
+// server.
+func isKubeTarget(t *targets.Target) bool {
+	return t.Type == "tcp" && exportTargetDefaultPort(t) == kubeDefaultPort
+}
+
+type kubeconfig struct {
+	ApiVersion string              `yaml:"apiVersion"`
+	Kind       string              `yaml:"kind"`
+	Clusters   []kubeconfigCluster `yaml:"clusters"`
+	Contexts   []kubeconfigContext `yaml:"contexts"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		ProxyUrl                 string `yaml:"proxy-url"`
+		TlsServerName            string `yaml:"tls-server-name,omitempty"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+		InsecureSkipTlsVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user,omitempty"`
+	} `yaml:"context"`
+}
+
+// kubeconfigOptions are the settings shared by the clusters and contexts of a
+// generated kubeconfig.
+type kubeconfigOptions struct {
+	proxyUrl string
+	user     string
+	// certificateAuthority is the PEM encoded certificate authorities which
+	// the clusters verify the servers' certificates with, if any.
+	certificateAuthority  []byte
+	insecureSkipTlsVerify bool
+}
+
+// generateKubeconfig returns a kubeconfig with a cluster and context for each
+// target. The clusters' servers are addressed by target ID, which the proxy
+// resolves to the target. The target's address is used as the TLS server name
+// since it's what the server's certificate is issued for. Unless the clusters
+// skip verifying certificates, the targets without an address are left out and
+// returned.
+func generateKubeconfig(ts []exportTarget, opts kubeconfigOptions) ([]byte, []exportTarget, error) {
+	cfg := kubeconfig{
+		ApiVersion: "v1",
+		Kind:       "Config",
+		Clusters:   []kubeconfigCluster{},
+		Contexts:   []kubeconfigContext{},
+	}
+	var skipped []exportTarget
+	for _, t := range ts {
+		if t.target.Address == "" && !opts.insecureSkipTlsVerify {
+			skipped = append(skipped, t)
+			continue
+		}
+		port := exportTargetDefaultPort(t.target)
+		if port == 0 {
+			port = kubeDefaultPort
+		}
+		var cluster kubeconfigCluster
+		cluster.Name = t.name
+		cluster.Cluster.Server = (&url.URL{Scheme: "https", Host: net.JoinHostPort(t.target.Id, strconv.Itoa(port))}).String()
+		cluster.Cluster.ProxyUrl = opts.proxyUrl
+		cluster.Cluster.TlsServerName = t.target.Address
+		if len(opts.certificateAuthority) > 0 {
+			cluster.Cluster.CertificateAuthorityData = base64.StdEncoding.EncodeToString(opts.certificateAuthority)
+		}
+		cluster.Cluster.InsecureSkipTlsVerify = opts.insecureSkipTlsVerify
+		cfg.Clusters = append(cfg.Clusters, cluster)
+
+		var context kubeconfigContext
+		context.Name = t.name
+		context.Context.Cluster = t.name
+		context.Context.User = opts.user
+		cfg.Contexts = append(cfg.Contexts, context)
+	}
+	var b bytes.Buffer
+	b.WriteString("# Generated by \"boundary targets export-kubeconfig\". Changes will be lost\n")
+	b.WriteString("# when the file is generated again.\n")
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(&cfg); err != nil {
+		return nil, nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, nil, err
+	}
+	return b.Bytes(), skipped, nil
+}
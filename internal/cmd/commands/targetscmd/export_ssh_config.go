@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ExportSshConfigCommand)(nil)
+	_ cli.CommandAutocomplete = (*ExportSshConfigCommand)(nil)
+)
+
+type ExportSshConfigCommand struct {
+	*base.Command
+	exportFlags
+
+	flagBoundaryPath string
+	flagExec         string
+}
+
+func (c *ExportSshConfigCommand) Synopsis() string {
+	return wordwrap.WrapString("Generate an SSH client configuration for the accessible targets", base.TermWidth)
+}
+
+func (c *ExportSshConfigCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary targets export-ssh-config [options]",
+		"",
+		"  Generate an ssh_config file with a Host block for each SSH target the user can authorize sessions against. SSH targets are targets of the ssh type and TCP targets whose default port is 22. The Host blocks connect through Boundary using a ProxyCommand, so that the SSH client and any tool using it can connect to the targets by name. Example:",
+		"",
+		`    $ boundary targets export-ssh-config -o ~/.ssh/boundary_config`,
+		"",
+		"  Include the file from your SSH client configuration:",
+		"",
+		`    Include ~/.ssh/boundary_config`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ExportSshConfigCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	exportOptions(c.Command, &c.exportFlags, set, "host-prefix", "A prefix to add to the name of each Host block.")
+
+	f := set.NewFlagSet("SSH Options")
+	f.StringVar(&base.StringVar{
+		Name:   "boundary-path",
+		Target: &c.flagBoundaryPath,
+		Usage:  "The path of the boundary binary the ProxyCommand runs. Defaults to the path of the running binary.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "exec",
+		Target:     &c.flagExec,
+		Default:    "nc",
+		Completion: complete.PredictAnything,
+		Usage:      "The binary the ProxyCommand runs to connect its standard input and output to the session's local listener. It is passed the listener's address and port.",
+	})
+	return set
+}
+
+func (c *ExportSshConfigCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExportSshConfigCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExportSshConfigCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	boundaryPath := c.flagBoundaryPath
+	if boundaryPath == "" {
+		var err error
+		boundaryPath, err = os.Executable()
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error finding the path of the boundary binary, set it with -boundary-path: %w", err))
+			return base.CommandCliError
+		}
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	ts, err := listExportTargets(c.Context, targets.NewClient(client), c.FlagScopeId, c.FlagFilter, c.flagPrefix, isSshTarget)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing targets")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing targets: %w", err))
+		return base.CommandCliError
+	}
+
+	out := generateSshConfig(ts, boundaryPath, client.Addr(), c.flagExec)
+	if err := writeExport(c.FlagOutputFile, []byte(out)); err != nil {
+		c.PrintCliError(fmt.Errorf("Error writing SSH configuration: %w", err))
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+// isSshTarget reports whether the target is likely to be an SSH server.
+func isSshTarget(t *targets.Target) bool {
+	return t.Type == "ssh" || exportTargetDefaultPort(t) == 22
+}
+
+func generateSshConfig(ts []exportTarget, boundaryPath, addr, exec string) string {
+	var b strings.Builder
+	b.WriteString("# Generated by \"boundary targets export-ssh-config\". Changes will be lost\n")
+	b.WriteString("# when the file is generated again.\n")
+	for _, t := range ts {
+		fmt.Fprintf(&b, "\nHost %s\n", t.name)
+		fmt.Fprintf(&b, "  # Target %s in scope %s\n", t.target.Id, t.target.ScopeId)
+		// Host keys are recorded per target since every session is reached
+		// through a different local port.
+		fmt.Fprintf(&b, "  HostKeyAlias %s\n", t.target.Id)
+		proxyCommand := []string{
+			shellQuote(boundaryPath), "connect",
+			"-addr", shellQuote(addr),
+			"-target-id", shellQuote(t.target.Id),
+			"-exec", shellQuote(exec),
+			"--", "{{boundary.ip}}", "{{boundary.port}}",
+		}
+		fmt.Fprintf(&b, "  ProxyCommand %s\n", strings.Join(proxyCommand, " "))
+	}
+	return b.String()
+}
+
+// shellQuote quotes the value for the shell the SSH client runs the
+// ProxyCommand with if it contains characters the shell would interpret.
+func shellQuote(v string) string {
+	if v != "" && strings.Trim(v, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-./:@") == "" {
+		return v
+	}
+	return "'" + strings.ReplaceAll(v, "'", `'\''`) + "'"
+}
@@ -532,6 +532,30 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.WorkerSelectionStrategy != "" {
+		nonAttributeMap["Worker Selection Strategy"] = item.WorkerSelectionStrategy
+	}
+	if item.HostSelectionStrategy != "" {
+		nonAttributeMap["Host Selection Strategy"] = item.HostSelectionStrategy
+	}
+	if item.EnableHostHealthChecks {
+		nonAttributeMap["Host Health Checks Enabled"] = item.EnableHostHealthChecks
+	}
+	if item.BandwidthLimit != 0 {
+		nonAttributeMap["Bandwidth Limit"] = item.BandwidthLimit
+	}
+	if item.SessionBandwidthLimit != 0 {
+		nonAttributeMap["Session Bandwidth Limit"] = item.SessionBandwidthLimit
+	}
+	if item.MaxConcurrentConnections != 0 {
+		nonAttributeMap["Max Concurrent Connections"] = item.MaxConcurrentConnections
+	}
+	if item.SessionMaxConcurrentConnections != 0 {
+		nonAttributeMap["Session Max Concurrent Connections"] = item.SessionMaxConcurrentConnections
+	}
+	if item.ConnectionIdleTimeoutSeconds != 0 {
+		nonAttributeMap["Connection Idle Timeout Seconds"] = item.ConnectionIdleTimeoutSeconds
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -25,27 +25,39 @@ func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "worker-selection-strategy", "host-selection-strategy", "enable-host-health-checks",
+			"bandwidth-limit", "session-bandwidth-limit", "max-concurrent-connections", "session-max-concurrent-connections",
+			"connection-idle-timeout",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
 			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"storage-bucket-id", "worker-selection-strategy", "host-selection-strategy", "enable-host-health-checks",
+			"bandwidth-limit", "session-bandwidth-limit", "max-concurrent-connections", "session-max-concurrent-connections",
+			"connection-idle-timeout",
 		},
 	}
 }
 
 type extraSshCmdVars struct {
-	flagDefaultPort            string
-	flagDefaultClientPort      string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
-	flagEgressWorkerFilter     string
-	flagIngressWorkerFilter    string
-	flagAddress                string
-	flagStorageBucketId        string
-	flagEnableSessionRecording string
+	flagDefaultPort               string
+	flagDefaultClientPort         string
+	flagSessionMaxSeconds         string
+	flagSessionConnectionLimit    string
+	flagWorkerFilter              string
+	flagEgressWorkerFilter        string
+	flagIngressWorkerFilter       string
+	flagWorkerSelection           string
+	flagHostSelection             string
+	flagHostHealthChecks          string
+	flagBandwidthLimit            string
+	flagSessionBandwidthLimit     string
+	flagMaxConcurrentConns        string
+	flagSessionMaxConcurrentConns string
+	flagConnectionIdleTimeout     string
+	flagAddress                   string
+	flagStorageBucketId           string
+	flagEnableSessionRecording    string
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -129,6 +141,54 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "worker-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-selection-strategy",
+				Target: &c.flagWorkerSelection,
+				Usage:  `The strategy used to order the workers which can handle sessions for this target. One of "random", "least-connections", "latency-weighted", or "sticky".`,
+			})
+		case "host-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-selection-strategy",
+				Target: &c.flagHostSelection,
+				Usage:  `The strategy used to choose the host for a session when no host is requested. One of "random", "round-robin", or "least-connections".`,
+			})
+		case "enable-host-health-checks":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-host-health-checks",
+				Target: &c.flagHostHealthChecks,
+				Usage:  "A boolean indicating if workers check the health of the hosts of this target. Hosts which failed their most recent check are not chosen for new sessions.",
+			})
+		case "bandwidth-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "bandwidth-limit",
+				Target: &c.flagBandwidthLimit,
+				Usage:  "The maximum number of bytes per second that all connections to the target through a single worker can transfer. 0 means unlimited.",
+			})
+		case "session-bandwidth-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-bandwidth-limit",
+				Target: &c.flagSessionBandwidthLimit,
+				Usage:  "The maximum number of bytes per second that all connections of a session through a single worker can transfer. 0 means unlimited.",
+			})
+		case "max-concurrent-connections":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-concurrent-connections",
+				Target: &c.flagMaxConcurrentConns,
+				Usage:  "The maximum number of concurrent connections to the target through a single worker. 0 means unlimited.",
+			})
+		case "session-max-concurrent-connections":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-concurrent-connections",
+				Target: &c.flagSessionMaxConcurrentConns,
+				Usage:  "The maximum number of concurrent connections of a session through a single worker. 0 means unlimited.",
+			})
+		case "connection-idle-timeout":
+			fs.StringVar(&base.StringVar{
+				Name:   "connection-idle-timeout",
+				Target: &c.flagConnectionIdleTimeout,
+				Usage:  "The time after which a connection with no bytes transferred in either direction is closed by the worker. Can be specified as an integer number of seconds or a duration string. 0 means connections are never closed for being idle.",
+			})
 		case "storage-bucket-id":
 			fs.StringVar(&base.StringVar{
 				Name:   "storage-bucket-id",
@@ -240,6 +300,107 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagWorkerSelection {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithWorkerSelectionStrategy(c.flagWorkerSelection))
+	}
+
+	switch c.flagHostSelection {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithHostSelectionStrategy(c.flagHostSelection))
+	}
+
+	switch c.flagHostHealthChecks {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnableHostHealthChecks())
+	case "false":
+		*opts = append(*opts, targets.WithEnableHostHealthChecks(false))
+	case "true":
+		*opts = append(*opts, targets.WithEnableHostHealthChecks(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for enable-host-health-checks %v", c.flagHostHealthChecks))
+		return false
+	}
+
+	switch c.flagBandwidthLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultBandwidthLimit())
+	default:
+		limit, err := strconv.ParseUint(c.flagBandwidthLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagBandwidthLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithBandwidthLimit(uint32(limit)))
+	}
+
+	switch c.flagSessionBandwidthLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionBandwidthLimit())
+	default:
+		limit, err := strconv.ParseUint(c.flagSessionBandwidthLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionBandwidthLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionBandwidthLimit(uint32(limit)))
+	}
+
+	switch c.flagMaxConcurrentConns {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultMaxConcurrentConnections())
+	default:
+		limit, err := strconv.ParseUint(c.flagMaxConcurrentConns, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxConcurrentConns, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithMaxConcurrentConnections(uint32(limit)))
+	}
+
+	switch c.flagSessionMaxConcurrentConns {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxConcurrentConnections())
+	default:
+		limit, err := strconv.ParseUint(c.flagSessionMaxConcurrentConns, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxConcurrentConns, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionMaxConcurrentConnections(uint32(limit)))
+	}
+
+	switch c.flagConnectionIdleTimeout {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultConnectionIdleTimeoutSeconds())
+	default:
+		var final uint32
+		secs, err := strconv.ParseUint(c.flagConnectionIdleTimeout, 10, 32)
+		if err == nil {
+			final = uint32(secs)
+		} else {
+			dur, err := time.ParseDuration(c.flagConnectionIdleTimeout)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagConnectionIdleTimeout, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithConnectionIdleTimeoutSeconds(final))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
@@ -27,6 +27,7 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-worker-tags":    {"id", "tag", "version"},
 		"set-worker-tags":    {"id", "tag", "version"},
 		"remove-worker-tags": {"id", "tag", "version"},
+		"drain":              {"id"},
 	}
 }
 
@@ -38,6 +39,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return "Set api tags for the specified worker"
 	case "remove-worker-tags":
 		return "Remove api tags from the specified worker"
+	case "drain":
+		return "Drain the specified worker"
 	default:
 		return ""
 	}
@@ -94,6 +97,19 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "drain":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers drain [args]",
+			"",
+			"  Request that a worker stop accepting new sessions and shut down once its",
+			"  active connections have closed or its drain timeout has passed. Example:",
+			"",
+			"    Drain a specified worker:",
+			"",
+			`      $ boundary workers drain -id w_1234567890`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -161,6 +177,12 @@ func executeExtraActionsImpl(c *Command, inResp *api.Response, inItem *workers.W
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "drain":
+		result, err := workerClient.Drain(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return inResp, inItem, inItems, inErr
 }
@@ -223,6 +245,11 @@ func (c *Command) printListTable(items []*workers.Worker) string {
 				fmt.Sprintf("    ReleaseVersion:          %s", item.ReleaseVersion),
 			)
 		}
+		if item.OperationalState != "" {
+			output = append(output,
+				fmt.Sprintf("    Operational State:       %s", item.OperationalState),
+			)
+		}
 		if !item.LastStatusTime.IsZero() {
 			output = append(output,
 				fmt.Sprintf("    Last Status Time:        %s", item.LastStatusTime.Format(time.RFC1123)),
@@ -274,6 +301,9 @@ func printItemTable(item *workers.Worker, resp *api.Response) string {
 	if item.ReleaseVersion != "" {
 		nonAttributeMap["Release Version"] = item.ReleaseVersion
 	}
+	if item.OperationalState != "" {
+		nonAttributeMap["Operational State"] = item.OperationalState
+	}
 	if !item.LastStatusTime.IsZero() {
 		nonAttributeMap["Last Status Time"] = item.LastStatusTime
 	}
@@ -150,6 +150,9 @@ type Config struct {
 	// Eventing configuration for the controller
 	Eventing *event.EventerConfig `hcl:"events"`
 
+	// Tracing configuration for exporting OpenTelemetry spans
+	Tracing *Tracing `hcl:"tracing"`
+
 	// Plugin-related options
 	Plugins Plugins `hcl:"plugins"`
 
@@ -183,6 +186,12 @@ type Controller struct {
 	AuthTokenTimeToStale         any           `hcl:"auth_token_time_to_stale"`
 	AuthTokenTimeToStaleDuration time.Duration `hcl:"-"`
 
+	// SessionMaxLifetime is the total lifetime, from creation to expiration, up
+	// to which a session can be extended, denoted by time.Duration. Grants can
+	// limit it further with max_lifetime_seconds.
+	SessionMaxLifetime         any           `hcl:"session_max_lifetime"`
+	SessionMaxLifetimeDuration time.Duration `hcl:"-"`
+
 	// GracefulShutdownWait is the amount of time that we'll wait before actually
 	// starting the Controller shutdown. This allows the health endpoint to
 	// return a status code to indicate that the instance is shutting down.
@@ -287,6 +296,12 @@ type Worker struct {
 	SuccessfulStatusGracePeriod         interface{}   `hcl:"successful_status_grace_period"`
 	SuccessfulStatusGracePeriodDuration time.Duration `hcl:"-"`
 
+	// DrainTimeout represents the maximum period of time (as a duration) that
+	// a draining worker will wait for its active connections to close before
+	// shutting down. Defaults to one hour.
+	DrainTimeout         any           `hcl:"drain_timeout"`
+	DrainTimeoutDuration time.Duration `hcl:"-"`
+
 	// AuthStoragePath represents the location a worker stores its node credentials, if set
 	AuthStoragePath string `hcl:"auth_storage_path"`
 
@@ -341,6 +356,21 @@ type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
 }
 
+// Tracing configures exporting OpenTelemetry spans to an OTLP collector.
+type Tracing struct {
+	// OtlpEndpoint is the host and port of the OTLP/HTTP collector spans are
+	// exported to, e.g. "localhost:4318".
+	OtlpEndpoint string `hcl:"otlp_endpoint"`
+
+	// OtlpInsecure exports spans over plain HTTP instead of HTTPS. It is
+	// meant for a collector running on the same host.
+	OtlpInsecure bool `hcl:"otlp_insecure"`
+
+	// SampleRatio is the ratio of new traces that are sampled, from 0 to 1.
+	// Defaults to 1.
+	SampleRatio *float64 `hcl:"sample_ratio"`
+}
+
 type Reporting struct {
 	License License `hcl:"license"`
 }
@@ -584,6 +614,17 @@ func Parse(d string) (*Config, error) {
 			result.Controller.AuthTokenTimeToStaleDuration = t
 		}
 
+		if result.Controller.SessionMaxLifetime != nil {
+			t, err := parseutil.ParseDurationSecond(result.Controller.SessionMaxLifetime)
+			if err != nil {
+				return result, err
+			}
+			result.Controller.SessionMaxLifetimeDuration = t
+		}
+		if result.Controller.SessionMaxLifetimeDuration < 0 {
+			return nil, errors.New("Controller session max lifetime value is negative")
+		}
+
 		if result.Controller.GracefulShutdownWait != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.GracefulShutdownWait)
 			if err != nil {
@@ -790,6 +831,17 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Successful status grace period value is negative")
 		}
 
+		if !util.IsNil(result.Worker.DrainTimeout) {
+			t, err := parseutil.ParseDurationSecond(result.Worker.DrainTimeout)
+			if err != nil {
+				return result, err
+			}
+			result.Worker.DrainTimeoutDuration = t
+		}
+		if result.Worker.DrainTimeoutDuration < 0 {
+			return nil, errors.New("Drain timeout value is negative")
+		}
+
 		switch {
 		case result.Worker.StatusCallTimeoutDuration == 0 && result.Worker.SuccessfulStatusGracePeriodDuration == 0:
 			// Nothing
@@ -967,6 +1019,19 @@ func Parse(d string) (*Config, error) {
 		return nil, fmt.Errorf(`too many "events" nodes (max 1, got %d)`, len(eventList.Items))
 	}
 
+	if result.Tracing != nil {
+		result.Tracing.OtlpEndpoint, err = parseutil.ParsePath(result.Tracing.OtlpEndpoint)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return nil, fmt.Errorf("Error parsing tracing otlp endpoint: %w", err)
+		}
+		if result.Tracing.OtlpEndpoint == "" {
+			return nil, errors.New("Tracing otlp endpoint must be set")
+		}
+		if r := result.Tracing.SampleRatio; r != nil && (*r < 0 || *r > 1) {
+			return nil, errors.New("Tracing sample ratio must be between 0 and 1")
+		}
+	}
+
 	if result.Plugins.ExecutionDir != "" {
 		result.Plugins.ExecutionDir, err = parseutil.ParsePath(result.Plugins.ExecutionDir)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
@@ -1756,6 +1756,66 @@ func TestControllerApiRateLimits(t *testing.T) {
 	}
 }
 
+func TestControllerSessionMaxLifetime(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expDur    time.Duration
+		expErr    bool
+		expErrStr string
+	}{
+		{
+			name: "Not set",
+			in: `
+			controller {
+				name = "c"
+			}`,
+			expDur: 0,
+		}, {
+			name: "Duration string",
+			in: `
+			controller {
+				name = "c"
+				session_max_lifetime = "48h"
+			}`,
+			expDur: 48 * time.Hour,
+		}, {
+			name: "Seconds",
+			in: `
+			controller {
+				name = "c"
+				session_max_lifetime = 3600
+			}`,
+			expDur: time.Hour,
+		}, {
+			name: "Negative",
+			in: `
+			controller {
+				name = "c"
+				session_max_lifetime = "-1s"
+			}`,
+			expErr:    true,
+			expErrStr: "Controller session max lifetime value is negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expDur, c.Controller.SessionMaxLifetimeDuration)
+		})
+	}
+}
+
 func TestWorkerDescription(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1811,6 +1871,63 @@ func TestWorkerDescription(t *testing.T) {
 	}
 }
 
+func TestWorkerDrainTimeout(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expDur    time.Duration
+		expErr    bool
+		expErrStr string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "w"
+			}`,
+			expDur: 0,
+		}, {
+			name: "Duration string",
+			in: `
+			worker {
+				drain_timeout = "30m"
+			}`,
+			expDur: 30 * time.Minute,
+		}, {
+			name: "Seconds",
+			in: `
+			worker {
+				drain_timeout = 90
+			}`,
+			expDur: 90 * time.Second,
+		}, {
+			name: "Negative",
+			in: `
+			worker {
+				drain_timeout = "-1s"
+			}`,
+			expErr:    true,
+			expErrStr: "Drain timeout value is negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.expDur, c.Worker.DrainTimeoutDuration)
+		})
+	}
+}
+
 func TestPluginExecutionDir(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -1857,6 +1974,66 @@ func TestPluginExecutionDir(t *testing.T) {
 	}
 }
 
+func TestTracing(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		exp       *Tracing
+		expErrStr string
+	}{
+		{
+			name: "Not set",
+			in:   ``,
+		},
+		{
+			name: "Valid endpoint from env var",
+			in: `
+			tracing {
+				otlp_endpoint = "env://OTLP_ENDPOINT"
+				otlp_insecure = true
+				sample_ratio = 0.25
+			}`,
+			exp: &Tracing{
+				OtlpEndpoint: "localhost:4318",
+				OtlpInsecure: true,
+				SampleRatio:  func() *float64 { r := 0.25; return &r }(),
+			},
+		},
+		{
+			name: "Missing endpoint",
+			in: `
+			tracing {
+				sample_ratio = 0.25
+			}`,
+			expErrStr: "Tracing otlp endpoint must be set",
+		},
+		{
+			name: "Invalid sample ratio",
+			in: `
+			tracing {
+				otlp_endpoint = "localhost:4318"
+				sample_ratio = 1.5
+			}`,
+			expErrStr: "Tracing sample ratio must be between 0 and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTLP_ENDPOINT", "localhost:4318")
+			p, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, p)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, p)
+			require.Equal(t, tt.exp, p.Tracing)
+		})
+	}
+}
+
 func TestDatabaseMaxConnections(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -593,7 +593,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraCommandVars: true,
 			HasExtraHelpFunc:    true,
 			HasId:               true,
-			VersionedActions:    []string{"cancel"},
+			VersionedActions:    []string{"cancel", "extend"},
 		},
 	},
 	"sessionrecordings": {
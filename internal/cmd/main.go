@@ -239,6 +239,10 @@ func RunCustom(args []string, runOpts *RunOptions) (exitCode int) {
 		os.Setenv("COMP_LINE", strings.Join(append([]string{"boundary"}, args...), " "))
 	}
 
+	if compLine == "" {
+		defer base.SetupCliTracing(args)()
+	}
+
 	initCommands(ui, serverCmdUi, runOpts)
 
 	hiddenCommands := []string{"version"}
@@ -50,6 +50,30 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         auth_method,
+         mount_path,
+         role_id,
+         secret_id_hmac,
+         kubernetes_role,
+         jwt_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
+vault_store_health as (
+  select store_id,
+         status,
+         vault_reachable,
+         token_expiration_time,
+         missing_capabilities,
+         last_check_error,
+         last_check_time,
+         last_renewal_error,
+         last_renewal_error_time
+    from credential_vault_store_health
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
@@ -74,10 +98,26 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.auth_method                  as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role_id                      as approle_role_id,
+            auth.secret_id_hmac               as approle_secret_id_hmac,
+            auth.kubernetes_role              as kubernetes_role,
+            auth.jwt_hmac                     as kubernetes_jwt_hmac,
+            health.status                     as health_status,
+            health.vault_reachable            as health_vault_reachable,
+            health.token_expiration_time      as health_token_expiration_time,
+            health.missing_capabilities       as health_missing_capabilities,
+            health.last_check_error           as health_last_check_error,
+            health.last_check_time            as health_last_check_time,
+            health.last_renewal_error         as health_last_renewal_error,
+            health.last_renewal_error_time    as health_last_renewal_error_time,
             'vault' as subtype
        from vault_stores store
-  left join vault_tokens token      on store.public_id = token.store_id
-  left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_tokens token       on store.public_id = token.store_id
+  left join vault_client_certs cert  on store.public_id = cert.store_id
+  left join vault_auth_methods auth  on store.public_id = auth.store_id
+  left join vault_store_health health on store.public_id = health.store_id
       union
      select public_id,
             project_id,
@@ -86,17 +126,31 @@ final as (
             create_time,
             update_time,
             version,
-            null as delete_time,          -- Add to make union uniform
-            null as vault_address,        -- Add to make union uniform
-            null as namespace,            -- Add to make union uniform
-            null as ca_cert,              -- Add to make union uniform
-            null as tls_server_name,      -- Add to make union uniform
-            null as tls_skip_verify,      -- Add to make union uniform
-            null as worker_filter,        -- Add to make union uniform
-            null as token_hmac,           -- Add to make union uniform
-            null as token_status,         -- Add to make union uniform
-            null as client_cert,          -- Add to make union uniform
-            null as client_cert_key_hmac, -- Add to make union uniform
+            null as delete_time,            -- Add to make union uniform
+            null as vault_address,          -- Add to make union uniform
+            null as namespace,              -- Add to make union uniform
+            null as ca_cert,                -- Add to make union uniform
+            null as tls_server_name,        -- Add to make union uniform
+            null as tls_skip_verify,        -- Add to make union uniform
+            null as worker_filter,          -- Add to make union uniform
+            null as token_hmac,             -- Add to make union uniform
+            null as token_status,           -- Add to make union uniform
+            null as client_cert,            -- Add to make union uniform
+            null as client_cert_key_hmac,   -- Add to make union uniform
+            null as auth_method,            -- Add to make union uniform
+            null as auth_mount_path,        -- Add to make union uniform
+            null as approle_role_id,        -- Add to make union uniform
+            null as approle_secret_id_hmac, -- Add to make union uniform
+            null as kubernetes_role,        -- Add to make union uniform
+            null as kubernetes_jwt_hmac,    -- Add to make union uniform
+            null as health_status,                  -- Add to make union uniform
+            null as health_vault_reachable,         -- Add to make union uniform
+            null as health_token_expiration_time,   -- Add to make union uniform
+            null as health_missing_capabilities,    -- Add to make union uniform
+            null as health_last_check_error,        -- Add to make union uniform
+            null as health_last_check_time,         -- Add to make union uniform
+            null as health_last_renewal_error,      -- Add to make union uniform
+            null as health_last_renewal_error_time, -- Add to make union uniform
             'static' as subtype
        from static_stores
 )
@@ -135,6 +189,30 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         auth_method,
+         mount_path,
+         role_id,
+         secret_id_hmac,
+         kubernetes_role,
+         jwt_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
+vault_store_health as (
+  select store_id,
+         status,
+         vault_reachable,
+         token_expiration_time,
+         missing_capabilities,
+         last_check_error,
+         last_check_time,
+         last_renewal_error,
+         last_renewal_error_time
+    from credential_vault_store_health
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
@@ -159,10 +237,26 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.auth_method                  as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role_id                      as approle_role_id,
+            auth.secret_id_hmac               as approle_secret_id_hmac,
+            auth.kubernetes_role              as kubernetes_role,
+            auth.jwt_hmac                     as kubernetes_jwt_hmac,
+            health.status                     as health_status,
+            health.vault_reachable            as health_vault_reachable,
+            health.token_expiration_time      as health_token_expiration_time,
+            health.missing_capabilities       as health_missing_capabilities,
+            health.last_check_error           as health_last_check_error,
+            health.last_check_time            as health_last_check_time,
+            health.last_renewal_error         as health_last_renewal_error,
+            health.last_renewal_error_time    as health_last_renewal_error_time,
             'vault' as subtype
        from vault_stores store
-  left join vault_tokens token      on store.public_id = token.store_id
-  left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_tokens token       on store.public_id = token.store_id
+  left join vault_client_certs cert  on store.public_id = cert.store_id
+  left join vault_auth_methods auth  on store.public_id = auth.store_id
+  left join vault_store_health health on store.public_id = health.store_id
       union
      select public_id,
             project_id,
@@ -171,17 +265,31 @@ final as (
             create_time,
             update_time,
             version,
-            null as delete_time,          -- Add to make union uniform
-            null as vault_address,        -- Add to make union uniform
-            null as namespace,            -- Add to make union uniform
-            null as ca_cert,              -- Add to make union uniform
-            null as tls_server_name,      -- Add to make union uniform
-            null as tls_skip_verify,      -- Add to make union uniform
-            null as worker_filter,        -- Add to make union uniform
-            null as token_hmac,           -- Add to make union uniform
-            null as token_status,         -- Add to make union uniform
-            null as client_cert,          -- Add to make union uniform
-            null as client_cert_key_hmac, -- Add to make union uniform
+            null as delete_time,            -- Add to make union uniform
+            null as vault_address,          -- Add to make union uniform
+            null as namespace,              -- Add to make union uniform
+            null as ca_cert,                -- Add to make union uniform
+            null as tls_server_name,        -- Add to make union uniform
+            null as tls_skip_verify,        -- Add to make union uniform
+            null as worker_filter,          -- Add to make union uniform
+            null as token_hmac,             -- Add to make union uniform
+            null as token_status,           -- Add to make union uniform
+            null as client_cert,            -- Add to make union uniform
+            null as client_cert_key_hmac,   -- Add to make union uniform
+            null as auth_method,            -- Add to make union uniform
+            null as auth_mount_path,        -- Add to make union uniform
+            null as approle_role_id,        -- Add to make union uniform
+            null as approle_secret_id_hmac, -- Add to make union uniform
+            null as kubernetes_role,        -- Add to make union uniform
+            null as kubernetes_jwt_hmac,    -- Add to make union uniform
+            null as health_status,                  -- Add to make union uniform
+            null as health_vault_reachable,         -- Add to make union uniform
+            null as health_token_expiration_time,   -- Add to make union uniform
+            null as health_missing_capabilities,    -- Add to make union uniform
+            null as health_last_check_error,        -- Add to make union uniform
+            null as health_last_check_time,         -- Add to make union uniform
+            null as health_last_renewal_error,      -- Add to make union uniform
+            null as health_last_renewal_error_time, -- Add to make union uniform
             'static' as subtype
        from static_stores
 )
@@ -220,6 +328,30 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         auth_method,
+         mount_path,
+         role_id,
+         secret_id_hmac,
+         kubernetes_role,
+         jwt_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
+vault_store_health as (
+  select store_id,
+         status,
+         vault_reachable,
+         token_expiration_time,
+         missing_capabilities,
+         last_check_error,
+         last_check_time,
+         last_renewal_error,
+         last_renewal_error_time
+    from credential_vault_store_health
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
@@ -244,10 +376,26 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.auth_method                  as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role_id                      as approle_role_id,
+            auth.secret_id_hmac               as approle_secret_id_hmac,
+            auth.kubernetes_role              as kubernetes_role,
+            auth.jwt_hmac                     as kubernetes_jwt_hmac,
+            health.status                     as health_status,
+            health.vault_reachable            as health_vault_reachable,
+            health.token_expiration_time      as health_token_expiration_time,
+            health.missing_capabilities       as health_missing_capabilities,
+            health.last_check_error           as health_last_check_error,
+            health.last_check_time            as health_last_check_time,
+            health.last_renewal_error         as health_last_renewal_error,
+            health.last_renewal_error_time    as health_last_renewal_error_time,
             'vault' as subtype
        from vault_stores store
-  left join vault_tokens token      on store.public_id = token.store_id
-  left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_tokens token       on store.public_id = token.store_id
+  left join vault_client_certs cert  on store.public_id = cert.store_id
+  left join vault_auth_methods auth  on store.public_id = auth.store_id
+  left join vault_store_health health on store.public_id = health.store_id
       union
      select public_id,
             project_id,
@@ -256,17 +404,31 @@ final as (
             create_time,
             update_time,
             version,
-            null as delete_time,          -- Add to make union uniform
-            null as vault_address,        -- Add to make union uniform
-            null as namespace,            -- Add to make union uniform
-            null as ca_cert,              -- Add to make union uniform
-            null as tls_server_name,      -- Add to make union uniform
-            null as tls_skip_verify,      -- Add to make union uniform
-            null as worker_filter,        -- Add to make union uniform
-            null as token_hmac,           -- Add to make union uniform
-            null as token_status,         -- Add to make union uniform
-            null as client_cert,          -- Add to make union uniform
-            null as client_cert_key_hmac, -- Add to make union uniform
+            null as delete_time,            -- Add to make union uniform
+            null as vault_address,          -- Add to make union uniform
+            null as namespace,              -- Add to make union uniform
+            null as ca_cert,                -- Add to make union uniform
+            null as tls_server_name,        -- Add to make union uniform
+            null as tls_skip_verify,        -- Add to make union uniform
+            null as worker_filter,          -- Add to make union uniform
+            null as token_hmac,             -- Add to make union uniform
+            null as token_status,           -- Add to make union uniform
+            null as client_cert,            -- Add to make union uniform
+            null as client_cert_key_hmac,   -- Add to make union uniform
+            null as auth_method,            -- Add to make union uniform
+            null as auth_mount_path,        -- Add to make union uniform
+            null as approle_role_id,        -- Add to make union uniform
+            null as approle_secret_id_hmac, -- Add to make union uniform
+            null as kubernetes_role,        -- Add to make union uniform
+            null as kubernetes_jwt_hmac,    -- Add to make union uniform
+            null as health_status,                  -- Add to make union uniform
+            null as health_vault_reachable,         -- Add to make union uniform
+            null as health_token_expiration_time,   -- Add to make union uniform
+            null as health_missing_capabilities,    -- Add to make union uniform
+            null as health_last_check_error,        -- Add to make union uniform
+            null as health_last_check_time,         -- Add to make union uniform
+            null as health_last_renewal_error,      -- Add to make union uniform
+            null as health_last_renewal_error_time, -- Add to make union uniform
             'static' as subtype
        from static_stores
 )
@@ -306,6 +468,30 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         auth_method,
+         mount_path,
+         role_id,
+         secret_id_hmac,
+         kubernetes_role,
+         jwt_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
+vault_store_health as (
+  select store_id,
+         status,
+         vault_reachable,
+         token_expiration_time,
+         missing_capabilities,
+         last_check_error,
+         last_check_time,
+         last_renewal_error,
+         last_renewal_error_time
+    from credential_vault_store_health
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
@@ -330,10 +516,26 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.auth_method                  as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role_id                      as approle_role_id,
+            auth.secret_id_hmac               as approle_secret_id_hmac,
+            auth.kubernetes_role              as kubernetes_role,
+            auth.jwt_hmac                     as kubernetes_jwt_hmac,
+            health.status                     as health_status,
+            health.vault_reachable            as health_vault_reachable,
+            health.token_expiration_time      as health_token_expiration_time,
+            health.missing_capabilities       as health_missing_capabilities,
+            health.last_check_error           as health_last_check_error,
+            health.last_check_time            as health_last_check_time,
+            health.last_renewal_error         as health_last_renewal_error,
+            health.last_renewal_error_time    as health_last_renewal_error_time,
             'vault' as subtype
        from vault_stores store
-  left join vault_tokens token      on store.public_id = token.store_id
-  left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_tokens token       on store.public_id = token.store_id
+  left join vault_client_certs cert  on store.public_id = cert.store_id
+  left join vault_auth_methods auth  on store.public_id = auth.store_id
+  left join vault_store_health health on store.public_id = health.store_id
       union
      select public_id,
             project_id,
@@ -342,17 +544,31 @@ final as (
             create_time,
             update_time,
             version,
-            null as delete_time,          -- Add to make union uniform
-            null as vault_address,        -- Add to make union uniform
-            null as namespace,            -- Add to make union uniform
-            null as ca_cert,              -- Add to make union uniform
-            null as tls_server_name,      -- Add to make union uniform
-            null as tls_skip_verify,      -- Add to make union uniform
-            null as worker_filter,        -- Add to make union uniform
-            null as token_hmac,           -- Add to make union uniform
-            null as token_status,         -- Add to make union uniform
-            null as client_cert,          -- Add to make union uniform
-            null as client_cert_key_hmac, -- Add to make union uniform
+            null as delete_time,            -- Add to make union uniform
+            null as vault_address,          -- Add to make union uniform
+            null as namespace,              -- Add to make union uniform
+            null as ca_cert,                -- Add to make union uniform
+            null as tls_server_name,        -- Add to make union uniform
+            null as tls_skip_verify,        -- Add to make union uniform
+            null as worker_filter,          -- Add to make union uniform
+            null as token_hmac,             -- Add to make union uniform
+            null as token_status,           -- Add to make union uniform
+            null as client_cert,            -- Add to make union uniform
+            null as client_cert_key_hmac,   -- Add to make union uniform
+            null as auth_method,            -- Add to make union uniform
+            null as auth_mount_path,        -- Add to make union uniform
+            null as approle_role_id,        -- Add to make union uniform
+            null as approle_secret_id_hmac, -- Add to make union uniform
+            null as kubernetes_role,        -- Add to make union uniform
+            null as kubernetes_jwt_hmac,    -- Add to make union uniform
+            null as health_status,                  -- Add to make union uniform
+            null as health_vault_reachable,         -- Add to make union uniform
+            null as health_token_expiration_time,   -- Add to make union uniform
+            null as health_missing_capabilities,    -- Add to make union uniform
+            null as health_last_check_error,        -- Add to make union uniform
+            null as health_last_check_time,         -- Add to make union uniform
+            null as health_last_renewal_error,      -- Add to make union uniform
+            null as health_last_renewal_error_time, -- Add to make union uniform
             'static' as subtype
        from static_stores
 )
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/x509"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SshPrivateKeySigner parses privateKey and returns it as a crypto.Signer.
+// If privateKey is encrypted, passphrase is used to decrypt it. Both PEM
+// encoded and OpenSSH formatted private keys are supported.
+//
+// The returned error has one of the following codes when the passphrase is the
+// cause of the failure:
+//   - errors.PrivateKeyPassphraseMissing if privateKey is encrypted and
+//     passphrase is empty
+//   - errors.PrivateKeyPassphraseIncorrect if passphrase does not decrypt
+//     privateKey
+//   - errors.PrivateKeyPassphraseUnneeded if privateKey is not encrypted and
+//     passphrase is not empty
+//
+// Any other parse failure returns an error with errors.InvalidParameter.
+func SshPrivateKeySigner(ctx context.Context, privateKey PrivateKey, passphrase []byte) (crypto.Signer, error) {
+	const op = "credential.SshPrivateKeySigner"
+	if len(privateKey) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+	}
+
+	raw, err := ssh.ParseRawPrivateKey(privateKey)
+	var missingErr *ssh.PassphraseMissingError
+	switch {
+	case err == nil:
+		if len(passphrase) > 0 {
+			return nil, errors.New(ctx, errors.PrivateKeyPassphraseUnneeded, op, "passphrase supplied for unencrypted private key")
+		}
+	case stderrors.As(err, &missingErr):
+		if len(passphrase) == 0 {
+			return nil, errors.New(ctx, errors.PrivateKeyPassphraseMissing, op, "private key is encrypted and no passphrase was provided")
+		}
+		raw, err = ssh.ParseRawPrivateKeyWithPassphrase(privateKey, passphrase)
+		switch {
+		case err == nil:
+		case stderrors.Is(err, x509.IncorrectPasswordError):
+			return nil, errors.New(ctx, errors.PrivateKeyPassphraseIncorrect, op, "incorrect private key passphrase")
+		default:
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to decrypt private key"))
+		}
+	default:
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse private key"))
+	}
+
+	// ed25519 keys are returned as a pointer by the ssh package but
+	// ed25519.PrivateKey is the type that implements crypto.Signer.
+	if k, ok := raw.(*ed25519.PrivateKey); ok {
+		raw = *k
+	}
+	signer, ok := raw.(crypto.Signer)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported private key type %T", raw))
+	}
+	return signer, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func testSshPrivateKey(t *testing.T, key crypto.PrivateKey, passphrase []byte) PrivateKey {
+	t.Helper()
+	var block *pem.Block
+	var err error
+	switch len(passphrase) {
+	case 0:
+		block, err = ssh.MarshalPrivateKey(key, "")
+	default:
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(key, "", passphrase)
+	}
+	require.NoError(t, err)
+	return pem.EncodeToMemory(block)
+}
+
+func TestSshPrivateKeySigner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	passphrase := []byte("correct horse battery staple")
+
+	tests := []struct {
+		name       string
+		privateKey PrivateKey
+		passphrase []byte
+		wantPub    crypto.PublicKey
+		wantErr    errors.Code
+	}{
+		{
+			name:       "unencrypted-ed25519",
+			privateKey: testSshPrivateKey(t, edKey, nil),
+			wantPub:    edKey.Public(),
+		},
+		{
+			name:       "encrypted-ed25519",
+			privateKey: testSshPrivateKey(t, edKey, passphrase),
+			passphrase: passphrase,
+			wantPub:    edKey.Public(),
+		},
+		{
+			name:       "encrypted-ecdsa",
+			privateKey: testSshPrivateKey(t, ecKey, passphrase),
+			passphrase: passphrase,
+			wantPub:    ecKey.Public(),
+		},
+		{
+			name:       "missing-passphrase",
+			privateKey: testSshPrivateKey(t, edKey, passphrase),
+			wantErr:    errors.PrivateKeyPassphraseMissing,
+		},
+		{
+			name:       "incorrect-passphrase",
+			privateKey: testSshPrivateKey(t, edKey, passphrase),
+			passphrase: []byte("wrong"),
+			wantErr:    errors.PrivateKeyPassphraseIncorrect,
+		},
+		{
+			name:       "unneeded-passphrase",
+			privateKey: testSshPrivateKey(t, ecKey, nil),
+			passphrase: passphrase,
+			wantErr:    errors.PrivateKeyPassphraseUnneeded,
+		},
+		{
+			name:       "invalid-private-key",
+			privateKey: PrivateKey("not a key"),
+			wantErr:    errors.InvalidParameter,
+		},
+		{
+			name:    "missing-private-key",
+			wantErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := SshPrivateKeySigner(ctx, tt.privateKey, tt.passphrase)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.wantPub, got.Public())
+
+			// The signer must be usable for ssh authentication.
+			_, err = ssh.NewSignerFromSigner(got)
+			assert.NoError(err)
+		})
+	}
+}
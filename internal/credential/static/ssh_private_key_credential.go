@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -42,18 +41,13 @@ func NewSshPrivateKeyCredential(
 
 	opts := getOpts(opt...)
 	if len(privateKey) != 0 {
-		var err error
-		if len(opts.withPrivateKeyPassphrase) == 0 {
-			_, err = ssh.ParsePrivateKey(privateKey)
-		} else {
-			_, err = ssh.ParsePrivateKeyWithPassphrase(privateKey, opts.withPrivateKeyPassphrase)
-		}
+		_, err := credential.SshPrivateKeySigner(ctx, privateKey, opts.withPrivateKeyPassphrase)
 		switch {
 		case err == nil:
-		case err.Error() == (&ssh.PassphraseMissingError{}).Error():
+		case errors.Match(errors.T(errors.PrivateKeyPassphraseMissing), err):
 			// This is okay, if it's brokered and the client can use it, no worries
 		default:
-			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
 
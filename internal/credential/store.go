@@ -52,6 +52,35 @@ type StoreListQueryResult struct {
 	ClientCert []byte
 	// Optional client cert key HMAC of the credential store.
 	ClientCertKeyHmac []byte
+	// Optional Vault auth method of the credential store.
+	AuthMethod string
+	// Optional mount path of the Vault auth method of the credential store.
+	AuthMountPath string
+	// Optional AppRole role id of the credential store.
+	ApproleRoleId string
+	// Optional AppRole secret id HMAC of the credential store.
+	ApproleSecretIdHmac []byte
+	// Optional Kubernetes role of the credential store.
+	KubernetesRole string
+	// Optional Kubernetes service account token HMAC of the credential store.
+	KubernetesJwtHmac []byte
+	// Optional health status of the credential store.
+	HealthStatus string
+	// Optionally specifies whether Vault was reachable during the last
+	// health check of the credential store. Nil if it has not been checked.
+	HealthVaultReachable *bool
+	// Optional expiration time of the token of the credential store.
+	HealthTokenExpirationTime *timestamp.Timestamp
+	// Optional capabilities missing from the token of the credential store.
+	HealthMissingCapabilities string
+	// Optional error from the last health check of the credential store.
+	HealthLastCheckError string
+	// Optional time of the last health check of the credential store.
+	HealthLastCheckTime *timestamp.Timestamp
+	// Optional error from the last token renewal of the credential store.
+	HealthLastRenewalError string
+	// Optional time of the last token renewal error of the credential store.
+	HealthLastRenewalErrorTime *timestamp.Timestamp
 	// The subtype of the credential store.
 	Subtype string
 }
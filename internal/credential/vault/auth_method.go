@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	vault "github.com/hashicorp/vault/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuthMethodType is the Vault auth method a credential store uses to obtain
+// a Vault token.
+type AuthMethodType string
+
+// AuthMethodType values.
+const (
+	AppRoleAuthMethod    AuthMethodType = "approle"
+	KubernetesAuthMethod AuthMethodType = "kubernetes"
+)
+
+// AuthMethod contains the Vault auth method and the credentials a
+// credential store uses to log in to Vault. A credential store with an
+// AuthMethod logs in to Vault to obtain a new token when its current token
+// can no longer be renewed. It is owned by a credential store.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string `gorm:"-"`
+}
+
+// NewAppRoleAuthMethod creates a new in memory AuthMethod for the AppRole
+// auth method. WithMountPath is the only valid option, the mount path
+// defaults to "approle". Both roleId and secretId are required to create a
+// credential store with the AuthMethod.
+func NewAppRoleAuthMethod(roleId string, secretId AuthSecret, opt ...Option) (*AuthMethod, error) {
+	opts := getOpts(opt...)
+	am := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			AuthMethod: string(AppRoleAuthMethod),
+			MountPath:  opts.withMountPath,
+			RoleId:     roleId,
+			SecretId:   copyBytes(secretId),
+		},
+	}
+	am.setDefaultMountPath()
+	return am, nil
+}
+
+// NewKubernetesAuthMethod creates a new in memory AuthMethod for the
+// Kubernetes auth method. Both role and jwt, the service account token used
+// to log in, are required to create a credential store with the AuthMethod.
+// WithMountPath is the only valid option, the mount path defaults to
+// "kubernetes".
+func NewKubernetesAuthMethod(role string, jwt AuthSecret, opt ...Option) (*AuthMethod, error) {
+	opts := getOpts(opt...)
+	am := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			AuthMethod:     string(KubernetesAuthMethod),
+			MountPath:      opts.withMountPath,
+			KubernetesRole: role,
+			Jwt:            copyBytes(jwt),
+		},
+	}
+	am.setDefaultMountPath()
+	return am, nil
+}
+
+func allocAuthMethod() *AuthMethod {
+	return &AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+func (am *AuthMethod) clone() *AuthMethod {
+	cp := proto.Clone(am.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// Type returns the type of Vault auth method.
+func (am *AuthMethod) Type() AuthMethodType {
+	return AuthMethodType(am.AuthMethod.GetAuthMethod())
+}
+
+func (am *AuthMethod) setDefaultMountPath() {
+	if am.MountPath == "" {
+		am.MountPath = am.AuthMethod.GetAuthMethod()
+	}
+}
+
+// resolveType sets the type of am from the fields am contains after an
+// update of orig. If am contains the fields of both auth methods, the fields
+// of the auth method of orig are removed. The type is set to "" if am does
+// not contain the fields of either auth method.
+func (am *AuthMethod) resolveType(orig *AuthMethod) {
+	hasAppRole := am.RoleId != "" || len(am.SecretId) > 0
+	hasKubernetes := am.KubernetesRole != "" || len(am.Jwt) > 0
+	if hasAppRole && hasKubernetes && orig != nil {
+		switch orig.Type() {
+		case AppRoleAuthMethod:
+			am.RoleId, am.SecretId = "", nil
+			hasAppRole = false
+		case KubernetesAuthMethod:
+			am.KubernetesRole, am.Jwt = "", nil
+			hasKubernetes = false
+		}
+	}
+	switch {
+	case hasAppRole && hasKubernetes:
+		// leave the type unchanged so validate reports the conflict
+		if am.AuthMethod.GetAuthMethod() == "" {
+			am.AuthMethod.AuthMethod = string(AppRoleAuthMethod)
+		}
+	case hasAppRole:
+		am.AuthMethod.AuthMethod = string(AppRoleAuthMethod)
+	case hasKubernetes:
+		am.AuthMethod.AuthMethod = string(KubernetesAuthMethod)
+	default:
+		am.AuthMethod.AuthMethod = ""
+		return
+	}
+	if orig != nil && orig.Type() != am.Type() && am.MountPath == string(orig.Type()) {
+		// The mount path of orig was the default for its auth method.
+		am.MountPath = ""
+	}
+	am.setDefaultMountPath()
+}
+
+// TableName returns the table name.
+func (am *AuthMethod) TableName() string {
+	if am.tableName != "" {
+		return am.tableName
+	}
+	return "credential_vault_store_auth_method"
+}
+
+// SetTableName sets the table name.
+func (am *AuthMethod) SetTableName(n string) {
+	am.tableName = n
+}
+
+// validate checks that am contains the fields required by its auth method.
+func (am *AuthMethod) validate(ctx context.Context, op errors.Op) error {
+	switch am.Type() {
+	case AppRoleAuthMethod:
+		if am.RoleId == "" || len(am.SecretId) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "approle auth method requires a role id and a secret id")
+		}
+		if am.KubernetesRole != "" || len(am.Jwt) > 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "approle auth method cannot contain kubernetes fields")
+		}
+	case KubernetesAuthMethod:
+		if am.KubernetesRole == "" || len(am.Jwt) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "kubernetes auth method requires a role and a jwt")
+		}
+		if am.RoleId != "" || len(am.SecretId) > 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "kubernetes auth method cannot contain approle fields")
+		}
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown auth method: %q", am.AuthMethod.GetAuthMethod()))
+	}
+	if strings.TrimSpace(am.MountPath) == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no mount path")
+	}
+	return nil
+}
+
+// loginData returns the path of the login endpoint of am and the data to
+// send to it.
+func (am *AuthMethod) loginData(ctx context.Context) (string, map[string]any, error) {
+	const op = "vault.(AuthMethod).loginData"
+	path := fmt.Sprintf("auth/%s/login", strings.Trim(am.MountPath, "/"))
+	switch am.Type() {
+	case AppRoleAuthMethod:
+		return path, map[string]any{
+			"role_id":   am.RoleId,
+			"secret_id": string(am.SecretId),
+		}, nil
+	case KubernetesAuthMethod:
+		if len(am.Jwt) == 0 {
+			return "", nil, errors.New(ctx, errors.InvalidParameter, op, "kubernetes auth method requires a jwt")
+		}
+		return path, map[string]any{
+			"role": am.KubernetesRole,
+			"jwt":  strings.TrimSpace(string(am.Jwt)),
+		}, nil
+	default:
+		return "", nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown auth method: %q", am.AuthMethod.GetAuthMethod()))
+	}
+}
+
+// login logs in to Vault with am using vc and returns the new token.
+func (am *AuthMethod) login(ctx context.Context, vc vaultClient) (*vault.Secret, error) {
+	const op = "vault.(AuthMethod).login"
+	path, data, err := am.loginData(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, err := vc.login(ctx, path, data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if s == nil || s.Auth == nil || s.Auth.ClientToken == "" {
+		return nil, errors.New(ctx, errors.VaultEmptySecret, op, "vault login did not return a token")
+	}
+	return s, nil
+}
+
+func (am *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(AuthMethod).encrypt"
+	am.CtSecretId, am.SecretIdHmac, am.CtJwt, am.JwtHmac = nil, nil, nil, nil
+	if len(am.SecretId) == 0 && len(am.Jwt) == 0 {
+		am.KeyId = ""
+		return nil
+	}
+	var err error
+	if len(am.SecretId) > 0 {
+		if am.CtSecretId, am.SecretIdHmac, err = am.encryptSecret(ctx, cipher, am.SecretId); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("secret id"))
+		}
+	}
+	if len(am.Jwt) > 0 {
+		if am.CtJwt, am.JwtHmac, err = am.encryptSecret(ctx, cipher, am.Jwt); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("jwt"))
+		}
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	am.KeyId = keyId
+	return nil
+}
+
+func (am *AuthMethod) encryptSecret(ctx context.Context, cipher wrapping.Wrapper, secret []byte) (ct []byte, hmac []byte, err error) {
+	const op = "vault.(AuthMethod).encryptSecret"
+	type pas struct {
+		Secret   []byte `wrapping:"pt,auth_secret_data"`
+		CtSecret []byte `wrapping:"ct,auth_secret_data"`
+	}
+	v := &pas{
+		Secret: secret,
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, v, nil); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	hm, err := crypto.HmacSha256(ctx, secret, cipher, []byte(am.StoreId), nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return v.CtSecret, []byte(hm), nil
+}
+
+func (am *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(AuthMethod).decrypt"
+	type pas struct {
+		Secret   []byte `wrapping:"pt,auth_secret_data"`
+		CtSecret []byte `wrapping:"ct,auth_secret_data"`
+	}
+	if len(am.CtSecretId) > 0 {
+		v := &pas{CtSecret: am.CtSecretId}
+		if err := structwrapping.UnwrapStruct(ctx, cipher, v, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("secret id"))
+		}
+		am.SecretId = v.Secret
+	}
+	if len(am.CtJwt) > 0 {
+		v := &pas{CtSecret: am.CtJwt}
+		if err := structwrapping.UnwrapStruct(ctx, cipher, v, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("jwt"))
+		}
+		am.Jwt = v.Secret
+	}
+	return nil
+}
+
+// clearSecrets removes the plain-text and encrypted secrets from am.
+func (am *AuthMethod) clearSecrets() {
+	am.SecretId, am.CtSecretId = nil, nil
+	am.Jwt, am.CtJwt = nil, nil
+}
+
+func (am *AuthMethod) insertQuery() (query string, queryValues []any) {
+	query = upsertAuthMethodQuery
+	queryValues = []any{
+		sql.Named("store_id", am.StoreId),
+		sql.Named("auth_method", am.AuthMethod.GetAuthMethod()),
+		sql.Named("mount_path", am.MountPath),
+		sql.Named("role_id", nullString(am.RoleId)),
+		sql.Named("secret_id", nullableBytes(am.CtSecretId)),
+		sql.Named("secret_id_hmac", nullableBytes(am.SecretIdHmac)),
+		sql.Named("kubernetes_role", nullString(am.KubernetesRole)),
+		sql.Named("jwt", nullableBytes(am.CtJwt)),
+		sql.Named("jwt_hmac", nullableBytes(am.JwtHmac)),
+		sql.Named("key_id", nullString(am.KeyId)),
+	}
+	return
+}
+
+func (am *AuthMethod) deleteQuery() (query string, queryValues []any) {
+	query = deleteAuthMethodQuery
+	queryValues = []any{
+		am.StoreId,
+	}
+	return
+}
+
+func (am *AuthMethod) oplogMessage(opType db.OpType) *oplog.Message {
+	cp := am.clone()
+	cp.SecretId, cp.Jwt = nil, nil
+	msg := oplog.Message{
+		Message:  cp,
+		TypeName: am.TableName(),
+	}
+	switch opType {
+	case db.CreateOp, db.UpdateOp:
+		msg.OpType = oplog.OpType_OP_TYPE_CREATE
+	case db.DeleteOp:
+		msg.OpType = oplog.OpType_OP_TYPE_DELETE
+	}
+	return &msg
+}
+
+func nullableBytes(b []byte) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+// lookupAuthMethod returns the decrypted AuthMethod of the credential store
+// with storeId. It returns nil, nil if the credential store does not have
+// an auth method.
+func lookupAuthMethod(ctx context.Context, r db.Reader, cipher wrapping.Wrapper, storeId string) (*AuthMethod, error) {
+	const op = "vault.lookupAuthMethod"
+	am := allocAuthMethod()
+	if err := r.LookupWhere(ctx, am, "store_id = ?", []any{storeId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := am.decrypt(ctx, cipher); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return am, nil
+}
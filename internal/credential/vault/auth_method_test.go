@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type loginTestClient struct {
+	vaultClient
+	path   string
+	data   map[string]any
+	secret *vault.Secret
+	err    error
+}
+
+func (c *loginTestClient) login(_ context.Context, path string, data map[string]any) (*vault.Secret, error) {
+	c.path, c.data = path, data
+	return c.secret, c.err
+}
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	t.Run("approle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewAppRoleAuthMethod("role-id", AuthSecret("secret-id"))
+		require.NoError(err)
+		assert.Equal(AppRoleAuthMethod, am.Type())
+		assert.Equal("approle", am.GetMountPath())
+		assert.Equal("role-id", am.GetRoleId())
+		assert.Equal([]byte("secret-id"), am.GetSecretId())
+	})
+	t.Run("kubernetes-with-mount-path", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", nil, WithMountPath("k8s/prod"))
+		require.NoError(err)
+		assert.Equal(KubernetesAuthMethod, am.Type())
+		assert.Equal("k8s/prod", am.GetMountPath())
+		assert.Equal("role", am.GetKubernetesRole())
+		assert.Empty(am.GetJwt())
+	})
+}
+
+func TestAuthMethod_validate(t *testing.T) {
+	t.Parallel()
+	approle := func(roleId, secretId string) *AuthMethod {
+		am, _ := NewAppRoleAuthMethod(roleId, AuthSecret(secretId))
+		return am
+	}
+	kubernetes := func(role, jwt string) *AuthMethod {
+		am, _ := NewKubernetesAuthMethod(role, AuthSecret(jwt))
+		return am
+	}
+	tests := []struct {
+		name    string
+		am      *AuthMethod
+		wantErr bool
+	}{
+		{name: "valid-approle", am: approle("role-id", "secret-id")},
+		{name: "approle-missing-role-id", am: approle("", "secret-id"), wantErr: true},
+		{name: "approle-missing-secret-id", am: approle("role-id", ""), wantErr: true},
+		{name: "valid-kubernetes", am: kubernetes("role", "jwt")},
+		{name: "kubernetes-missing-role", am: kubernetes("", "jwt"), wantErr: true},
+		{name: "kubernetes-missing-jwt", am: kubernetes("role", ""), wantErr: true},
+		{
+			name: "kubernetes-with-approle-fields",
+			am: func() *AuthMethod {
+				am := kubernetes("role", "jwt")
+				am.RoleId = "role-id"
+				return am
+			}(),
+			wantErr: true,
+		},
+		{name: "unknown", am: allocAuthMethod(), wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.am.validate(context.Background(), "test")
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthMethod_resolveType(t *testing.T) {
+	t.Parallel()
+	t.Run("approle-to-kubernetes", func(t *testing.T) {
+		assert := assert.New(t)
+		orig, _ := NewAppRoleAuthMethod("role-id", AuthSecret("secret-id"))
+		am := orig.clone()
+		am.KubernetesRole = "role"
+		am.resolveType(orig)
+		assert.Equal(KubernetesAuthMethod, am.Type())
+		assert.Equal("kubernetes", am.GetMountPath())
+		assert.Empty(am.GetRoleId())
+		assert.Empty(am.GetSecretId())
+	})
+	t.Run("keeps-custom-mount-path", func(t *testing.T) {
+		assert := assert.New(t)
+		orig, _ := NewKubernetesAuthMethod("role", nil, WithMountPath("custom"))
+		am := orig.clone()
+		am.RoleId, am.SecretId = "role-id", []byte("secret-id")
+		am.resolveType(orig)
+		assert.Equal(AppRoleAuthMethod, am.Type())
+		assert.Equal("custom", am.GetMountPath())
+		assert.Empty(am.GetKubernetesRole())
+	})
+	t.Run("all-fields-removed", func(t *testing.T) {
+		orig, _ := NewAppRoleAuthMethod("role-id", AuthSecret("secret-id"))
+		am := orig.clone()
+		am.RoleId, am.SecretId = "", nil
+		am.resolveType(orig)
+		assert.Equal(t, AuthMethodType(""), am.Type())
+	})
+}
+
+func TestAuthMethod_login(t *testing.T) {
+	ctx := context.Background()
+	t.Run("approle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewAppRoleAuthMethod("role-id", AuthSecret("secret-id"), WithMountPath("/approle/prod/"))
+		require.NoError(err)
+		c := &loginTestClient{secret: &vault.Secret{Auth: &vault.SecretAuth{ClientToken: "token"}}}
+		s, err := am.login(ctx, c)
+		require.NoError(err)
+		assert.Equal("token", s.Auth.ClientToken)
+		assert.Equal("auth/approle/prod/login", c.path)
+		assert.Equal(map[string]any{"role_id": "role-id", "secret_id": "secret-id"}, c.data)
+	})
+	t.Run("kubernetes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("sa-jwt\n"))
+		require.NoError(err)
+		c := &loginTestClient{secret: &vault.Secret{Auth: &vault.SecretAuth{ClientToken: "token"}}}
+		_, err = am.login(ctx, c)
+		require.NoError(err)
+		assert.Equal("auth/kubernetes/login", c.path)
+		assert.Equal(map[string]any{"role": "role", "jwt": "sa-jwt"}, c.data)
+	})
+	t.Run("kubernetes-without-jwt", func(t *testing.T) {
+		// The controller's own service account token is never used in
+		// place of a missing jwt.
+		am, err := NewKubernetesAuthMethod("role", nil)
+		require.NoError(t, err)
+		c := &loginTestClient{secret: &vault.Secret{Auth: &vault.SecretAuth{ClientToken: "token"}}}
+		_, err = am.login(ctx, c)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		assert.Empty(t, c.path, "vault was not contacted")
+	})
+	t.Run("no-token-returned", func(t *testing.T) {
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"))
+		require.NoError(t, err)
+		_, err = am.login(ctx, &loginTestClient{secret: &vault.Secret{}})
+		assert.Truef(t, errors.Match(errors.T(errors.VaultEmptySecret), err), "want err code: %q got: %q", errors.VaultEmptySecret, err)
+	})
+}
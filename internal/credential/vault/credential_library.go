@@ -37,8 +37,8 @@ type CredentialLibrary struct {
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, credential type, KV version, and
+// mapping override are the only valid options. All other options are ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
@@ -53,6 +53,7 @@ func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*Cre
 			HttpRequestBody: opts.withRequestBody,
 			HttpMethod:      string(opts.withMethod),
 			CredentialType:  string(opts.withCredentialType),
+			KvVersion:       opts.withKvVersion,
 		},
 	}
 
@@ -63,6 +64,10 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	switch {
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
+	case l.KvVersion != "" && !ValidKvVersion(l.KvVersion):
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid kv version: %s", l.KvVersion))
+	case l.KvVersion != "" && Method(l.HttpMethod) != MethodGet:
+		return errors.New(ctx, errors.InvalidParameter, caller, "kv version can only be set if http method is GET")
 	}
 	return nil
 }
@@ -147,6 +152,7 @@ type listCredentialLibraryResult struct {
 	CredentialType            string
 	HttpMethod                string
 	HttpRequestBody           string
+	KvVersion                 string
 	Username                  string
 	KeyType                   string
 	Ttl                       string
@@ -177,6 +183,7 @@ func (l *listCredentialLibraryResult) toLibrary(ctx context.Context) (credential
 				VaultPath:      l.VaultPath,
 				CredentialType: l.CredentialType,
 				HttpMethod:     l.HttpMethod,
+				KvVersion:      l.KvVersion,
 			},
 		}
 		// Assign byte slices only if the string isn't empty
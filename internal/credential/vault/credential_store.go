@@ -21,22 +21,27 @@ type CredentialStore struct {
 	tableName string `gorm:"-"`
 
 	clientCert  *ClientCertificate `gorm:"-"`
+	authMethod  *AuthMethod        `gorm:"-"`
 	inputToken  TokenSecret        `gorm:"-"`
 	outputToken *Token             `gorm:"-"`
 
 	privateClientCert *ClientCertificate `gorm:"-"`
 	privateToken      *Token             `gorm:"-"`
+
+	health *StoreHealth `gorm:"-"`
 }
 
 // NewCredentialStore creates a new in memory CredentialStore for a Vault
 // server at vaultAddress assigned to projectId. Name, description, CA cert,
-// client cert, namespace, TLS server name, worker filter, and TLS skip verify are the
-// only valid options. All other options are ignored.
+// client cert, auth method, namespace, TLS server name, worker filter, and TLS skip verify
+// are the only valid options. All other options are ignored. token can be empty if an
+// auth method is provided.
 func NewCredentialStore(projectId string, vaultAddress string, token TokenSecret, opt ...Option) (*CredentialStore, error) {
 	opts := getOpts(opt...)
 	cs := &CredentialStore{
 		inputToken: token,
 		clientCert: opts.withClientCert,
+		authMethod: opts.withAuthMethod,
 		CredentialStore: &store.CredentialStore{
 			ProjectId:     projectId,
 			Name:          opts.withName,
@@ -65,10 +70,15 @@ func (cs *CredentialStore) clone() *CredentialStore {
 	if cs.clientCert != nil {
 		clientCertCopy = cs.clientCert.clone()
 	}
+	var authMethodCopy *AuthMethod
+	if cs.authMethod != nil {
+		authMethodCopy = cs.authMethod.clone()
+	}
 	cp := proto.Clone(cs.CredentialStore)
 	return &CredentialStore{
 		inputToken:      tokenCopy,
 		clientCert:      clientCertCopy,
+		authMethod:      authMethodCopy,
 		CredentialStore: cp.(*store.CredentialStore),
 	}
 }
@@ -117,11 +127,45 @@ func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []st
 			cp.inputToken = new.inputToken
 		case strings.EqualFold(workerFilterField, f):
 			cp.WorkerFilter = new.WorkerFilter
+		case strings.EqualFold(mountPathField, f):
+			cp.authMethodForUpdate(cs.GetPublicId()).MountPath = new.authMethodForRead().GetMountPath()
+		case strings.EqualFold(roleIdField, f):
+			cp.authMethodForUpdate(cs.GetPublicId()).RoleId = new.authMethodForRead().GetRoleId()
+		case strings.EqualFold(secretIdField, f):
+			cp.authMethodForUpdate(cs.GetPublicId()).SecretId = new.authMethodForRead().GetSecretId()
+		case strings.EqualFold(kubernetesRoleField, f):
+			cp.authMethodForUpdate(cs.GetPublicId()).KubernetesRole = new.authMethodForRead().GetKubernetesRole()
+		case strings.EqualFold(jwtField, f):
+			cp.authMethodForUpdate(cs.GetPublicId()).Jwt = new.authMethodForRead().GetJwt()
+		}
+	}
+	if cp.authMethod != nil {
+		cp.authMethod.resolveType(cs.authMethod)
+		if cp.authMethod.Type() == "" {
+			cp.authMethod = nil
 		}
 	}
 	return cp
 }
 
+// authMethodForUpdate returns the auth method of cs, allocating an empty
+// auth method for storeId if cs does not have one.
+func (cs *CredentialStore) authMethodForUpdate(storeId string) *AuthMethod {
+	if cs.authMethod == nil {
+		cs.authMethod = allocAuthMethod()
+		cs.authMethod.StoreId = storeId
+	}
+	return cs.authMethod
+}
+
+// authMethodForRead returns the embedded store.AuthMethod of cs or nil.
+func (cs *CredentialStore) authMethodForRead() *store.AuthMethod {
+	if cs.authMethod == nil {
+		return nil
+	}
+	return cs.authMethod.AuthMethod
+}
+
 // TableName returns the table name.
 func (cs *CredentialStore) TableName() string {
 	if cs.tableName != "" {
@@ -178,6 +222,17 @@ func (cs *CredentialStore) ClientCertificate() *ClientCertificate {
 	return cs.clientCert
 }
 
+// AuthMethod returns the Vault auth method used to log in to Vault if
+// available.
+func (cs *CredentialStore) AuthMethod() *AuthMethod {
+	return cs.authMethod
+}
+
+// Health returns the results of the most recent health check if available.
+func (cs *CredentialStore) Health() *StoreHealth {
+	return cs.health
+}
+
 func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 	const op = "vault.(CredentialStore).client"
 	clientConfig := &clientConfig{
@@ -193,13 +248,37 @@ func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 		clientConfig.ClientKey = cs.clientCert.GetCertificateKey()
 	}
 
-	c, err := vaultClientFactoryFn(ctx, clientConfig, WithWorkerFilter(cs.WorkerFilter))
+	opts := []Option{WithWorkerFilter(cs.WorkerFilter)}
+	if len(cs.inputToken) == 0 && cs.authMethod != nil {
+		opts = append(opts, withLoginClient())
+	}
+	c, err := vaultClientFactoryFn(ctx, clientConfig, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	return c, nil
 }
 
+// login logs in to Vault with the auth method of cs and replaces the input
+// token of cs with the token returned by Vault.
+func (cs *CredentialStore) login(ctx context.Context) error {
+	const op = "vault.(CredentialStore).login"
+	if cs.authMethod == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "no auth method")
+	}
+	cs.inputToken = nil
+	c, err := cs.client(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	s, err := cs.authMethod.login(ctx, c)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+	}
+	cs.inputToken = TokenSecret(s.Auth.ClientToken)
+	return nil
+}
+
 func (cs *CredentialStore) softDeleteQuery() (query string, queryValues []any) {
 	query = softDeleteStoreQuery
 	queryValues = []any{
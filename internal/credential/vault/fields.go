@@ -11,6 +11,7 @@ const (
 	vaultPathField       = "VaultPath"
 	httpMethodField      = "HttpMethod"
 	httpRequestBodyField = "HttpRequestBody"
+	kvVersionField       = "KvVersion"
 
 	usernameField = "Username"
 	keyTypeField  = "KeyType"
@@ -37,6 +38,12 @@ const (
 	tokenField          = "Token"
 	workerFilterField   = "WorkerFilter"
 
+	mountPathField      = "MountPath"
+	roleIdField         = "RoleId"
+	secretIdField       = "SecretId"
+	kubernetesRoleField = "KubernetesRole"
+	jwtField            = "Jwt"
+
 	// MappingOverrideField represents the field mask indicating a mapping override
 	// update has been requested.
 	MappingOverrideField = "MappingOverride"
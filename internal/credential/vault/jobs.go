@@ -5,6 +5,8 @@ package vault
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	vault "github.com/hashicorp/vault/api"
 	ua "go.uber.org/atomic"
 )
@@ -25,6 +28,7 @@ const (
 	credentialRevocationJobName   = "vault_credential_revocation"
 	credentialStoreCleanupJobName = "vault_credential_store_cleanup"
 	credentialCleanupJobName      = "vault_credential_cleanup"
+	storeHealthJobName            = "vault_credential_store_health"
 
 	defaultNextRunIn = 5 * time.Minute
 	renewalWindow    = 10 * time.Minute
@@ -74,6 +78,13 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 	if err = scheduler.RegisterJob(ctx, credCleanup); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("credential cleanup job"))
 	}
+	storeHealth, err := newStoreHealthJob(ctx, r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, storeHealth); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential store health job"))
+	}
 	return nil
 }
 
@@ -162,10 +173,50 @@ func (r *TokenRenewalJob) Run(ctx context.Context) error {
 		}
 		if err := r.renewToken(ctx, s); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error renewing token", "credential store id", s.PublicId, "token status", s.TokenStatus))
+			if s.TokenStatus == string(CurrentToken) {
+				r.recordRenewalError(ctx, s.PublicId, err.Error())
+			}
 		}
 		r.numProcessed++
 	}
 
+	// Credential stores with an auth method whose current token expired
+	// before it could be replaced, for example because no controller was
+	// running, log in again to get a new current token.
+	var expired []*clientStore
+	err = r.reader.SearchWhere(ctx, &expired, `token_status = ? and public_id in (select store_id from credential_vault_store_auth_method)`, []any{ExpiredToken}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, s := range expired {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.loginExpiredStore(ctx, s); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error logging in to vault", "credential store id", s.PublicId))
+			r.recordRenewalError(ctx, s.PublicId, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (r *TokenRenewalJob) loginExpiredStore(ctx context.Context, s *clientStore) error {
+	const op = "vault.(TokenRenewalJob).loginExpiredStore"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err = s.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	vc, err := s.client(ctx, withLoginClient())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := r.login(ctx, s, databaseWrapper, vc); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
 	return nil
 }
 
@@ -204,9 +255,6 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		if numRows != 1 {
 			return errors.New(ctx, errors.Unknown, op, "token expired but failed to update repo")
 		}
-		if s.TokenStatus == string(CurrentToken) {
-			event.WriteSysEvent(ctx, op, "Vault credential store current token has expired", "credential store id", s.PublicId)
-		}
 
 		// Set credentials associated with this token to expired as Vault will already cascade delete them
 		_, err = r.writer.Exec(ctx, updateCredentialStatusByTokenQuery, []any{ExpiredCredential, token.TokenHmac})
@@ -214,9 +262,31 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 			return errors.Wrap(ctx, err, op, errors.WithMsg("error updating credentials to revoked after revoking token"))
 		}
 
+		if s.TokenStatus == string(CurrentToken) {
+			loggedIn, err := r.login(ctx, s, databaseWrapper, vc)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op, errors.WithMsg("vault token has expired"))
+			case !loggedIn:
+				event.WriteSysEvent(ctx, op, "Vault credential store current token has expired", "credential store id", s.PublicId)
+				r.recordRenewalError(ctx, s.PublicId, "vault token has expired")
+			}
+		}
+
 		return nil
 	}
 	if err != nil {
+		if s.TokenStatus == string(CurrentToken) {
+			// The token cannot be renewed, log in again if the store has an
+			// auth method.
+			loggedIn, lerr := r.login(ctx, s, databaseWrapper, vc)
+			switch {
+			case lerr != nil:
+				return errors.Wrap(ctx, lerr, op, errors.WithMsg(fmt.Sprintf("unable to renew vault token: %s", err.Error())))
+			case loggedIn:
+				return nil
+			}
+		}
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
 	}
 
@@ -235,9 +305,88 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		return errors.New(ctx, errors.Unknown, op, "token renewed but failed to update repo")
 	}
 
+	if s.TokenStatus == string(CurrentToken) {
+		if tokenExpires <= renewalWindow {
+			// The token has reached its max ttl and will expire before it
+			// can be renewed again, log in again if the store has an auth
+			// method.
+			loggedIn, err := r.login(ctx, s, databaseWrapper, vc)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case loggedIn:
+				return nil
+			}
+		}
+		if _, err := r.writer.Exec(ctx, clearStoreRenewalErrorQuery, []any{s.PublicId}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to clear store renewal error"))
+		}
+	}
+
 	return nil
 }
 
+// login logs in to Vault with the auth method of the credential store s and
+// stores the token returned by Vault as the current token of s. The
+// previous token of s is changed to maintaining by the database. login
+// returns false if s does not have an auth method.
+func (r *TokenRenewalJob) login(ctx context.Context, s *clientStore, cipher wrapping.Wrapper, vc vaultClient) (bool, error) {
+	const op = "vault.(TokenRenewalJob).login"
+	am, err := lookupAuthMethod(ctx, r.reader, cipher, s.PublicId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return false, nil
+	}
+
+	secret, err := am.login(ctx, vc)
+	if err != nil {
+		return true, errors.Wrap(ctx, err, op)
+	}
+	tokenExpires, err := secret.TokenTTL()
+	if err != nil {
+		return true, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token expiration"))
+	}
+	accessor, err := secret.TokenAccessor()
+	if err != nil {
+		return true, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token accessor"))
+	}
+	token, err := newToken(ctx, s.PublicId, TokenSecret(secret.Auth.ClientToken), []byte(accessor), tokenExpires)
+	if err != nil {
+		return true, errors.Wrap(ctx, err, op)
+	}
+	if err := token.encrypt(ctx, cipher); err != nil {
+		return true, errors.Wrap(ctx, err, op)
+	}
+	query, values := token.insertQuery()
+	numRows, err := r.writer.Exec(ctx, query, values)
+	if err != nil {
+		return true, errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return true, errors.New(ctx, errors.Unknown, op, "logged in to vault but failed to store token")
+	}
+	if _, err := r.writer.Exec(ctx, clearStoreRenewalErrorQuery, []any{s.PublicId}); err != nil {
+		return true, errors.Wrap(ctx, err, op, errors.WithMsg("unable to clear store renewal error"))
+	}
+	event.WriteSysEvent(ctx, op, "Vault credential store logged in to Vault to replace its token", "credential store id", s.PublicId, "auth method", string(am.Type()))
+	return true, nil
+}
+
+// recordRenewalError records msg as the last token renewal error in the
+// health status of the credential store with storeId.
+func (r *TokenRenewalJob) recordRenewalError(ctx context.Context, storeId string, msg string) {
+	const op = "vault.(TokenRenewalJob).recordRenewalError"
+	_, err := r.writer.Exec(ctx, recordStoreRenewalErrorQuery, []any{
+		sql.Named("store_id", storeId),
+		sql.Named("last_renewal_error", msg),
+	})
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error recording token renewal error", "credential store id", storeId))
+	}
+}
+
 // NextRunIn queries the vault credential repo to determine when the next token renewal job should run.
 func (r *TokenRenewalJob) NextRunIn(ctx context.Context) (time.Duration, error) {
 	const op = "vault.(TokenRenewalJob).NextRunIn"
@@ -960,3 +1109,180 @@ func (r *CredentialCleanupJob) Name() string {
 func (r *CredentialCleanupJob) Description() string {
 	return "Periodically deletes Vault credentials that are no longer attached to a session (have a null session_id) and are not active in Vault."
 }
+
+// StoreHealthJob is the recurring job that checks the health of Vault
+// credential stores. For each store that has not been deleted it checks that
+// Vault is reachable, looks up the store's current token to record when it
+// will expire, and verifies the token has the capabilities Boundary requires.
+// The results are recorded in the store's health status and a system event is
+// emitted whenever the status of a store changes. The StoreHealthJob is not
+// thread safe, an attempt to Run the job concurrently will result in an
+// JobAlreadyRunning error.
+type StoreHealthJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	limit        int
+	running      ua.Bool
+	numProcessed int
+	numStores    int
+}
+
+// newStoreHealthJob creates a new in-memory StoreHealthJob.
+//
+// WithLimit is the only supported option.
+func newStoreHealthJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*StoreHealthJob, error) {
+	const op = "vault.newStoreHealthJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &StoreHealthJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the store health job. Total is the
+// total number of stores to check. Completed is the number of stores already
+// checked.
+func (r *StoreHealthJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numStores,
+	}
+}
+
+// Run checks the health of all Vault credential stores that have not been
+// deleted, reading at most limit stores from the database at a time. Can not
+// be run in parallel, if Run is invoked while already running an error with
+// code JobAlreadyRunning will be returned.
+func (r *StoreHealthJob) Run(ctx context.Context) error {
+	const op = "vault.(StoreHealthJob).Run"
+	if !r.running.CompareAndSwap(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numStores for status report
+	r.numProcessed, r.numStores = 0, 0
+
+	// Page through the stores so every store is checked on each run, not
+	// only the first limit stores. credential_vault_store_client only
+	// contains stores that have not been deleted.
+	var lastId string
+	for {
+		var ps []*clientStore
+		if err := r.reader.SearchWhere(ctx, &ps, "public_id > ?", []any{lastId}, db.WithLimit(r.limit), db.WithOrder("public_id asc")); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		r.numStores += len(ps)
+		for _, s := range ps {
+			// Verify context is not done before checking next store
+			if err := ctx.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := r.checkStore(ctx, s); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error checking credential store health", "credential store id", s.PublicId))
+			}
+			r.numProcessed++
+		}
+		if r.limit < 1 || len(ps) < r.limit {
+			return nil
+		}
+		lastId = ps[len(ps)-1].PublicId
+	}
+}
+
+func (r *StoreHealthJob) checkStore(ctx context.Context, s *clientStore) error {
+	const op = "vault.(StoreHealthJob).checkStore"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err = s.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var h *StoreHealth
+	switch {
+	case len(s.Token) == 0:
+		// The store's token has expired, there is nothing to check.
+		h = allocStoreHealth()
+		h.StoreId = s.PublicId
+		h.Status = string(UnhealthyStore)
+		h.LastCheckError = "credential store does not have a current vault token"
+	default:
+		vc, err := s.client(ctx)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		h = checkStoreHealth(ctx, s.PublicId, vc)
+	}
+
+	prev := allocStoreHealth()
+	if err := r.reader.LookupWhere(ctx, prev, "store_id = ?", []any{s.PublicId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return errors.Wrap(ctx, err, op)
+		}
+		prev = nil
+	}
+	if prev != nil && prev.LastRenewalError != "" && h.Status == string(HealthyStore) {
+		// A failed renewal keeps the store degraded until the token is
+		// successfully renewed.
+		h.Status = string(DegradedStore)
+	}
+
+	query, values := h.upsertQuery()
+	if _, err := r.writer.Exec(ctx, query, values); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	if prev == nil || prev.Status != h.Status {
+		var prevStatus string
+		if prev != nil {
+			prevStatus = prev.Status
+		}
+		event.WriteSysEvent(ctx, op, "Vault credential store health status changed",
+			"credential store id", s.PublicId,
+			"previous status", prevStatus,
+			"status", h.Status,
+			"vault reachable", h.VaultReachable,
+			"missing capabilities", h.MissingCapabilities,
+			"error", h.LastCheckError)
+	}
+	return nil
+}
+
+// NextRunIn determine when the next store health job should run.
+func (r *StoreHealthJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *StoreHealthJob) Name() string {
+	return storeHealthJobName
+}
+
+// Description is the human readable description of the job.
+func (r *StoreHealthJob) Description() string {
+	return "Periodically checks Vault reachability, token expiration and token capabilities for Vault credential stores."
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// KvLatestVersion is the KV version of a credential library that reads the
+// current version of a KV version 2 secret.
+const KvLatestVersion = "latest"
+
+// ValidKvVersion reports if v is a valid KV version for a credential
+// library: KvLatestVersion or a positive integer.
+func ValidKvVersion(v string) bool {
+	if v == KvLatestVersion {
+		return true
+	}
+	n, err := strconv.ParseUint(v, 10, 63)
+	return err == nil && n > 0 && strconv.FormatUint(n, 10) == v
+}
+
+// kvSecretVersion is the version of a KV version 2 secret read from Vault.
+type kvSecretVersion struct {
+	path    string
+	version int64
+}
+
+// kvReadParams returns the query parameters used to read kvVersion of a
+// KV version 2 secret.
+func kvReadParams(kvVersion string) map[string][]string {
+	if kvVersion == "" || kvVersion == KvLatestVersion {
+		return nil
+	}
+	return map[string][]string{"version": {kvVersion}}
+}
+
+// unwrapKvSecret removes the KV version 2 response envelope from secret.
+// It returns the data of the secret and the version of the secret that was
+// read.
+func unwrapKvSecret(ctx context.Context, secret *vault.Secret) (map[string]any, int64, error) {
+	const op = "vault.unwrapKvSecret"
+	if secret == nil || secret.Data == nil {
+		return nil, 0, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
+	}
+	metadata, ok := secret.Data["metadata"].(map[string]any)
+	if !ok {
+		return nil, 0, errors.New(ctx, errors.VaultInvalidCredentialMapping, op, "secret is not a kv version 2 secret: missing metadata")
+	}
+	version, err := kvVersionNumber(metadata["version"])
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultInvalidCredentialMapping), errors.WithMsg("invalid kv secret version"))
+	}
+	data, ok := secret.Data["data"].(map[string]any)
+	if !ok || data == nil {
+		// Vault returns a nil data field for versions which have been
+		// deleted or destroyed.
+		return nil, 0, errors.New(ctx, errors.VaultEmptySecret, op, fmt.Sprintf("kv secret version %d has been deleted or destroyed", version))
+	}
+	return data, version, nil
+}
+
+func kvVersionNumber(v any) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// recordKvSecretVersion records that version of the KV version 2 secret at
+// path was issued to sessionId by libraryId. The session is flagged if the
+// version differs from the version of the secret previously issued by
+// libraryId. Both records are written in a single transaction. It returns
+// the version of the secret that was previously issued by libraryId or 0 if
+// the secret has not been issued before.
+func recordKvSecretVersion(ctx context.Context, w db.Writer, libraryId, sessionId string, s *kvSecretVersion) (int64, error) {
+	const op = "vault.recordKvSecretVersion"
+	var previous sql.NullInt64
+	_, err := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			previous = sql.NullInt64{}
+			rows, err := w.Query(ctx, upsertKvSecretVersionQuery, []any{
+				sql.Named("library_id", libraryId),
+				sql.Named("vault_path", s.path),
+				sql.Named("secret_version", s.version),
+				sql.Named("session_id", sessionId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			defer rows.Close()
+			if rows.Next() {
+				if err := rows.Scan(&previous); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rows.Close()
+
+			_, err = w.Exec(ctx, insertSessionKvSecretVersionQuery, []any{
+				sql.Named("session_id", sessionId),
+				sql.Named("library_id", libraryId),
+				sql.Named("vault_path", s.path),
+				sql.Named("secret_version", s.version),
+				sql.Named("previous_secret_version", previous),
+				sql.Named("secret_version_changed", previous.Valid && previous.Int64 != s.version),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return previous.Int64, nil
+}
+
+// SessionKvSecretVersion is the version of a KV version 2 secret issued to a
+// session by a Vault credential library.
+type SessionKvSecretVersion struct {
+	SessionId string `gorm:"primary_key"`
+	LibraryId string `gorm:"primary_key"`
+	// VaultPath is the path of the secret after templating.
+	VaultPath     string `gorm:"primary_key"`
+	SecretVersion int64
+	// PreviousSecretVersion is the version of the secret the library
+	// issued to the session before this one, or 0 if it had not been
+	// issued before.
+	PreviousSecretVersion int64 `gorm:"default:null"`
+	// SecretVersionChanged is true if SecretVersion differs from
+	// PreviousSecretVersion.
+	SecretVersionChanged bool
+	CreateTime           *timestamp.Timestamp
+}
+
+// TableName returns the table name for gorm.
+func (*SessionKvSecretVersion) TableName() string {
+	return "credential_vault_session_kv_secret_version"
+}
+
+// ListSessionKvSecretVersions returns the versions of the KV version 2
+// secrets issued to the session with sessionId, ordered by library id and
+// path. Returns an empty slice if no KV secrets were issued to the session.
+func (r *Repository) ListSessionKvSecretVersions(ctx context.Context, sessionId string, _ ...Option) ([]*SessionKvSecretVersion, error) {
+	const op = "vault.(Repository).ListSessionKvSecretVersions"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	var versions []*SessionKvSecretVersion
+	if err := r.reader.SearchWhere(ctx, &versions, "session_id = ?", []any{sessionId}, db.WithOrder("library_id, vault_path")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return versions, nil
+}
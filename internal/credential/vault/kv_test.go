@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidKvVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{in: "latest", want: true},
+		{in: "1", want: true},
+		{in: "42", want: true},
+		{in: "", want: false},
+		{in: "0", want: false},
+		{in: "-1", want: false},
+		{in: "01", want: false},
+		{in: "1.5", want: false},
+		{in: "LATEST", want: false},
+		{in: "current", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ValidKvVersion(tt.in))
+		})
+	}
+}
+
+func Test_kvReadParams(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, kvReadParams(""))
+	assert.Nil(t, kvReadParams(KvLatestVersion))
+	assert.Equal(t, map[string][]string{"version": {"3"}}, kvReadParams("3"))
+}
+
+func Test_unwrapKvSecret(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		in          *vault.Secret
+		wantData    map[string]any
+		wantVersion int64
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "nil-secret",
+			wantErrCode: errors.VaultEmptySecret,
+		},
+		{
+			name: "valid",
+			in: &vault.Secret{
+				Data: map[string]any{
+					"data": map[string]any{
+						"username": "user",
+						"password": "pass",
+					},
+					"metadata": map[string]any{
+						"created_time": "2024-01-01T00:00:00.000000Z",
+						"version":      json.Number("3"),
+					},
+				},
+			},
+			wantData: map[string]any{
+				"username": "user",
+				"password": "pass",
+			},
+			wantVersion: 3,
+		},
+		{
+			name: "not-kv-v2",
+			in: &vault.Secret{
+				Data: map[string]any{
+					"username": "user",
+					"password": "pass",
+				},
+			},
+			wantErrCode: errors.VaultInvalidCredentialMapping,
+		},
+		{
+			name: "invalid-version",
+			in: &vault.Secret{
+				Data: map[string]any{
+					"data": map[string]any{
+						"username": "user",
+					},
+					"metadata": map[string]any{
+						"version": "three",
+					},
+				},
+			},
+			wantErrCode: errors.VaultInvalidCredentialMapping,
+		},
+		{
+			name: "deleted-version",
+			in: &vault.Secret{
+				Data: map[string]any{
+					"data": nil,
+					"metadata": map[string]any{
+						"deletion_time": "2024-01-01T00:00:00.000000Z",
+						"version":       json.Number("2"),
+					},
+				},
+			},
+			wantErrCode: errors.VaultEmptySecret,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			data, version, err := unwrapKvSecret(context.Background(), tt.in)
+			if tt.wantErrCode != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "want err code: %q got: %q", tt.wantErrCode, err)
+				assert.Nil(data)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantData, data)
+			assert.Equal(tt.wantVersion, version)
+		})
+	}
+}
+
+func TestRecordKvSecretVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	lib := TestCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 1)[0]
+
+	type sessionVersion struct {
+		SecretVersion         int64
+		PreviousSecretVersion *int64
+		SecretVersionChanged  bool
+	}
+	lookup := func(sessionId string) *sessionVersion {
+		t.Helper()
+		rows, err := rw.Query(ctx, `
+select secret_version, previous_secret_version, secret_version_changed
+  from credential_vault_session_kv_secret_version
+ where session_id = ? and library_id = ?`, []any{sessionId, lib.GetPublicId()})
+		require.NoError(err)
+		defer rows.Close()
+		require.True(rows.Next())
+		var got sessionVersion
+		require.NoError(rows.Scan(&got.SecretVersion, &got.PreviousSecretVersion, &got.SecretVersionChanged))
+		return &got
+	}
+	prev := func(v int64) *int64 { return &v }
+
+	kv := &kvSecretVersion{path: "secret/data/app", version: 1}
+
+	s1 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	previous, err := recordKvSecretVersion(ctx, rw, lib.GetPublicId(), s1.GetPublicId(), kv)
+	require.NoError(err)
+	assert.Zero(previous)
+	assert.Equal(&sessionVersion{SecretVersion: 1}, lookup(s1.GetPublicId()))
+
+	// The same version is not flagged.
+	s2 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	previous, err = recordKvSecretVersion(ctx, rw, lib.GetPublicId(), s2.GetPublicId(), kv)
+	require.NoError(err)
+	assert.Equal(int64(1), previous)
+	assert.Equal(&sessionVersion{SecretVersion: 1, PreviousSecretVersion: prev(1)}, lookup(s2.GetPublicId()))
+
+	// A new version flags the session it is issued to.
+	kv.version = 2
+	s3 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	previous, err = recordKvSecretVersion(ctx, rw, lib.GetPublicId(), s3.GetPublicId(), kv)
+	require.NoError(err)
+	assert.Equal(int64(1), previous)
+	assert.Equal(&sessionVersion{SecretVersion: 2, PreviousSecretVersion: prev(1), SecretVersionChanged: true}, lookup(s3.GetPublicId()))
+
+	// The sessions the earlier versions were issued to are unchanged.
+	assert.False(lookup(s1.GetPublicId()).SecretVersionChanged)
+	assert.False(lookup(s2.GetPublicId()).SecretVersionChanged)
+
+	// Recording a version for a session that does not exist fails without
+	// recording the version for the library.
+	kv.version = 3
+	_, err = recordKvSecretVersion(ctx, rw, lib.GetPublicId(), "s_doesnotexist", kv)
+	require.Error(err)
+	s4 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	previous, err = recordKvSecretVersion(ctx, rw, lib.GetPublicId(), s4.GetPublicId(), kv)
+	require.NoError(err)
+	assert.Equal(int64(2), previous)
+}
+
+func TestRepository_ListSessionKvSecretVersions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	libs := TestCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 2)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	_, err = repo.ListSessionKvSecretVersions(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	s1 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	got, err := repo.ListSessionKvSecretVersions(ctx, s1.GetPublicId())
+	require.NoError(err)
+	assert.Empty(got)
+
+	_, err = recordKvSecretVersion(ctx, rw, libs[0].GetPublicId(), s1.GetPublicId(), &kvSecretVersion{path: "secret/data/app", version: 1})
+	require.NoError(err)
+
+	// The second session is issued a new version of the first library's
+	// secret and the first version of the second library's secret.
+	s2 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	_, err = recordKvSecretVersion(ctx, rw, libs[0].GetPublicId(), s2.GetPublicId(), &kvSecretVersion{path: "secret/data/app", version: 2})
+	require.NoError(err)
+	_, err = recordKvSecretVersion(ctx, rw, libs[1].GetPublicId(), s2.GetPublicId(), &kvSecretVersion{path: "secret/data/db", version: 5})
+	require.NoError(err)
+
+	got, err = repo.ListSessionKvSecretVersions(ctx, s1.GetPublicId())
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(libs[0].GetPublicId(), got[0].LibraryId)
+	assert.Equal(int64(1), got[0].SecretVersion)
+	assert.Zero(got[0].PreviousSecretVersion)
+	assert.False(got[0].SecretVersionChanged)
+
+	got, err = repo.ListSessionKvSecretVersions(ctx, s2.GetPublicId())
+	require.NoError(err)
+	require.Len(got, 2)
+	want := map[string]*SessionKvSecretVersion{
+		libs[0].GetPublicId(): {SessionId: s2.GetPublicId(), LibraryId: libs[0].GetPublicId(), VaultPath: "secret/data/app", SecretVersion: 2, PreviousSecretVersion: 1, SecretVersionChanged: true},
+		libs[1].GetPublicId(): {SessionId: s2.GetPublicId(), LibraryId: libs[1].GetPublicId(), VaultPath: "secret/data/db", SecretVersion: 5},
+	}
+	for _, v := range got {
+		assert.NotNil(v.CreateTime)
+		v.CreateTime = nil
+		assert.Equal(want[v.LibraryId], v)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	subsystem = "controller_credential_brokering"
+
+	labelCredentialStoreId = "credential_store_id"
+)
+
+var (
+	brokeringLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: subsystem,
+			Name:      "duration_seconds",
+			Help:      "Histogram of latencies for retrieving a credential from Vault, by credential store.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{labelCredentialStoreId},
+	)
+
+	brokeringFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: subsystem,
+			Name:      "failures_total",
+			Help:      "Count of failures to retrieve a credential from Vault, by credential store.",
+		},
+		[]string{labelCredentialStoreId},
+	)
+)
+
+// InitializeMetrics initializes the metrics for visibility into credential
+// brokering from Vault.
+func InitializeMetrics(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(
+		brokeringLatency,
+		brokeringFailures,
+	)
+}
+
+// recordBrokering records how long retrieving a credential from the
+// credential store took and whether it failed.
+func recordBrokering(storeId string, d time.Duration, err error) {
+	brokeringLatency.WithLabelValues(storeId).Observe(d.Seconds())
+	if err != nil {
+		brokeringFailures.WithLabelValues(storeId).Inc()
+	}
+}
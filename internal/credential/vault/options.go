@@ -28,9 +28,13 @@ type options struct {
 	withTlsSkipVerify  bool
 	withWorkerFilter   string
 	withClientCert     *ClientCertificate
+	withAuthMethod     *AuthMethod
+	withMountPath      string
+	withLoginClient    bool
 	withMethod         Method
 	withRequestBody    []byte
 	withCredentialType globals.CredentialType
+	withKvVersion      string
 
 	withOverrideUsernameAttribute             string
 	withOverridePasswordAttribute             string
@@ -122,6 +126,30 @@ func WithClientCert(clientCert *ClientCertificate) Option {
 	}
 }
 
+// WithAuthMethod provides an optional AuthMethod to use for logging in to
+// a Vault server.
+func WithAuthMethod(am *AuthMethod) Option {
+	return func(o *options) {
+		o.withAuthMethod = am
+	}
+}
+
+// WithMountPath provides an optional path an auth method is mounted at in
+// Vault.
+func WithMountPath(p string) Option {
+	return func(o *options) {
+		o.withMountPath = p
+	}
+}
+
+// withLoginClient allows a Vault client to be created without a token. The
+// client can only be used to log in to Vault.
+func withLoginClient() Option {
+	return func(o *options) {
+		o.withLoginClient = true
+	}
+}
+
 // WithMethod provides an optional Method to use for communicating with
 // Vault.
 func WithMethod(m Method) Option {
@@ -138,6 +166,15 @@ func WithRequestBody(b []byte) Option {
 	}
 }
 
+// WithKvVersion provides an optional KV version 2 secret version for a
+// credential library. The version is either KvLatestVersion or the version
+// of the secret to read.
+func WithKvVersion(v string) Option {
+	return func(o *options) {
+		o.withKvVersion = v
+	}
+}
+
 // WithCredentialType provides an optional credential type to associate
 // with a credential library.
 func WithCredentialType(t globals.CredentialType) Option {
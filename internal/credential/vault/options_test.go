@@ -94,6 +94,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRequestBody = []byte("body")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithKvVersion", func(t *testing.T) {
+		opts := getOpts(WithKvVersion(KvLatestVersion))
+		testOpts := getDefaultOptions()
+		testOpts.withKvVersion = KvLatestVersion
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithCredentialType", func(t *testing.T) {
 		opts := getOpts(WithCredentialType(globals.UsernamePasswordCredentialType))
 		testOpts := getDefaultOptions()
@@ -125,6 +131,19 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withOverridePrivateKeyPassphraseAttribute = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithAuthMethod", func(t *testing.T) {
+		am := &AuthMethod{}
+		opts := getOpts(WithAuthMethod(am))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthMethod = am
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithMountPath", func(t *testing.T) {
+		opts := getOpts(WithMountPath("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withMountPath = "test"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithMappingOverride", func(t *testing.T) {
 		opts := getOpts(WithMappingOverride(unknownMapper(1)))
 		testOpts := getDefaultOptions()
@@ -45,6 +45,7 @@ type baseCred struct {
 
 	lib        issuingCredentialLibrary
 	secretData map[string]any
+	kvSecret   *kvSecretVersion
 }
 
 func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
@@ -54,6 +55,8 @@ func (bc *baseCred) getExpiration() time.Duration  { return bc.expiration }
 func (bc *baseCred) getCredential() *Credential    { return bc.Credential }
 func (bc *baseCred) isRevokable() bool             { return bc.ExternalId != sentinel.ExternalIdNone }
 
+func (bc *baseCred) getKvSecretVersion() *kvSecretVersion { return bc.kvSecret }
+
 // convert converts bc to a specific credential type if bc is not
 // UnspecifiedType.
 func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
@@ -154,6 +157,15 @@ func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, err
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
 	}
 
+	if bc.Purpose() == credential.InjectedApplicationPurpose {
+		// Injected credentials are used by a worker which has no way to
+		// prompt for a passphrase, so the private key must be usable as is.
+		if _, err := credential.SshPrivateKeySigner(ctx, pk, pass); err != nil {
+			return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping), errors.WithWrap(err),
+				errors.WithMsg("private key cannot be used for injection"))
+		}
+	}
+
 	return &sshPrivateKeyCred{
 		baseCred:   bc,
 		username:   username,
@@ -191,6 +203,7 @@ type genericIssuingCredentialLibrary struct {
 	HttpMethod                    string
 	HttpRequestBody               []byte
 	CredType                      string
+	KvVersion                     string
 	ProjectId                     string
 	VaultAddress                  string
 	Namespace                     string
@@ -234,6 +247,7 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		KvVersion:                     pl.KvVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -303,6 +317,10 @@ type dynamicCred interface {
 	getExpiration() time.Duration
 	getCredential() *Credential
 	isRevokable() bool
+	// getKvSecretVersion returns the version of the KV version 2 secret
+	// the credential was read from or nil if the credential was not read
+	// from a KV version 2 secret.
+	getKvSecretVersion() *kvSecretVersion
 }
 
 // retrieveCredential retrieves a dynamic credential from Vault for the
@@ -358,7 +376,11 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 
 	switch Method(pl.HttpMethod) {
 	case MethodGet:
-		secret, reqErr = client.get(ctx, path)
+		if params := kvReadParams(pl.KvVersion); len(params) > 0 {
+			secret, reqErr = client.getWithParams(ctx, path, params)
+		} else {
+			secret, reqErr = client.get(ctx, path)
+		}
 	case MethodPost:
 		secret, reqErr = client.post(ctx, path, []byte(body))
 	default:
@@ -387,6 +409,17 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 		lib:        pl,
 		secretData: secret.Data,
 	}
+	if pl.KvVersion != "" {
+		data, version, err := unwrapKvSecret(ctx, secret)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("library: %s", pl.PublicId)))
+		}
+		dCred.secretData = data
+		dCred.kvSecret = &kvSecretVersion{
+			path:    path,
+			version: version,
+		}
+	}
 	return convert(ctx, dCred)
 }
 
@@ -472,6 +505,7 @@ type privateCredentialLibraryAllTypes struct {
 	HttpMethod                    string
 	HttpRequestBody               []byte
 	CredType                      string `gorm:"column:credential_type"`
+	KvVersion                     string
 	ProjectId                     string
 	VaultAddress                  string
 	Namespace                     string
@@ -563,6 +597,7 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		KvVersion:                     pl.KvVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -646,6 +681,7 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			VaultPath:                     pl.VaultPath,
 			HttpMethod:                    pl.HttpMethod,
 			HttpRequestBody:               pl.HttpRequestBody,
+			KvVersion:                     pl.KvVersion,
 			VaultAddress:                  pl.VaultAddress,
 			Namespace:                     pl.Namespace,
 			CaCert:                        pl.CaCert,
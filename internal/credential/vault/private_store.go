@@ -138,7 +138,7 @@ func (ps *clientStore) decrypt(ctx context.Context, cipher wrapping.Wrapper) err
 	return nil
 }
 
-func (ps *clientStore) client(ctx context.Context) (vaultClient, error) {
+func (ps *clientStore) client(ctx context.Context, opt ...Option) (vaultClient, error) {
 	const op = "vault.(clientStore).client"
 	clientConfig := &clientConfig{
 		Addr:          ps.VaultAddress,
@@ -154,7 +154,8 @@ func (ps *clientStore) client(ctx context.Context) (vaultClient, error) {
 		clientConfig.ClientKey = ps.ClientKey
 	}
 
-	client, err := vaultClientFactoryFn(ctx, clientConfig, WithWorkerFilter(ps.WorkerFilter))
+	opts := append([]Option{WithWorkerFilter(ps.WorkerFilter)}, opt...)
+	client, err := vaultClientFactoryFn(ctx, clientConfig, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create vault client"))
 	}
@@ -85,6 +85,29 @@ on conflict (store_id) do update
 returning *;
 `
 
+	upsertAuthMethodQuery = `
+insert into credential_vault_store_auth_method
+  (store_id, auth_method, mount_path, role_id, secret_id, secret_id_hmac, kubernetes_role, jwt, jwt_hmac, key_id)
+values
+  (@store_id, @auth_method, @mount_path, @role_id, @secret_id, @secret_id_hmac, @kubernetes_role, @jwt, @jwt_hmac, @key_id)
+on conflict (store_id) do update
+  set auth_method     = excluded.auth_method,
+      mount_path      = excluded.mount_path,
+      role_id         = excluded.role_id,
+      secret_id       = excluded.secret_id,
+      secret_id_hmac  = excluded.secret_id_hmac,
+      kubernetes_role = excluded.kubernetes_role,
+      jwt             = excluded.jwt,
+      jwt_hmac        = excluded.jwt_hmac,
+      key_id          = excluded.key_id
+returning *;
+`
+
+	deleteAuthMethodQuery = `
+delete from credential_vault_store_auth_method
+ where store_id = ?;
+`
+
 	deleteClientCertQuery = `
 delete from credential_vault_client_certificate
  where store_id = ?;
@@ -119,6 +142,61 @@ update credential_vault_token
  where token_hmac = ?;
 `
 
+	upsertStoreHealthQuery = `
+insert into credential_vault_store_health
+  (store_id, status, vault_reachable, token_expiration_time, missing_capabilities, last_check_error, last_check_time)
+values
+  (@store_id, @status, @vault_reachable, @token_expiration_time, @missing_capabilities, @last_check_error, now())
+on conflict (store_id) do update
+  set status                = excluded.status,
+      vault_reachable       = excluded.vault_reachable,
+      token_expiration_time = excluded.token_expiration_time,
+      missing_capabilities  = excluded.missing_capabilities,
+      last_check_error      = excluded.last_check_error,
+      last_check_time       = excluded.last_check_time;
+`
+
+	recordStoreRenewalErrorQuery = `
+insert into credential_vault_store_health
+  (store_id, status, vault_reachable, last_renewal_error, last_renewal_error_time)
+values
+  (@store_id, 'degraded', null, @last_renewal_error, now())
+on conflict (store_id) do update
+  set status                  = case credential_vault_store_health.status
+                                  when 'healthy' then 'degraded'
+                                  else credential_vault_store_health.status
+                                end,
+      last_renewal_error      = excluded.last_renewal_error,
+      last_renewal_error_time = excluded.last_renewal_error_time;
+`
+
+	clearStoreRenewalErrorQuery = `
+update credential_vault_store_health
+   set last_renewal_error      = null,
+       last_renewal_error_time = null
+ where store_id = ?
+   and last_renewal_error is not null;
+`
+
+	upsertKvSecretVersionQuery = `
+insert into credential_vault_library_kv_secret_version
+  (library_id, vault_path, secret_version, last_session_id)
+values
+  (@library_id, @vault_path, @secret_version, @session_id)
+on conflict (library_id, vault_path) do update
+  set previous_secret_version = credential_vault_library_kv_secret_version.secret_version,
+      secret_version          = excluded.secret_version,
+      last_session_id         = excluded.last_session_id
+returning previous_secret_version;
+`
+
+	insertSessionKvSecretVersionQuery = `
+insert into credential_vault_session_kv_secret_version
+  (session_id, library_id, vault_path, secret_version, previous_secret_version, secret_version_changed)
+values
+  (@session_id, @library_id, @vault_path, @secret_version, @previous_secret_version, @secret_version_changed);
+`
+
 	tokenRenewalNextRunInQuery = `
 select extract(epoch from (last_renewal_time + (expiration_time - last_renewal_time) / 2) - now())::int as renewal_in
   from credential_vault_token
@@ -230,6 +308,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         kv_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -253,6 +332,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as kv_version,        -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -301,6 +381,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         kv_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -324,6 +405,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as kv_version,        -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -372,6 +454,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         kv_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -395,6 +478,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as kv_version,        -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -444,6 +528,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         kv_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -467,6 +552,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as kv_version,        -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -43,5 +43,29 @@ func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreLis
 		s.clientCert.CertificateKeyHmac = result.ClientCertKeyHmac
 	}
 
+	if result.AuthMethod != "" {
+		s.authMethod = allocAuthMethod()
+		s.authMethod.StoreId = result.PublicId
+		s.authMethod.AuthMethod.AuthMethod = result.AuthMethod
+		s.authMethod.MountPath = result.AuthMountPath
+		s.authMethod.RoleId = result.ApproleRoleId
+		s.authMethod.SecretIdHmac = result.ApproleSecretIdHmac
+		s.authMethod.KubernetesRole = result.KubernetesRole
+		s.authMethod.JwtHmac = result.KubernetesJwtHmac
+	}
+
+	if result.HealthStatus != "" {
+		s.health = allocStoreHealth()
+		s.health.StoreId = result.PublicId
+		s.health.Status = result.HealthStatus
+		s.health.VaultReachable = result.HealthVaultReachable
+		s.health.TokenExpirationTime = result.HealthTokenExpirationTime
+		s.health.MissingCapabilities = result.HealthMissingCapabilities
+		s.health.LastCheckError = result.HealthLastCheckError
+		s.health.LastCheckTime = result.HealthLastCheckTime
+		s.health.LastRenewalError = result.HealthLastRenewalError
+		s.health.LastRenewalErrorTime = result.HealthLastRenewalErrorTime
+	}
+
 	return s, nil
 }
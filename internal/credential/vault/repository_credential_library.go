@@ -122,14 +122,17 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId stri
 // number of records updated. l is not changed.
 //
 // l must contain a valid PublicId. Only Name, Description, VaultPath,
-// HttpMethod, HttpRequestBody, and MappingOverride can be updated. If
-// l.Name is set to a non-empty string, it must be unique within l.StoreId.
+// HttpMethod, HttpRequestBody, KvVersion, and MappingOverride can be
+// updated. If l.Name is set to a non-empty string, it must be unique within
+// l.StoreId.
 //
 // An attribute of l will be set to NULL in the database if the attribute
 // in l is the zero value and it is included in fieldMaskPaths except for
 // HttpMethod.  If HttpMethod is in the fieldMaskPath but l.HttpMethod
 // is not set it will be set to the value "GET".  If storage has a value
 // for HttpRequestBody when l.HttpMethod is set to GET the update will fail.
+// The update will fail if the library would have a KvVersion and a
+// HttpMethod of POST, whether those values come from l or storage.
 func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialLibrary, int, error) {
 	const op = "vault.(Repository).UpdateCredentialLibrary"
 	if l == nil {
@@ -157,6 +160,10 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 		case strings.EqualFold(vaultPathField, f):
 		case strings.EqualFold(httpMethodField, f):
 		case strings.EqualFold(httpRequestBodyField, f):
+		case strings.EqualFold(kvVersionField, f):
+			if l.KvVersion != "" && !ValidKvVersion(l.KvVersion) {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid kv version: %s", l.KvVersion))
+			}
 		case strings.EqualFold(MappingOverrideField, f):
 			updateMappingOverride = true
 		default:
@@ -171,6 +178,7 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 			vaultPathField:       l.VaultPath,
 			httpMethodField:      l.HttpMethod,
 			httpRequestBodyField: l.HttpRequestBody,
+			kvVersionField:       l.KvVersion,
 			MappingOverrideField: l.MappingOverride,
 		},
 		fieldMaskPaths,
@@ -203,6 +211,23 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.VaultInvalidMappingOverride, op, "invalid mapping override for credential type")
 	}
 
+	// A KV version requires the GET method. Check the values the library
+	// will have after the update, taking those not being updated from
+	// storage.
+	method, kvVersion := origLib.HttpMethod, origLib.KvVersion
+	if strutil.StrListContains(dbMask, httpMethodField) {
+		method = l.HttpMethod
+	}
+	switch {
+	case strutil.StrListContains(dbMask, kvVersionField):
+		kvVersion = l.KvVersion
+	case strutil.StrListContains(nullFields, kvVersionField):
+		kvVersion = ""
+	}
+	if kvVersion != "" && Method(strings.ToUpper(method)) == MethodPost {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "kv version can only be set with the GET http method")
+	}
+
 	var filteredDbMask, filteredNullFields []string
 	for _, f := range dbMask {
 		switch {
@@ -363,6 +388,7 @@ type listLookupLibrary struct {
 	HttpMethod                    string
 	HttpRequestBody               []byte
 	CredentialType                string
+	KvVersion                     string
 	UsernameAttribute             string
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
@@ -386,6 +412,7 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 	cl.HttpMethod = pl.HttpMethod
 	cl.HttpRequestBody = pl.HttpRequestBody
 	cl.CredentialLibrary.CredentialType = pl.CredentialType
+	cl.KvVersion = pl.KvVersion
 
 	switch pl.CredentialType {
 	case string(globals.UsernamePasswordCredentialType):
@@ -1703,6 +1703,62 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdateCredentialLibrary_KvVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	create := func(t *testing.T, opt ...Option) *CredentialLibrary {
+		t.Helper()
+		in, err := NewCredentialLibrary(cs.GetPublicId(), "secret/data/app", opt...)
+		require.NoError(t, err)
+		got, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in)
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("kv-version-with-stored-post", func(t *testing.T) {
+		orig := create(t, WithMethod(MethodPost))
+		in := orig.clone()
+		in.KvVersion = KvLatestVersion
+		_, _, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), in, orig.Version, []string{kvVersionField})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	})
+	t.Run("post-with-stored-kv-version", func(t *testing.T) {
+		orig := create(t, WithKvVersion("3"))
+		in := orig.clone()
+		in.HttpMethod = string(MethodPost)
+		_, _, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), in, orig.Version, []string{httpMethodField})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	})
+	t.Run("post-clearing-kv-version", func(t *testing.T) {
+		orig := create(t, WithKvVersion("3"))
+		in := orig.clone()
+		in.HttpMethod = string(MethodPost)
+		in.KvVersion = ""
+		got, _, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), in, orig.Version, []string{httpMethodField, kvVersionField})
+		require.NoError(t, err)
+		assert.Equal(t, string(MethodPost), got.HttpMethod)
+		assert.Empty(t, got.KvVersion)
+	})
+	t.Run("kv-version-with-stored-get", func(t *testing.T) {
+		orig := create(t)
+		in := orig.clone()
+		in.KvVersion = "2"
+		got, _, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), in, orig.Version, []string{kvVersionField})
+		require.NoError(t, err)
+		assert.Equal(t, "2", got.KvVersion)
+	})
+}
+
 func TestRepository_LookupCredentialLibrary(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -23,9 +23,14 @@ import (
 // CredentialStore containing the credential store's PublicId. cs is not
 // changed. cs must not contain a PublicId. The PublicId is generated and
 // assigned by this method. cs must contain a valid ProjectId, VaultAddress,
-// and Vault token. The Vault token must be renewable, periodic, and
-// orphan. CreateCredentialStore calls the /auth/token/renew-self and
-// /auth/token/lookup-self Vault endpoints.
+// and either a Vault token or an AuthMethod. The Vault token must be
+// renewable, periodic, and orphan. CreateCredentialStore calls the
+// /auth/token/renew-self and /auth/token/lookup-self Vault endpoints.
+//
+// If cs contains an AuthMethod, CreateCredentialStore logs in to Vault with
+// the AuthMethod to obtain a token. The token must be renewable and orphan
+// but does not need to be periodic: the token renewal job logs in to Vault
+// again when the token can no longer be renewed.
 //
 // Both cs.Name and cs.Description are optional. If cs.Name is set, it must
 // be unique within cs.ProjectId. Both cs.CreateTime and cs.UpdateTime are
@@ -53,9 +58,12 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.ProjectId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
-	if len(cs.inputToken) == 0 {
+	if len(cs.inputToken) == 0 && cs.authMethod == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token")
 	}
+	if len(cs.inputToken) != 0 && cs.authMethod != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "vault token and auth method are mutually exclusive")
+	}
 	if cs.VaultAddress == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault address")
 	}
@@ -65,6 +73,11 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.clientCert != nil && len(cs.clientCert.CertificateKey) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "client certificate without private key")
 	}
+	if cs.authMethod != nil {
+		if err := cs.authMethod.validate(ctx, op); err != nil {
+			return nil, err
+		}
+	}
 
 	cs = cs.clone()
 
@@ -76,6 +89,12 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.clientCert != nil {
 		cs.clientCert.StoreId = id
 	}
+	if cs.authMethod != nil {
+		cs.authMethod.StoreId = id
+		if err := cs.login(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	client, err := cs.client(ctx)
 	if err != nil {
@@ -85,7 +104,7 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
 	}
-	if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+	if err := cs.validateTokenLookup(ctx, op, tokenLookup); err != nil {
 		return nil, err
 	}
 
@@ -142,13 +161,19 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	if cs.authMethod != nil {
+		if err := cs.authMethod.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	var newToken *Token
 	var newClientCertificate *ClientCertificate
+	var newAuthMethod *AuthMethod
 	var newCredentialStore *CredentialStore
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) error {
-			msgs := make([]*oplog.Message, 0, 3)
+			msgs := make([]*oplog.Message, 0, 4)
 			ticket, err := w.GetTicket(ctx, cs)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
@@ -193,6 +218,23 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 				newCredentialStore.clientCert = newClientCertificate
 
 			}
+
+			// insert auth method (if exists)
+			if cs.authMethod != nil {
+				newAuthMethod = cs.authMethod.clone()
+				query, values := newAuthMethod.insertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been created")
+				}
+				msgs = append(msgs, newAuthMethod.oplogMessage(db.CreateOp))
+
+				newAuthMethod.clearSecrets()
+				newCredentialStore.authMethod = newAuthMethod
+			}
 			metadata := cs.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -216,7 +258,29 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	return newCredentialStore, nil
 }
 
+// validateTokenLookup validates the token lookup of the token of cs. A
+// token obtained by logging in with the auth method of cs does not need to
+// be periodic.
+func (cs *CredentialStore) validateTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) error {
+	if cs.authMethod != nil {
+		return validateLoginTokenLookup(ctx, op, s)
+	}
+	return validateTokenLookup(ctx, op, s)
+}
+
 func validateTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) error {
+	if err := validateLoginTokenLookup(ctx, op, s); err != nil {
+		return err
+	}
+	if s.Data["period"] == nil {
+		return errors.E(ctx, errors.WithCode(errors.VaultTokenNotPeriodic), errors.WithOp(op))
+	}
+	return nil
+}
+
+// validateLoginTokenLookup validates a token obtained by logging in to
+// Vault with an auth method. The token must be renewable and orphan.
+func validateLoginTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) error {
 	if s.Data == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "vault secret is not a token lookup")
 	}
@@ -243,10 +307,6 @@ func validateTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) err
 		return errors.E(ctx, errors.WithCode(errors.VaultTokenNotOrphan), errors.WithOp(op))
 	}
 
-	if s.Data["period"] == nil {
-		return errors.E(ctx, errors.WithCode(errors.VaultTokenNotPeriodic), errors.WithOp(op))
-	}
-
 	return nil
 }
 
@@ -265,27 +325,39 @@ func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string,
 		}
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
 	}
-	return agg.toCredentialStore(), nil
+	cs := agg.toCredentialStore()
+	h, err := r.LookupStoreHealth(ctx, publicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs.health = h
+	return cs, nil
 }
 
 type listLookupStore struct {
-	PublicId          string `gorm:"primary_key"`
-	ProjectId         string
-	Name              string
-	Description       string
-	CreateTime        *timestamp.Timestamp
-	UpdateTime        *timestamp.Timestamp
-	Version           uint32
-	VaultAddress      string
-	Namespace         string
-	CaCert            []byte
-	TlsServerName     string
-	TlsSkipVerify     bool
-	WorkerFilter      string
-	TokenHmac         []byte
-	TokenStatus       string
-	ClientCert        []byte
-	ClientCertKeyHmac []byte
+	PublicId            string `gorm:"primary_key"`
+	ProjectId           string
+	Name                string
+	Description         string
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	Version             uint32
+	VaultAddress        string
+	Namespace           string
+	CaCert              []byte
+	TlsServerName       string
+	TlsSkipVerify       bool
+	WorkerFilter        string
+	TokenHmac           []byte
+	TokenStatus         string
+	ClientCert          []byte
+	ClientCertKeyHmac   []byte
+	AuthMethod          string
+	AuthMountPath       string
+	ApproleRoleId       string
+	ApproleSecretIdHmac []byte
+	KubernetesRole      string
+	KubernetesJwtHmac   []byte
 }
 
 func allocListLookupStore() *listLookupStore {
@@ -319,6 +391,18 @@ func (ps *listLookupStore) toCredentialStore() *CredentialStore {
 		cert.CertificateKeyHmac = ps.ClientCertKeyHmac
 		cs.clientCert = cert
 	}
+
+	if ps.AuthMethod != "" {
+		am := allocAuthMethod()
+		am.StoreId = ps.PublicId
+		am.AuthMethod.AuthMethod = ps.AuthMethod
+		am.MountPath = ps.AuthMountPath
+		am.RoleId = ps.ApproleRoleId
+		am.SecretIdHmac = ps.ApproleSecretIdHmac
+		am.KubernetesRole = ps.KubernetesRole
+		am.JwtHmac = ps.KubernetesJwtHmac
+		cs.authMethod = am
+	}
 	return cs
 }
 
@@ -335,11 +419,17 @@ func (ps *listLookupStore) GetPublicId() string { return ps.PublicId }
 //
 // cs must contain a valid PublicId. Only Name, Description, Namespace,
 // TlsServerName, TlsSkipVerify, CaCert, VaultAddress, ClientCertificate,
-// ClientCertificateKey, workerFilter, and Token can be changed. If cs.Name is set to a
-// non-empty string, it must be unique within cs.Projectid. If Token is changed,
-// the new token must have the same properties defined in CreateCredentialStore
-// and UpdateCredentialStore calls the same Vault endpoints described in
-// CreateCredentialStore.
+// ClientCertificateKey, workerFilter, Token, and the fields of the AuthMethod
+// can be changed. If cs.Name is set to a non-empty string, it must be unique
+// within cs.Projectid. If Token is changed, the new token must have the same
+// properties defined in CreateCredentialStore and UpdateCredentialStore calls
+// the same Vault endpoints described in CreateCredentialStore.
+//
+// If the updated credential store has an AuthMethod and either the
+// AuthMethod or the VaultAddress is changed, UpdateCredentialStore logs in
+// to Vault to obtain a new token. Token cannot be changed if the updated
+// credential store has an AuthMethod. If the AuthMethod is removed, a new
+// Token must be provided.
 //
 // An attribute of cs will be set to NULL in the database if the attribute
 // in cs is the zero value and it is included in fieldMaskPaths.
@@ -362,7 +452,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	}
 	cs = cs.clone()
 
-	var validateToken, updateToken bool
+	var validateToken, updateToken, updateAuthMethod bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
@@ -381,6 +471,12 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 				updateToken = true
 				validateToken = true
 			}
+		case strings.EqualFold(mountPathField, f),
+			strings.EqualFold(roleIdField, f),
+			strings.EqualFold(secretIdField, f),
+			strings.EqualFold(kubernetesRoleField, f),
+			strings.EqualFold(jwtField, f):
+			updateAuthMethod = true
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -417,7 +513,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if len(certNullFields) != 0 && len(certNullFields) != 2 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "attempting to unset a required field on a client cert")
 	}
-	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 {
+	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 && !updateAuthMethod {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
@@ -464,8 +560,38 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("can't recreate client certificate for vault client creation"))
 	}
+	if origStore.authMethod, err = lookupAuthMethod(ctx, r.reader, databaseWrapper, cs.GetPublicId()); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method"))
+	}
 	updatedStore := origStore.applyUpdate(cs, fieldMaskPaths)
 
+	switch {
+	case updatedStore.authMethod != nil:
+		if updateToken {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "vault token cannot be set on a credential store that uses an auth method")
+		}
+		if err := updatedStore.authMethod.validate(ctx, op); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		if updateAuthMethod || validateToken {
+			// Log in with the updated auth method or against the updated
+			// Vault address to get a new token for the store.
+			if err := updatedStore.login(ctx); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+			cs.inputToken = updatedStore.inputToken
+			updateToken = true
+			validateToken = true
+		}
+		if updateAuthMethod {
+			if err := updatedStore.authMethod.encrypt(ctx, databaseWrapper); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+		}
+	case origStore.authMethod != nil && !updateToken:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "a vault token must be provided when removing the auth method")
+	}
+
 	if len(certDbMask) > 0 && updatedStore.clientCert != nil {
 		if err := updatedStore.clientCert.encrypt(ctx, databaseWrapper); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
@@ -482,7 +608,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		if err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("cannot lookup token for updated store"))
 		}
-		if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+		if err := updatedStore.validateTokenLookup(ctx, op, tokenLookup); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 		}
 
@@ -527,7 +653,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	var returnedCredentialStore *CredentialStore
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
-			msgs := make([]*oplog.Message, 0, 3)
+			msgs := make([]*oplog.Message, 0, 4)
 			ticket, err := w.GetTicket(ctx, cs)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
@@ -600,6 +726,29 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 				}
 			}
 
+			switch {
+			case updateAuthMethod && updatedStore.authMethod != nil:
+				query, values := updatedStore.authMethod.insertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to upsert auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been upserted")
+				}
+				msgs = append(msgs, updatedStore.authMethod.oplogMessage(db.UpdateOp))
+			case updateAuthMethod && origStore.authMethod != nil:
+				query, values := origStore.authMethod.deleteQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been deleted")
+				}
+				msgs = append(msgs, origStore.authMethod.oplogMessage(db.DeleteOp))
+			}
+
 			if updateToken {
 				query, values := token.insertQuery()
 				rows, err := w.Exec(ctx, query, values)
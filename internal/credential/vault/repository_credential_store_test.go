@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	vault "github.com/hashicorp/vault/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -1673,3 +1674,254 @@ group by store_id, status;
 		})
 	}
 }
+
+func TestRepository_CredentialStore_AppRole(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(t, err)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	v := NewTestVaultServer(t)
+	roleId, secretId := v.MountAppRole(t)
+
+	create := func(t *testing.T) *CredentialStore {
+		t.Helper()
+		am, err := NewAppRoleAuthMethod(roleId, AuthSecret(secretId))
+		require.NoError(t, err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(t, err)
+		got, err := repo.CreateCredentialStore(ctx, in)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		return got
+	}
+
+	t.Run("create", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got := create(t)
+		require.NotNil(got.AuthMethod())
+		assert.Equal(AppRoleAuthMethod, got.AuthMethod().Type())
+		assert.Equal("approle", got.AuthMethod().GetMountPath())
+		assert.Equal(roleId, got.AuthMethod().GetRoleId())
+		assert.Empty(got.AuthMethod().GetSecretId())
+		assert.NotEmpty(got.AuthMethod().GetSecretIdHmac())
+
+		ps, err := repo.lookupClientStore(ctx, got.GetPublicId())
+		require.NoError(err)
+		require.NotNil(ps)
+		assert.NotEmpty(ps.Token)
+		v.LookupToken(t, string(ps.Token))
+	})
+
+	t.Run("update-mount-path", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		orig := create(t)
+		origPs, err := repo.lookupClientStore(ctx, orig.GetPublicId())
+		require.NoError(err)
+
+		newRoleId, newSecretId := v.MountAppRole(t, WithTestMountPath("approle/other/"))
+		am, err := NewAppRoleAuthMethod(newRoleId, AuthSecret(newSecretId), WithMountPath("approle/other"))
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, gotCount, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{mountPathField, roleIdField, secretIdField})
+		require.NoError(err)
+		assert.Equal(1, gotCount)
+		require.NotNil(got.AuthMethod())
+		assert.Equal(AppRoleAuthMethod, got.AuthMethod().Type())
+		assert.Equal("approle/other", got.AuthMethod().GetMountPath())
+		assert.Equal(newRoleId, got.AuthMethod().GetRoleId())
+
+		ps, err := repo.lookupClientStore(ctx, orig.GetPublicId())
+		require.NoError(err)
+		assert.NotEqual(origPs.Token, ps.Token)
+	})
+
+	t.Run("update-invalid-secret-id", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		orig := create(t)
+		am, err := NewAppRoleAuthMethod("", AuthSecret("invalid"))
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, gotCount, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{secretIdField})
+		assert.Error(err)
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, gotCount)
+	})
+}
+
+// authMethodTestClient is a vaultClient that logs in to Vault by returning
+// token instead of calling Vault. It records the path and data of the
+// login.
+type authMethodTestClient struct {
+	vaultClient
+	token  string
+	logins *[]string
+	data   *map[string]any
+}
+
+func (c *authMethodTestClient) login(_ context.Context, path string, data map[string]any) (*vault.Secret, error) {
+	*c.logins = append(*c.logins, path)
+	*c.data = data
+	return &vault.Secret{Auth: &vault.SecretAuth{ClientToken: c.token}}, nil
+}
+
+// TestRepository_CredentialStore_Kubernetes replaces vaultClientFactoryFn
+// so it cannot be run in parallel with other tests.
+func TestRepository_CredentialStore_Kubernetes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(t, err)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	v := NewTestVaultServer(t)
+
+	var logins []string
+	var loginData map[string]any
+	origFactory := vaultClientFactoryFn
+	vaultClientFactoryFn = func(ctx context.Context, c *clientConfig, opt ...Option) (vaultClient, error) {
+		vc, err := origFactory(ctx, c, opt...)
+		if err != nil {
+			return nil, err
+		}
+		_, token := v.CreateToken(t)
+		return &authMethodTestClient{vaultClient: vc, token: token, logins: &logins, data: &loginData}, nil
+	}
+	t.Cleanup(func() { vaultClientFactoryFn = origFactory })
+
+	create := func(t *testing.T, am *AuthMethod) (*CredentialStore, error) {
+		t.Helper()
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(t, err)
+		return repo.CreateCredentialStore(ctx, in)
+	}
+
+	t.Run("create", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		logins = nil
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"), WithMountPath("k8s/prod"))
+		require.NoError(err)
+		got, err := create(t, am)
+		require.NoError(err)
+		require.NotNil(got.AuthMethod())
+		assert.Equal(KubernetesAuthMethod, got.AuthMethod().Type())
+		assert.Equal("k8s/prod", got.AuthMethod().GetMountPath())
+		assert.Equal("role", got.AuthMethod().GetKubernetesRole())
+		assert.Empty(got.AuthMethod().GetJwt())
+		assert.NotEmpty(got.AuthMethod().GetJwtHmac())
+		assert.Equal([]string{"auth/k8s/prod/login"}, logins)
+		assert.Equal(map[string]any{"role": "role", "jwt": "jwt"}, loginData)
+	})
+
+	t.Run("create-without-jwt", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		logins = nil
+		am, err := NewKubernetesAuthMethod("role", nil)
+		require.NoError(err)
+		got, err := create(t, am)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+		assert.Empty(logins, "no login without a jwt")
+	})
+
+	t.Run("update-mount-path-keeps-type", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"))
+		require.NoError(err)
+		orig, err := create(t, am)
+		require.NoError(err)
+		logins = nil
+
+		// A mount path only update as built by the credential store
+		// handler for a store using the kubernetes auth method.
+		am, err = NewKubernetesAuthMethod("", nil, WithMountPath("k8s/other"))
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, gotCount, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{mountPathField})
+		require.NoError(err)
+		assert.Equal(1, gotCount)
+		require.NotNil(got.AuthMethod())
+		assert.Equal(KubernetesAuthMethod, got.AuthMethod().Type())
+		assert.Equal("k8s/other", got.AuthMethod().GetMountPath())
+		assert.Equal("role", got.AuthMethod().GetKubernetesRole())
+		assert.Equal([]string{"auth/k8s/other/login"}, logins)
+		assert.Equal(map[string]any{"role": "role", "jwt": "jwt"}, loginData)
+	})
+
+	t.Run("update-jwt", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"))
+		require.NoError(err)
+		orig, err := create(t, am)
+		require.NoError(err)
+		logins = nil
+
+		am, err = NewKubernetesAuthMethod("", AuthSecret("new-jwt"))
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, _, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{jwtField})
+		require.NoError(err)
+		assert.Equal(KubernetesAuthMethod, got.AuthMethod().Type())
+		assert.NotEqual(orig.AuthMethod().GetJwtHmac(), got.AuthMethod().GetJwtHmac())
+		assert.Equal([]string{"auth/kubernetes/login"}, logins)
+		assert.Equal(map[string]any{"role": "role", "jwt": "new-jwt"}, loginData)
+	})
+
+	t.Run("update-unset-jwt", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"))
+		require.NoError(err)
+		orig, err := create(t, am)
+		require.NoError(err)
+
+		am, err = NewKubernetesAuthMethod("", nil)
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, gotCount, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{jwtField})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, gotCount)
+	})
+
+	t.Run("update-to-approle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am, err := NewKubernetesAuthMethod("role", AuthSecret("jwt"))
+		require.NoError(err)
+		orig, err := create(t, am)
+		require.NoError(err)
+		logins = nil
+
+		am, err = NewAppRoleAuthMethod("role-id", AuthSecret("secret-id"))
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithAuthMethod(am))
+		require.NoError(err)
+		in.PublicId = orig.GetPublicId()
+		got, _, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), []string{roleIdField, secretIdField, kubernetesRoleField, jwtField})
+		require.NoError(err)
+		require.NotNil(got.AuthMethod())
+		assert.Equal(AppRoleAuthMethod, got.AuthMethod().Type())
+		assert.Equal("approle", got.AuthMethod().GetMountPath())
+		assert.Empty(got.AuthMethod().GetKubernetesRole())
+		assert.Equal([]string{"auth/approle/login"}, logins)
+	})
+}
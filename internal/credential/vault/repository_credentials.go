@@ -73,12 +73,29 @@ func (r *Repository) Issue(ctx context.Context, sessionId string, requests []cre
 	var minLease time.Duration
 	runJobsInterval := r.scheduler.GetRunJobsInterval()
 	for _, lib := range libs {
+		start := time.Now()
 		cred, err := lib.retrieveCredential(ctx, op, opt...)
+		recordBrokering(lib.GetStoreId(), time.Since(start), err)
 		if err != nil {
 			return nil, err
 		}
 
 		creds = append(creds, cred)
+		if kv := cred.getKvSecretVersion(); kv != nil {
+			// Best effort, failing to record the version of a KV secret
+			// should not prevent the session from being authorized.
+			previous, err := recordKvSecretVersion(ctx, r.writer, lib.GetPublicId(), sessionId, kv)
+			switch {
+			case err != nil:
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record kv secret version", "credential library id", lib.GetPublicId()))
+			case previous != 0 && previous != kv.version:
+				event.WriteSysEvent(ctx, op, "Vault KV secret version changed since last session",
+					"session id", sessionId,
+					"credential library id", lib.GetPublicId(),
+					"previous version", previous,
+					"version", kv.version)
+			}
+		}
 		if !cred.isRevokable() {
 			// No need to persist since the credential cannot be revoked nor renewed
 			continue
@@ -15,6 +15,7 @@ import (
 func init() {
 	kms.RegisterTableRewrapFn("credential_vault_client_certificate", credVaultClientCertificateRewrapFn)
 	kms.RegisterTableRewrapFn("credential_vault_token", credVaultTokenRewrapFn)
+	kms.RegisterTableRewrapFn("credential_vault_store_auth_method", credVaultAuthMethodRewrapFn)
 }
 
 func rewrapParameterChecks(ctx context.Context, dataKeyVersionId string, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) string {
@@ -93,3 +94,33 @@ func credVaultTokenRewrapFn(ctx context.Context, dataKeyVersionId, scopeId strin
 	}
 	return nil
 }
+
+func credVaultAuthMethodRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
+	const op = "vault.credVaultAuthMethodRewrapFn"
+	if errStr := rewrapParameterChecks(ctx, dataKeyVersionId, scopeId, reader, writer, kmsRepo); errStr != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, errStr)
+	}
+	var methods []*AuthMethod
+	// only index is store id, and store isn't queryable via scope.
+	// This is the fastest query we can use without creating a new index on key_id.
+	if err := reader.SearchWhere(ctx, &methods, "key_id=?", []any{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	wrapper, err := kmsRepo.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, am := range methods {
+		if err := am.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt vault auth method"))
+		}
+		if err := am.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt vault auth method"))
+		}
+		query, values := am.insertQuery()
+		if _, err := writer.Exec(ctx, query, values); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update vault auth method row with rewrapped fields"))
+		}
+	}
+	return nil
+}
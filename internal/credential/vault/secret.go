@@ -50,3 +50,26 @@ func (s KeySecret) GoString() string {
 func (s KeySecret) MarshalJSON() ([]byte, error) {
 	return json.Marshal([]byte(redactedKeySecret))
 }
+
+// AuthSecret equals an AppRole secret id or a Kubernetes service account
+// token used to log in to Vault. This type provides a wrapper so the secret
+// isn't inadvertently leaked into a log or error.
+type AuthSecret []byte
+
+// redactedAuthSecret is the redacted string or json for a Vault auth method secret.
+const redactedAuthSecret = "[REDACTED: Vault auth_secret]"
+
+// String will redact the AuthSecret.
+func (s AuthSecret) String() string {
+	return redactedAuthSecret
+}
+
+// GoString will redact the AuthSecret.
+func (s AuthSecret) GoString() string {
+	return redactedAuthSecret
+}
+
+// MarshalJSON will redact the AuthSecret.
+func (s AuthSecret) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]byte(redactedAuthSecret))
+}
@@ -133,3 +133,44 @@ func TestKeySecret_MarshalJSON(t *testing.T) {
 		assert.Equal(testB, sec.B)
 	})
 }
+
+func TestAuthSecret_String(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedAuthSecret
+		as := AuthSecret("login secret")
+		assert.Equalf(want, as.String(), "AuthSecret.String() = %v, want %v", as.String(), want)
+
+		// Verify stringer is called
+		s := fmt.Sprintf("%s", as)
+		assert.Equalf(want, s, "AuthSecret.String() = %v, want %v", s, want)
+	})
+}
+
+func TestAuthSecret_GoString(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedAuthSecret
+		as := AuthSecret("login secret")
+		assert.Equalf(want, as.GoString(), "AuthSecret.GoString() = %v, want %v", as.GoString(), want)
+
+		// Verify gostringer is called
+		s := fmt.Sprintf("%#v", as)
+		assert.Equalf(want, s, "AuthSecret.GoString() = %v, want %v", s, want)
+	})
+}
+
+func TestAuthSecret_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want, err := json.Marshal([]byte(redactedAuthSecret))
+		require.NoError(err)
+		as := AuthSecret("login secret")
+		got, err := as.MarshalJSON()
+		require.NoError(err)
+		assert.Equalf(want, got, "AuthSecret.MarshalJSON() = %s, want %s", got, want)
+	})
+}
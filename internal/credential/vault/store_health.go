@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// A StoreHealthStatus represents the overall health of a Vault credential
+// store as determined by the most recent health check.
+type StoreHealthStatus string
+
+const (
+	// HealthyStore means Vault is reachable, the store's token is valid
+	// and the token has all of the capabilities Boundary requires.
+	HealthyStore StoreHealthStatus = "healthy"
+
+	// DegradedStore means Vault is reachable and the store's token is
+	// valid but the token is missing capabilities, is close to expiring or
+	// the last attempt to renew the token failed.
+	DegradedStore StoreHealthStatus = "degraded"
+
+	// UnhealthyStore means Vault is not reachable or the store's token
+	// could not be used to authenticate to Vault.
+	UnhealthyStore StoreHealthStatus = "unhealthy"
+)
+
+// StoreHealth contains the results of the most recent health check for a
+// Vault credential store.
+type StoreHealth struct {
+	StoreId string `gorm:"primary_key"`
+	Status  string
+	// VaultReachable is nil until the store has been checked by the health
+	// job, even if a token renewal failure has been recorded.
+	VaultReachable *bool
+	// TokenExpirationTime is the time the store's current token will
+	// expire if it is not renewed. It is nil if the token does not expire
+	// or the token could not be looked up.
+	TokenExpirationTime *timestamp.Timestamp
+	// MissingCapabilities is a Vault policy containing the capabilities
+	// required by Boundary that the store's current token does not have.
+	MissingCapabilities  string
+	LastCheckError       string
+	LastCheckTime        *timestamp.Timestamp
+	LastRenewalError     string
+	LastRenewalErrorTime *timestamp.Timestamp
+}
+
+func allocStoreHealth() *StoreHealth {
+	return &StoreHealth{}
+}
+
+// TableName returns the table name for gorm.
+func (*StoreHealth) TableName() string {
+	return "credential_vault_store_health"
+}
+
+// checkStoreHealth runs the health checks for a Vault credential store using
+// vc and returns the results. checkStoreHealth does not return an error,
+// failures are recorded in the returned StoreHealth.
+func checkStoreHealth(ctx context.Context, storeId string, vc vaultClient) *StoreHealth {
+	h := allocStoreHealth()
+	h.StoreId = storeId
+	h.Status = string(HealthyStore)
+
+	if err := vc.ping(ctx); err != nil {
+		h.Status = string(UnhealthyStore)
+		h.VaultReachable = util.Pointer(false)
+		h.LastCheckError = err.Error()
+		return h
+	}
+	h.VaultReachable = util.Pointer(true)
+
+	t, err := vc.lookupToken(ctx)
+	if err != nil {
+		h.Status = string(UnhealthyStore)
+		h.LastCheckError = err.Error()
+		return h
+	}
+	ttl, err := t.TokenTTL()
+	if err != nil {
+		h.Status = string(DegradedStore)
+		h.LastCheckError = err.Error()
+	}
+	if ttl > 0 {
+		h.TokenExpirationTime = timestamp.New(time.Now().Add(ttl))
+		if ttl < renewalWindow {
+			// The token renewal job renews tokens that will expire within
+			// the renewal window, so a ttl this low means renewals are
+			// not keeping up.
+			h.Status = string(DegradedStore)
+		}
+	}
+
+	available, err := vc.capabilities(ctx, requiredCapabilities.paths())
+	if err != nil {
+		h.Status = string(DegradedStore)
+		h.LastCheckError = err.Error()
+		return h
+	}
+	if missing := available.missing(requiredCapabilities); len(missing) > 0 {
+		h.Status = string(DegradedStore)
+		h.MissingCapabilities = strings.TrimSpace(missing.vaultPolicy())
+	}
+	return h
+}
+
+// LookupStoreHealth returns the results of the most recent health check for
+// the Vault credential store with storeId. Returns nil, nil if the store has
+// not been checked yet.
+func (r *Repository) LookupStoreHealth(ctx context.Context, storeId string, _ ...Option) (*StoreHealth, error) {
+	const op = "vault.(Repository).LookupStoreHealth"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	h := allocStoreHealth()
+	if err := r.reader.LookupWhere(ctx, h, "store_id = ?", []any{storeId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return h, nil
+}
+
+func (h *StoreHealth) upsertQuery() (query string, queryValues []any) {
+	var tokenExpiration any
+	if h.TokenExpirationTime != nil {
+		tokenExpiration = h.TokenExpirationTime.AsTime()
+	}
+	query = upsertStoreHealthQuery
+	queryValues = []any{
+		sql.Named("store_id", h.StoreId),
+		sql.Named("status", h.Status),
+		sql.Named("vault_reachable", h.VaultReachable),
+		sql.Named("token_expiration_time", tokenExpiration),
+		sql.Named("missing_capabilities", nullString(h.MissingCapabilities)),
+		sql.Named("last_check_error", nullString(h.LastCheckError)),
+	}
+	return
+}
+
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthTestClient struct {
+	vaultClient
+	pingErr         error
+	lookupErr       error
+	ttl             time.Duration
+	capabilitiesErr error
+	caps            pathCapabilities
+}
+
+func (c *healthTestClient) ping(context.Context) error {
+	return c.pingErr
+}
+
+func (c *healthTestClient) lookupToken(context.Context) (*vault.Secret, error) {
+	if c.lookupErr != nil {
+		return nil, c.lookupErr
+	}
+	return &vault.Secret{
+		Data: map[string]any{
+			"ttl": json.Number(fmt.Sprintf("%d", int64(c.ttl.Seconds()))),
+		},
+	}, nil
+}
+
+func (c *healthTestClient) capabilities(context.Context, []string) (pathCapabilities, error) {
+	return c.caps, c.capabilitiesErr
+}
+
+func Test_checkStoreHealth(t *testing.T) {
+	t.Parallel()
+	allCaps := make(pathCapabilities, len(requiredCapabilities))
+	for p, c := range requiredCapabilities {
+		allCaps[p] = c
+	}
+	noRenewCaps := make(pathCapabilities, len(requiredCapabilities))
+	for p, c := range requiredCapabilities {
+		if p != "auth/token/renew-self" {
+			noRenewCaps[p] = c
+		}
+	}
+
+	tests := []struct {
+		name          string
+		client        *healthTestClient
+		wantStatus    StoreHealthStatus
+		wantReachable bool
+		wantExpires   bool
+		wantErr       bool
+		wantMissing   string
+	}{
+		{
+			name: "healthy",
+			client: &healthTestClient{
+				ttl:  time.Hour,
+				caps: allCaps,
+			},
+			wantStatus:    HealthyStore,
+			wantReachable: true,
+			wantExpires:   true,
+		},
+		{
+			name: "non-expiring-token",
+			client: &healthTestClient{
+				caps: allCaps,
+			},
+			wantStatus:    HealthyStore,
+			wantReachable: true,
+		},
+		{
+			name: "unreachable",
+			client: &healthTestClient{
+				pingErr: fmt.Errorf("connection refused"),
+			},
+			wantStatus: UnhealthyStore,
+			wantErr:    true,
+		},
+		{
+			name: "lookup-failure",
+			client: &healthTestClient{
+				lookupErr: fmt.Errorf("permission denied"),
+			},
+			wantStatus:    UnhealthyStore,
+			wantReachable: true,
+			wantErr:       true,
+		},
+		{
+			name: "expiring-soon",
+			client: &healthTestClient{
+				ttl:  renewalWindow / 2,
+				caps: allCaps,
+			},
+			wantStatus:    DegradedStore,
+			wantReachable: true,
+			wantExpires:   true,
+		},
+		{
+			name: "capabilities-failure",
+			client: &healthTestClient{
+				ttl:             time.Hour,
+				capabilitiesErr: fmt.Errorf("permission denied"),
+			},
+			wantStatus:    DegradedStore,
+			wantReachable: true,
+			wantExpires:   true,
+			wantErr:       true,
+		},
+		{
+			name: "missing-capabilities",
+			client: &healthTestClient{
+				ttl:  time.Hour,
+				caps: noRenewCaps,
+			},
+			wantStatus:    DegradedStore,
+			wantReachable: true,
+			wantExpires:   true,
+			wantMissing:   "path \"auth/token/renew-self\" {\n\tcapabilities = [\"update\"]\n}",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got := checkStoreHealth(context.Background(), "csvlt_1234567890", tt.client)
+			require.NotNil(got)
+			assert.Equal("csvlt_1234567890", got.StoreId)
+			assert.Equal(string(tt.wantStatus), got.Status)
+			require.NotNil(got.VaultReachable)
+			assert.Equal(tt.wantReachable, *got.VaultReachable)
+			assert.Equal(tt.wantExpires, got.TokenExpirationTime != nil)
+			assert.Equal(tt.wantErr, got.LastCheckError != "")
+			assert.Equal(tt.wantMissing, got.MissingCapabilities)
+		})
+	}
+}
+
+func TestNewStoreHealthJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	_, err := newStoreHealthJob(ctx, nil, rw, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	_, err = newStoreHealthJob(ctx, rw, nil, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+	_, err = newStoreHealthJob(ctx, rw, rw, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	got, err := newStoreHealthJob(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	assert.Equal(t, db.DefaultLimit, got.limit)
+	got, err = newStoreHealthJob(ctx, rw, rw, kmsCache, WithLimit(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, got.limit)
+}
+
+func TestStoreHealthJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	// The test stores point at Vault addresses that do not resolve so
+	// every store is recorded as unhealthy.
+	count := 5
+	css := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), count)
+	for _, cs := range css {
+		h, err := repo.LookupStoreHealth(ctx, cs.GetPublicId())
+		require.NoError(err)
+		assert.Nil(h, "store has not been checked")
+	}
+
+	// A limit smaller than the number of stores still checks every store.
+	j, err := newStoreHealthJob(ctx, rw, rw, kmsCache, WithLimit(2))
+	require.NoError(err)
+	require.NoError(j.Run(ctx))
+	assert.Equal(count, j.numStores)
+	assert.Equal(count, j.numProcessed)
+	for _, cs := range css {
+		h, err := repo.LookupStoreHealth(ctx, cs.GetPublicId())
+		require.NoError(err)
+		require.NotNil(h)
+		assert.Equal(string(UnhealthyStore), h.Status)
+		require.NotNil(h.VaultReachable)
+		assert.False(*h.VaultReachable)
+		assert.NotEmpty(h.LastCheckError)
+		assert.NotNil(h.LastCheckTime)
+
+		got, err := repo.LookupCredentialStore(ctx, cs.GetPublicId())
+		require.NoError(err)
+		assert.Equal(h, got.Health())
+	}
+
+	// Listing stores includes the same health as reading them.
+	storeRepo, err := credential.NewStoreRepository(ctx, rw, rw)
+	require.NoError(err)
+	stores, _, err := storeRepo.List(ctx, []string{prj.GetPublicId()}, nil, count)
+	require.NoError(err)
+	require.Len(stores, count)
+	for _, s := range stores {
+		cs, ok := s.(*CredentialStore)
+		require.True(ok)
+		want, err := repo.LookupStoreHealth(ctx, cs.GetPublicId())
+		require.NoError(err)
+		require.NotNil(cs.Health())
+		assert.Equal(want.Status, cs.Health().Status)
+		assert.Equal(want.LastCheckError, cs.Health().LastCheckError)
+		assert.Equal(want.LastCheckTime.AsTime(), cs.Health().LastCheckTime.AsTime())
+	}
+
+	// A store whose token has expired is unhealthy without contacting
+	// Vault.
+	_, err = rw.Exec(ctx, "update credential_vault_token set status = 'expired' where store_id = ?", []any{css[0].GetPublicId()})
+	require.NoError(err)
+	require.NoError(j.Run(ctx))
+	h, err := repo.LookupStoreHealth(ctx, css[0].GetPublicId())
+	require.NoError(err)
+	assert.Equal(string(UnhealthyStore), h.Status)
+	assert.Equal("credential store does not have a current vault token", h.LastCheckError)
+}
+
+func TestTokenRenewalJob_recordRenewalError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	r, err := newTokenRenewalJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	// A renewal error on a store that has not been checked degrades it
+	// without knowing whether Vault is reachable.
+	r.recordRenewalError(ctx, cs.GetPublicId(), "renewal failed")
+	h, err := repo.LookupStoreHealth(ctx, cs.GetPublicId())
+	require.NoError(err)
+	require.NotNil(h)
+	assert.Equal(string(DegradedStore), h.Status)
+	assert.Nil(h.VaultReachable)
+	assert.Equal("renewal failed", h.LastRenewalError)
+	assert.NotNil(h.LastRenewalErrorTime)
+
+	// A health check keeps the renewal error.
+	j, err := newStoreHealthJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(j.Run(ctx))
+	h, err = repo.LookupStoreHealth(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(string(UnhealthyStore), h.Status)
+	require.NotNil(h.VaultReachable)
+	assert.False(*h.VaultReachable)
+	assert.Equal("renewal failed", h.LastRenewalError)
+
+	// A renewal error does not improve an unhealthy store.
+	r.recordRenewalError(ctx, cs.GetPublicId(), "renewal failed again")
+	h, err = repo.LookupStoreHealth(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(string(UnhealthyStore), h.Status)
+	assert.Equal("renewal failed again", h.LastRenewalError)
+}
+
+func TestRepository_LookupStoreHealth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(t, err)
+
+	_, err = repo.LookupStoreHealth(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	h, err := repo.LookupStoreHealth(ctx, "csvlt_doesnotexist")
+	require.NoError(t, err)
+	assert.Nil(t, h)
+}
@@ -983,6 +983,55 @@ func (v *TestVaultServer) MountPKI(t testing.TB, opt ...TestOption) *vault.Secre
 	return s
 }
 
+// MountAppRole enables the Vault AppRole auth method and creates a role on
+// the mount. It returns the role id of the role and a secret id generated
+// for the role.
+//
+// The default mount path is approle and the default role name is boundary.
+// WithTestMountPath, WithTestRoleName, WithPolicies and WithTokenPeriod are
+// the test options supported. Tokens issued to the role have the same
+// policies, period and renewability as tokens created with v.CreateToken.
+func (v *TestVaultServer) MountAppRole(t testing.TB, opt ...TestOption) (roleId, secretId string) {
+	t.Helper()
+	require := require.New(t)
+	opts := getTestOpts(t, opt...)
+	vc := v.client(t).cl
+
+	mountPath := opts.mountPath
+	if mountPath == "" {
+		mountPath = "approle/"
+	}
+	require.NoError(vc.Sys().EnableAuthWithOptions(mountPath, &vault.EnableAuthOptions{
+		Type:        "approle",
+		Description: t.Name(),
+	}))
+
+	rolePath := path.Join("auth", mountPath, "role", opts.roleName)
+	roleOptions := map[string]any{
+		"token_policies": opts.policies,
+		"token_type":     "service",
+	}
+	if opts.periodic {
+		roleOptions["token_period"] = opts.tokenPeriod.String()
+	}
+	_, err := vc.Logical().Write(rolePath, roleOptions)
+	require.NoError(err)
+
+	s, err := vc.Logical().Read(path.Join(rolePath, "role-id"))
+	require.NoError(err)
+	require.NotNil(s)
+	roleId, ok := s.Data["role_id"].(string)
+	require.True(ok)
+
+	s, err = vc.Logical().Write(path.Join(rolePath, "secret-id"), nil)
+	require.NoError(err)
+	require.NotNil(s)
+	secretId, ok = s.Data["secret_id"].(string)
+	require.True(ok)
+
+	return roleId, secretId
+}
+
 // AddKVPolicy adds a Vault policy named 'secret' to v and adds it to the
 // standard set of polices attached to tokens created with v.CreateToken.
 // The policy is defined as:
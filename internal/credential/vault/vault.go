@@ -26,15 +26,17 @@ type vaultClient interface {
 	lookupToken(context.Context) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
 	get(context.Context, string) (*vault.Secret, error)
+	getWithParams(context.Context, string, map[string][]string) (*vault.Secret, error)
 	post(context.Context, string, []byte) (*vault.Secret, error)
 	capabilities(context.Context, []string) (pathCapabilities, error)
+	login(context.Context, string, map[string]any) (*vault.Secret, error)
 }
 
 var vaultClientFactoryFn = vaultClientFactory
 
 func vaultClientFactory(ctx context.Context, c *clientConfig, opt ...Option) (vaultClient, error) {
 	const op = "vault.vaultClientFactory"
-	nc, err := newClient(ctx, c)
+	nc, err := newClient(ctx, c, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -52,8 +54,10 @@ type clientConfig struct {
 	Namespace     string `json:"namespace"`
 }
 
-func (c *clientConfig) isValid() bool {
-	if c == nil || c.Addr == "" || len(c.Token) == 0 {
+// isValid reports if c can be used to create a client. A configuration
+// without a token is valid if it is only used to log in to Vault.
+func (c *clientConfig) isValid(login bool) bool {
+	if c == nil || c.Addr == "" || (len(c.Token) == 0 && !login) {
 		return false
 	}
 	return true
@@ -73,9 +77,10 @@ type client struct {
 	token TokenSecret
 }
 
-func newClient(ctx context.Context, c *clientConfig) (*client, error) {
+func newClient(ctx context.Context, c *clientConfig, opt ...Option) (*client, error) {
 	const op = "vault.newClient"
-	if !c.isValid() {
+	opts := getOpts(opt...)
+	if !c.isValid(opts.withLoginClient) {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid configuration")
 	}
 	vc := vault.DefaultConfig()
@@ -220,6 +225,17 @@ func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
 	return s, nil
 }
 
+// getWithParams is the same as get with params added to the request as
+// query parameters.
+func (c *client) getWithParams(ctx context.Context, path string, params map[string][]string) (*vault.Secret, error) {
+	const op = "vault.(client).getWithParams"
+	s, err := c.cl.Logical().ReadWithDataWithContext(ctx, path, params)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return s, nil
+}
+
 func (c *client) post(ctx context.Context, path string, data []byte) (*vault.Secret, error) {
 	const op = "vault.(client).post"
 
@@ -264,3 +280,22 @@ func (c *client) capabilities(ctx context.Context, paths []string) (pathCapabili
 
 	return newPathCapabilities(res), nil
 }
+
+// login calls the login endpoint of a Vault auth method at path and returns
+// the vault.Secret response. The token of the client is not sent with the
+// request. See
+// https://developer.hashicorp.com/vault/api-docs/auth/approle#login-with-approle and
+// https://developer.hashicorp.com/vault/api-docs/auth/kubernetes#login.
+func (c *client) login(ctx context.Context, path string, data map[string]any) (*vault.Secret, error) {
+	const op = "vault.(client).login"
+	lc, err := c.cl.CloneWithHeaders()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	lc.ClearToken()
+	s, err := lc.Logical().WriteWithContext(ctx, path, data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return s, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	dcommon "github.com/hashicorp/boundary/internal/daemon/common"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/go-bexpr"
+)
+
+// hostHealthCheckEndpointCacheTTL is how long the host health check endpoints
+// are cached for the status calls of all workers.
+const hostHealthCheckEndpointCacheTTL = 30 * time.Second
+
+// hostHealthCheckEndpointCache caches the host health check endpoints so the
+// controller looks them up at most once per hostHealthCheckEndpointCacheTTL
+// instead of on every worker status call. Changes to the endpoints reach the
+// workers after at most hostHealthCheckEndpointCacheTTL.
+type hostHealthCheckEndpointCache struct {
+	mu        sync.Mutex
+	endpoints []*server.HostHealthCheckEndpoint
+	expires   time.Time
+}
+
+// get returns the cached endpoints or, if they have expired, the endpoints
+// returned by fetch. Concurrent callers wait for a single call to fetch.
+func (c *hostHealthCheckEndpointCache) get(ctx context.Context, fetch func(context.Context) ([]*server.HostHealthCheckEndpoint, error)) ([]*server.HostHealthCheckEndpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().Before(c.expires) {
+		return c.endpoints, nil
+	}
+	endpoints, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.endpoints = endpoints
+	c.expires = time.Now().Add(hostHealthCheckEndpointCacheTTL)
+	return endpoints, nil
+}
+
+// hostHealthChecksForWorker returns the endpoints which the worker should
+// check the health of. An endpoint is checked by the workers matching the
+// worker filter of the target it belongs to, since those are the workers
+// which would proxy sessions to it.
+func hostHealthChecksForWorker(ctx context.Context, w *server.Worker, endpoints []*server.HostHealthCheckEndpoint) []*pbs.HostHealthCheck {
+	const op = "handlers.hostHealthChecksForWorker"
+	type key struct {
+		hostId, address string
+		port            uint32
+	}
+	matches := make(map[string]bool)
+	seen := make(map[key]bool)
+	var checks []*pbs.HostHealthCheck
+	for _, ep := range endpoints {
+		k := key{ep.HostId, ep.Address, ep.Port}
+		if seen[k] {
+			continue
+		}
+		match, ok := matches[ep.WorkerFilter]
+		if !ok {
+			match = true
+			if ep.WorkerFilter != "" {
+				match = false
+				eval, err := bexpr.CreateEvaluator(ep.WorkerFilter)
+				if err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error creating worker filter evaluator"))
+				} else {
+					filtered, err := dcommon.WorkerList{w}.Filtered(eval)
+					if err != nil {
+						event.WriteError(ctx, op, err, event.WithInfoMsg("error evaluating worker filter"))
+					}
+					match = len(filtered) > 0
+				}
+			}
+			matches[ep.WorkerFilter] = match
+		}
+		if !match {
+			continue
+		}
+		seen[k] = true
+		checks = append(checks, &pbs.HostHealthCheck{
+			HostId:  ep.HostId,
+			Address: ep.Address,
+			Port:    ep.Port,
+		})
+	}
+	return checks
+}
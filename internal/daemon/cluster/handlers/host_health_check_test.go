@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestHostHealthChecksForWorker(t *testing.T) {
+	ctx := context.Background()
+	w := server.NewWorker(scope.Global.String(),
+		server.WithName("worker"),
+		server.WithWorkerTags(&server.Tag{Key: "region", Value: "east"}),
+		server.WithTestUseInputTagsAsApiTags(true))
+
+	endpoints := []*server.HostHealthCheckEndpoint{
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22},
+		{HostId: "hst_2", Address: "10.0.0.2", Port: 22, WorkerFilter: `"east" in "/tags/region"`},
+		{HostId: "hst_3", Address: "10.0.0.3", Port: 22, WorkerFilter: `"west" in "/tags/region"`},
+		// The same endpoint for a second target is only checked once.
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22, WorkerFilter: `"east" in "/tags/region"`},
+		// An endpoint of another target with a different port is checked.
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 2222},
+		// Invalid filters do not match.
+		{HostId: "hst_4", Address: "10.0.0.4", Port: 22, WorkerFilter: `"east" in`},
+	}
+	got := hostHealthChecksForWorker(ctx, w, endpoints)
+	want := []*pbs.HostHealthCheck{
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 22},
+		{HostId: "hst_2", Address: "10.0.0.2", Port: 22},
+		{HostId: "hst_1", Address: "10.0.0.1", Port: 2222},
+	}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+	assert.Empty(t, hostHealthChecksForWorker(ctx, w, nil))
+}
+
+func TestHostHealthCheckEndpointCache(t *testing.T) {
+	ctx := context.Background()
+	endpoints := []*server.HostHealthCheckEndpoint{{HostId: "hst_1", Address: "10.0.0.1", Port: 22}}
+	var calls int
+	fetch := func(context.Context) ([]*server.HostHealthCheckEndpoint, error) {
+		calls++
+		return endpoints, nil
+	}
+	failing := func(context.Context) ([]*server.HostHealthCheckEndpoint, error) {
+		calls++
+		return nil, stderrors.New("query failed")
+	}
+
+	c := &hostHealthCheckEndpointCache{}
+	_, err := c.get(ctx, failing)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	got, err := c.get(ctx, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, endpoints, got)
+	assert.Equal(t, 2, calls)
+
+	got, err = c.get(ctx, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, endpoints, got)
+	assert.Equal(t, 2, calls, "cached endpoints are not fetched again")
+
+	c.expires = time.Now()
+	_, err = c.get(ctx, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls, "expired endpoints are fetched again")
+}
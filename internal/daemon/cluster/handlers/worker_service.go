@@ -41,6 +41,8 @@ type workerServiceServer struct {
 	kms                 *kms.Kms
 	livenessTimeToStale *atomic.Int64
 	controllerExt       intglobals.ControllerExtension
+
+	hostHealthCheckEndpoints *hostHealthCheckEndpointCache
 }
 
 var (
@@ -87,6 +89,8 @@ func NewWorkerServiceServer(
 		kms:                 kms,
 		livenessTimeToStale: livenessTimeToStale,
 		controllerExt:       controllerExt,
+
+		hostHealthCheckEndpoints: &hostHealthCheckEndpointCache{},
 	}
 }
 
@@ -140,7 +144,8 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		server.WithAddress(wStat.GetAddress()),
 		server.WithWorkerTags(workerTags...),
 		server.WithReleaseVersion(wStat.ReleaseVersion),
-		server.WithOperationalState(wStat.OperationalState))
+		server.WithOperationalState(wStat.OperationalState),
+		server.WithStatusRttMs(wStat.GetStatusRttMs()))
 	opts := []server.Option{server.WithUpdateTags(req.GetUpdateTags())}
 	if wStat.GetPublicId() != "" {
 		opts = append(opts, server.WithPublicId(wStat.GetPublicId()))
@@ -208,6 +213,35 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		WorkerId:                    wrk.GetPublicId(),
 		AuthorizedWorkers:           authorizedWorkerList,
 		AuthorizedDownstreamWorkers: authorizedDownstreams,
+		DrainRequested:              wrk.GetDrainRequested(),
+	}
+
+	if len(req.GetHostHealthCheckResults()) > 0 {
+		results := make([]*server.HostHealthCheckResult, 0, len(req.GetHostHealthCheckResults()))
+		for _, r := range req.GetHostHealthCheckResults() {
+			results = append(results, &server.HostHealthCheckResult{
+				HostId:    r.GetHostId(),
+				Address:   r.GetAddress(),
+				Port:      r.GetPort(),
+				Healthy:   r.GetHealthy(),
+				Error:     r.GetError(),
+				CheckTime: r.GetCheckTime().AsTime(),
+			})
+		}
+		// Failing to record the results only affects host selection, so it
+		// should not fail the status update.
+		if err := serverRepo.UpdateHostHealthCheckResults(ctx, wrk.GetPublicId(), results); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error storing host health check results"))
+		}
+	}
+	// Failing to get the endpoints only affects host health checks, so the
+	// worker is sent no endpoints to check instead of failing the status
+	// update.
+	healthCheckEndpoints, err := ws.hostHealthCheckEndpoints.get(ctx, serverRepo.ListHostHealthCheckEndpoints)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting host health check endpoints"))
+	} else {
+		ret.HostHealthChecks = hostHealthChecksForWorker(ctx, wrk, healthCheckEndpoints)
 	}
 
 	stateReport := make([]*session.StateReport, 0, len(req.GetJobs()))
@@ -523,6 +557,13 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		TargetId:        sessionInfo.TargetId,
 		UserId:          sessionInfo.UserId,
 		Credentials:     workerCreds,
+
+		BandwidthLimit:                  sessionInfo.BandwidthLimit,
+		SessionBandwidthLimit:           sessionInfo.SessionBandwidthLimit,
+		MaxConcurrentConnections:        sessionInfo.MaxConcurrentConnections,
+		SessionMaxConcurrentConnections: sessionInfo.SessionMaxConcurrentConnections,
+		ConnectionIdleTimeoutSeconds:    sessionInfo.ConnectionIdleTimeoutSeconds,
+		ProjectId:                       sessionInfo.ProjectId,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
@@ -1357,3 +1357,71 @@ func TestWorkerOperationalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerDrainRequested(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	serverRepo, _ := server.NewRepository(ctx, rw, rw, kms)
+	serverRepo.UpsertController(ctx, &store.Controller{
+		PrivateId: "test_controller1",
+		Address:   "127.0.0.1",
+	})
+	serversRepoFn := func() (*server.Repository, error) {
+		return serverRepo, nil
+	}
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return server.NewRepositoryStorage(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms)
+	}
+	connRepoFn := func() (*session.ConnectionRepository, error) {
+		return session.NewConnectionRepository(ctx, rw, rw, kms)
+	}
+	fce := &fakeControllerExtension{
+		reader: rw,
+		writer: rw,
+	}
+
+	worker1 := server.TestKmsWorker(t, conn, wrapper)
+
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
+	require.NotNil(t, s)
+
+	statusReq := func(state string) *pbs.StatusRequest {
+		return &pbs.StatusRequest{
+			WorkerStatus: &pb.ServerWorkerStatus{
+				PublicId:         worker1.GetPublicId(),
+				Name:             worker1.GetName(),
+				Address:          worker1.GetAddress(),
+				OperationalState: state,
+			},
+		}
+	}
+
+	got, err := s.Status(ctx, statusReq(server.ActiveOperationalState.String()))
+	require.NoError(t, err)
+	assert.False(t, got.GetDrainRequested())
+
+	_, err = serverRepo.RequestWorkerDrain(ctx, worker1.GetPublicId())
+	require.NoError(t, err)
+
+	// The request is repeated until the worker reports it is draining
+	got, err = s.Status(ctx, statusReq(server.ActiveOperationalState.String()))
+	require.NoError(t, err)
+	assert.True(t, got.GetDrainRequested())
+	got, err = s.Status(ctx, statusReq(server.ActiveOperationalState.String()))
+	require.NoError(t, err)
+	assert.True(t, got.GetDrainRequested())
+
+	got, err = s.Status(ctx, statusReq(server.DrainingOperationalState.String()))
+	require.NoError(t, err)
+	assert.False(t, got.GetDrainRequested())
+	repoWorker, err := serverRepo.LookupWorker(ctx, worker1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, server.DrainingOperationalState.String(), repoWorker.GetOperationalState())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 )
@@ -118,6 +119,9 @@ func WrapWithEventsHandler(ctx context.Context, h http.Handler, e *event.Eventer
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
 	}
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, endSpan := tracing.StartHttpServerSpan(r)
+		statusCode := http.StatusInternalServerError
+		defer func() { endSpan(statusCode) }()
 		ctx := r.Context()
 		publicId, _, _ := auth.GetTokenFromRequest(ctx, kms, r)
 
@@ -141,6 +145,9 @@ func WrapWithEventsHandler(ctx context.Context, h http.Handler, e *event.Eventer
 			Path:     r.URL.RequestURI(),
 			ClientIp: clientIp,
 		}
+		// Include the id of the trace being recorded so that events can be
+		// correlated with the spans of the request.
+		info.TraceId, _ = tracing.TraceId(ctx)
 		ctx, err = event.NewRequestInfoContext(ctx, info)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -176,6 +183,7 @@ func WrapWithEventsHandler(ctx context.Context, h http.Handler, e *event.Eventer
 			h.ServeHTTP(wrapper, r)
 
 			i, _ := wrapper.(interface{ StatusCode() int })
+			statusCode = i.StatusCode()
 			if err := flushGatedEvents(ctx, method, url, i.StatusCode(), start); err != nil {
 				// Intentionally not writing the header/response here, since the
 				// header and response have already been written.
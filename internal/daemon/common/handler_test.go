@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -27,8 +28,24 @@ import (
 	"github.com/hashicorp/go-sockaddr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
+func Test_GeneratedTraceId(t *testing.T) {
+	t.Parallel()
+	// The id of a trace is chosen by the caller, so it must not be used as
+	// the id of the request.
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	first, second := GeneratedTraceId(ctx), GeneratedTraceId(ctx)
+	assert.True(t, strings.HasPrefix(first, "gtraceid_"))
+	assert.NotEqual(t, first, second)
+	assert.NotContains(t, first, sc.TraceID().String())
+}
+
 func Test_WrapWithOptionals(t *testing.T) {
 	t.Parallel()
 	ctx := context.TODO()
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -32,6 +33,7 @@ import (
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -40,6 +42,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/mr-tron/base58"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -284,10 +287,22 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	var authResults perms.ACLResults
 	var userData template.Data
 	var err error
-	authResults, ret.UserData, ret.Scope, v.acl, ret.grants, err = v.performAuthCheck(ctx)
-	if err != nil {
+	checkStart := time.Now()
+	checkCtx, span := tracing.StartSpan(ctx, "auth.Verify",
+		attribute.String("boundary.resource.type", v.res.Type.String()),
+		attribute.String("boundary.action", v.act.String()))
+	authResults, ret.UserData, ret.Scope, v.acl, ret.grants, err = v.performAuthCheck(checkCtx)
+	span.SetAttributes(attribute.Bool("boundary.authorized", authResults.Authorized))
+	tracing.EndSpan(span, err)
+	switch {
+	case err != nil:
+		metric.RecordAclEvaluation(v.res.Type.String(), metric.AclError, time.Since(checkStart))
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error performing authn/authz check"))
 		return
+	case authResults.Authorized:
+		metric.RecordAclEvaluation(v.res.Type.String(), metric.AclAuthorized, time.Since(checkStart))
+	default:
+		metric.RecordAclEvaluation(v.res.Type.String(), metric.AclDenied, time.Since(checkStart))
 	}
 
 	if ret.UserData.User.Id != nil {
@@ -761,6 +776,20 @@ func (r *VerifyResults) FetchOutputFields(res perms.Resource, act action.Type) *
 	return r.v.acl.Allowed(res, act, *r.UserData.User.Id).OutputFields
 }
 
+// FetchMaxLifetime returns the maximum lifetime, from creation to expiration,
+// that the grants authorizing the action on the resource allow extending
+// sessions to, or zero if they don't limit it.
+func (r *VerifyResults) FetchMaxLifetime(res perms.Resource, act action.Type) time.Duration {
+	switch {
+	case r.v.requestInfo.TokenFormat == uint32(AuthTokenTypeRecoveryKms),
+		r.v.requestInfo.DisableAuthEntirely,
+		r.UserData.User.Id == nil:
+		return 0
+	}
+
+	return r.v.acl.Allowed(res, act, *r.UserData.User.Id).MaxLifetime
+}
+
 // ACL returns the perms.ACL of the verifier.
 func (r *VerifyResults) ACL() perms.ACL {
 	if r.v == nil {
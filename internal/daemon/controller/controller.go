@@ -169,6 +169,10 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	const op = "controller.New"
 	metric.InitializeApiCollectors(conf.PrometheusRegisterer)
 	ratelimit.InitializeMetrics(conf.PrometheusRegisterer)
+	metric.InitializeSessionCollectors(conf.PrometheusRegisterer)
+	metric.InitializeAuthCollectors(conf.PrometheusRegisterer)
+	session.InitializeMetrics(conf.PrometheusRegisterer)
+	vault.InitializeMetrics(conf.PrometheusRegisterer)
 	c := &Controller{
 		conf:                    conf,
 		logger:                  conf.Logger.Named("controller"),
@@ -591,7 +595,7 @@ func (c *Controller) registerJobs() error {
 	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
 		return err
 	}
-	var serverJobOpts []serversjob.Option
+	serverJobOpts := []serversjob.Option{serversjob.WithHostPlugins(c.conf.HostPlugins)}
 	if c.conf.TestOverrideWorkerAuthCaCertificateLifetime > 0 {
 		serverJobOpts = append(serverJobOpts,
 			serversjob.WithCertificateLifetime(c.conf.TestOverrideWorkerAuthCaCertificateLifetime),
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -39,6 +40,7 @@ func gatewayDialOptions(lis grpcServerListener) []grpc.DialOption {
 		}),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
 		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
 	}
 }
 
@@ -109,11 +111,13 @@ func newGrpcServer(
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(
+				tracing.StreamServerInterceptor(), // continue the trace of the http request
 				streamCtxInterceptor,
 			),
 		),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
+				tracing.UnaryServerInterceptor(),              // continue the trace of the http request
 				unaryCtxInterceptor,                           // populated requestInfo from headers into the request ctx
 				errorInterceptor(ctx),                         // convert domain and api errors into headers for the http proxy
 				subtypes.AttributeTransformerInterceptor(ctx), // convert to/from generic attributes from/to subtype specific attributes
@@ -39,6 +39,7 @@ const (
 	vaultPathField             = "attributes.path"
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
+	kvVersionField             = "attributes.kv_version"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
 	keyTypeField               = "attributes.key_type"
@@ -362,6 +363,7 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 		return nil, err
 	}
 	var currentCredentialType globals.CredentialType
+	var currentHttpMethod, currentKvVersion string
 	var mo vault.MappingOverride
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
@@ -376,10 +378,11 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 			return nil, err
 		}
 		currentCredentialType = globals.CredentialType(cur.GetCredentialType())
+		currentHttpMethod, currentKvVersion = cur.GetHttpMethod(), cur.GetKvVersion()
 		mo = cur.MappingOverride
 	}
 
-	if err := validateUpdateRequest(req, currentCredentialType); err != nil {
+	if err := validateUpdateRequest(req, currentCredentialType, currentHttpMethod, currentKvVersion); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Update)
@@ -790,6 +793,9 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if vaultIn.GetKvVersion() != "" {
+				attrs.KvVersion = wrapperspb.String(vaultIn.GetKvVersion())
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
 				VaultGenericCredentialLibraryAttributes: attrs,
 			}
@@ -867,6 +873,9 @@ func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.Credentia
 	if attrs.GetHttpRequestBody() != nil {
 		opts = append(opts, vault.WithRequestBody([]byte(attrs.GetHttpRequestBody().GetValue())))
 	}
+	if attrs.GetKvVersion() != nil {
+		opts = append(opts, vault.WithKvVersion(strings.ToLower(attrs.GetKvVersion().GetValue())))
+	}
 
 	credentialType := globals.CredentialType(in.GetCredentialType())
 	switch credentialType {
@@ -1053,6 +1062,14 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				if v := attrs.GetKvVersion(); v != nil {
+					switch {
+					case !vault.ValidKvVersion(strings.ToLower(v.GetValue())):
+						badFields[kvVersionField] = "If set, value must be 'latest' or a positive integer."
+					case strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST":
+						badFields[kvVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+					}
+				}
 				validateMapping(badFields, globals.CredentialType(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
 			case vault.SSHCertificateLibrarySubtype.String():
 				if req.GetItem().GetCredentialType() != "" {
@@ -1089,7 +1106,7 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCredentialType globals.CredentialType) error {
+func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCredentialType globals.CredentialType, currentHttpMethod, currentKvVersion string) error {
 	prefix := ""
 	st := globals.ResourceInfoFromPrefix(req.GetId()).Subtype
 	switch st {
@@ -1123,6 +1140,25 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				// A KV version requires the GET method, whether the
+				// method and version come from the request or storage.
+				method, kvVersion := strings.ToUpper(currentHttpMethod), currentKvVersion
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), httpMethodField) {
+					method = strings.ToUpper(attrs.GetHttpMethod().GetValue())
+				}
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kvVersionField) {
+					kvVersion = attrs.GetKvVersion().GetValue()
+				}
+				if v := attrs.GetKvVersion(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), kvVersionField) && v != nil {
+					switch {
+					case !vault.ValidKvVersion(strings.ToLower(v.GetValue())):
+						badFields[kvVersionField] = "If set, value must be 'latest' or a positive integer."
+					case method == "POST":
+						badFields[kvVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+					}
+				} else if method == "POST" && kvVersion != "" {
+					badFields[httpMethodField] = fmt.Sprintf("Cannot be set to 'POST' while %q is set.", kvVersionField)
+				}
 				validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 			}
 		case vault.SSHCertificateLibrarySubtype:
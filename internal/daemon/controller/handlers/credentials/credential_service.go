@@ -5,9 +5,9 @@ package credentials
 
 import (
 	"context"
-	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
@@ -27,7 +27,6 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -295,7 +294,7 @@ func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialReques
 func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredentialRequest) (*pbs.CreateCredentialResponse, error) {
 	const op = "credentials.(Service).CreateCredential"
 
-	if err := validateCreateRequest(req); err != nil {
+	if err := validateCreateRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetItem().GetCredentialStoreId(), action.Create)
@@ -342,7 +341,7 @@ func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredential
 	}
 	storeId := cur.GetStoreId()
 
-	if err := validateUpdateRequest(req); err != nil {
+	if err := validateUpdateRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Update)
@@ -838,7 +837,7 @@ func validateGetRequest(req *pbs.GetCredentialRequest) error {
 	)
 }
 
-func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
+func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		if !handlers.ValidId(handlers.Id(req.Item.GetCredentialStoreId()), globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix) {
@@ -863,24 +862,7 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 			if privateKey == "" {
 				badFields[privateKeyField] = "Field required for creating an SSH private key credential."
 			} else {
-				switch passphrase {
-				case "":
-					if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err != nil {
-						badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-					}
-				default:
-					if _, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase)); err != nil {
-						if errors.Is(err, x509.IncorrectPasswordError) {
-							badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
-						} else {
-							if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err == nil {
-								badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
-							} else {
-								badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-							}
-						}
-					}
-				}
+				validateSshPrivateKey(ctx, privateKey, passphrase, badFields)
 			}
 
 		case credential.JsonSubtype.String():
@@ -899,7 +881,29 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
+// validateSshPrivateKey ensures privateKey can be parsed and, if it is
+// encrypted, that passphrase decrypts it. Any problems are added to badFields.
+func validateSshPrivateKey(ctx context.Context, privateKey, passphrase string, badFields map[string]string) {
+	_, err := credential.SshPrivateKeySigner(ctx, credential.PrivateKey(privateKey), []byte(passphrase))
+	switch {
+	case err == nil:
+	case errors.Match(errors.T(errors.PrivateKeyPassphraseMissing), err):
+		badFields[privateKeyPassphraseField] = "Private key is encrypted and no passphrase was supplied."
+	case errors.Match(errors.T(errors.PrivateKeyPassphraseIncorrect), err):
+		badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
+	case errors.Match(errors.T(errors.PrivateKeyPassphraseUnneeded), err):
+		badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
+	default:
+		// Report the underlying parse failure rather than the wrapping
+		// boundary error.
+		for u := stderrors.Unwrap(err); u != nil; u = stderrors.Unwrap(err) {
+			err = u
+		}
+		badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
+	}
+}
+
+func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
@@ -923,24 +927,7 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 				if privateKey == "" {
 					badFields[privateKeyField] = "This is a required field and cannot be set to empty."
 				} else {
-					switch passphrase {
-					case "":
-						if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err != nil {
-							badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-						}
-					default:
-						if _, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase)); err != nil {
-							if errors.Is(err, x509.IncorrectPasswordError) {
-								badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
-							} else {
-								if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err == nil {
-									badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
-								} else {
-									badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-								}
-							}
-						}
-					}
+					validateSshPrivateKey(ctx, privateKey, passphrase, badFields)
 				}
 			}
 
@@ -44,6 +44,14 @@ const (
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	authMethodField        = "attributes.auth_method"
+	authMountPathField     = "attributes.auth_mount_path"
+	approleRoleIdField     = "attributes.approle_role_id"
+	approleSecretIdField   = "attributes.approle_secret_id"
+	approleSecretHmacField = "attributes.approle_secret_id_hmac"
+	kubernetesRoleField    = "attributes.kubernetes_role"
+	kubernetesJwtField     = "attributes.kubernetes_jwt"
+	kubernetesJwtHmacField = "attributes.kubernetes_jwt_hmac"
 	domain                 = "credential"
 )
 
@@ -84,7 +92,7 @@ func init() {
 	var err error
 	if maskManager, err = handlers.NewMaskManager(
 		context.Background(),
-		handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}},
+		handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}, &store.AuthMethod{}},
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}},
 	); err != nil {
 		panic(err)
@@ -486,7 +494,7 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 
 	switch item.Type {
 	case vault.Subtype.String():
-		cs, err := toStorageVaultStore(ctx, projId, item)
+		cs, err := toStorageVaultStore(ctx, projId, item, "")
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -533,16 +541,26 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.Subtype:
-		cs, err := toStorageVaultStore(ctx, projId, item)
+		repo, err := s.vaultRepoFn()
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		cs.PublicId = id
-
-		repo, err := s.vaultRepoFn()
+		// An update of auth method fields shared by every auth method
+		// applies to the auth method the store already uses.
+		var authMethodType vault.AuthMethodType
+		cur, err := repo.LookupCredentialStore(ctx, id)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
+		if cur != nil && cur.AuthMethod() != nil {
+			authMethodType = cur.AuthMethod().Type()
+		}
+		cs, err := toStorageVaultStore(ctx, projId, item, authMethodType)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
 		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
@@ -784,6 +802,36 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 				}
 				attrs.ClientCertificateKeyHmac = base64.RawURLEncoding.EncodeToString(cc.GetCertificateKeyHmac())
 			}
+			if am := vaultIn.AuthMethod(); am != nil {
+				attrs.AuthMethod = string(am.Type())
+				attrs.AuthMountPath = wrapperspb.String(am.GetMountPath())
+				if am.GetRoleId() != "" {
+					attrs.ApproleRoleId = wrapperspb.String(am.GetRoleId())
+				}
+				if len(am.GetSecretIdHmac()) != 0 {
+					attrs.ApproleSecretIdHmac = base64.RawURLEncoding.EncodeToString(am.GetSecretIdHmac())
+				}
+				if am.GetKubernetesRole() != "" {
+					attrs.KubernetesRole = wrapperspb.String(am.GetKubernetesRole())
+				}
+				if len(am.GetJwtHmac()) != 0 {
+					attrs.KubernetesJwtHmac = base64.RawURLEncoding.EncodeToString(am.GetJwtHmac())
+				}
+			}
+			if h := vaultIn.Health(); h != nil {
+				attrs.Health = &pb.VaultCredentialStoreHealth{
+					Status:               h.Status,
+					TokenExpirationTime:  h.TokenExpirationTime.GetTimestamp(),
+					MissingCapabilities:  h.MissingCapabilities,
+					LastCheckError:       h.LastCheckError,
+					LastCheckTime:        h.LastCheckTime.GetTimestamp(),
+					LastRenewalError:     h.LastRenewalError,
+					LastRenewalErrorTime: h.LastRenewalErrorTime.GetTimestamp(),
+				}
+				if h.VaultReachable != nil {
+					attrs.Health.VaultReachable = wrapperspb.Bool(*h.VaultReachable)
+				}
+			}
 
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
@@ -810,7 +858,9 @@ func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.Credential
 	return cs, err
 }
 
-func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
+// toStorageVaultStore converts in to a vault.CredentialStore. authMethodType
+// is the auth method of the stored credential store when in is an update.
+func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialStore, authMethodType vault.AuthMethodType) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
 	if in.GetName() != nil {
@@ -859,6 +909,14 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 		opts = append(opts, vault.WithClientCert(cc))
 	}
 
+	am, err := toStorageVaultAuthMethod(attrs, authMethodType)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am != nil {
+		opts = append(opts, vault.WithAuthMethod(am))
+	}
+
 	cs, err := vault.NewCredentialStore(scopeId, attrs.GetAddress().GetValue(), []byte(attrs.GetToken().GetValue()), opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
@@ -866,6 +924,26 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 	return cs, err
 }
 
+// toStorageVaultAuthMethod returns the vault auth method set in attrs or nil
+// if attrs does not contain any auth method fields. current is the auth
+// method of the stored credential store, it is used when attrs only
+// contains fields shared by every auth method.
+func toStorageVaultAuthMethod(attrs *pb.VaultCredentialStoreAttributes, current vault.AuthMethodType) (*vault.AuthMethod, error) {
+	var opts []vault.Option
+	if attrs.GetAuthMountPath().GetValue() != "" {
+		opts = append(opts, vault.WithMountPath(attrs.GetAuthMountPath().GetValue()))
+	}
+	hasAppRole := attrs.GetApproleRoleId() != nil || attrs.GetApproleSecretId() != nil
+	hasKubernetes := attrs.GetKubernetesRole() != nil || attrs.GetKubernetesJwt() != nil
+	switch {
+	case hasKubernetes, !hasAppRole && attrs.GetAuthMountPath() != nil && current == vault.KubernetesAuthMethod:
+		return vault.NewKubernetesAuthMethod(attrs.GetKubernetesRole().GetValue(), vault.AuthSecret(attrs.GetKubernetesJwt().GetValue()), opts...)
+	case hasAppRole, attrs.GetAuthMountPath() != nil:
+		return vault.NewAppRoleAuthMethod(attrs.GetApproleRoleId().GetValue(), vault.AuthSecret(attrs.GetApproleSecretId().GetValue()), opts...)
+	}
+	return nil, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -891,12 +969,43 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			if attrs.GetAddress().GetValue() == "" {
 				badFields[globals.AttributesAddressField] = "Field required for creating a vault credential store."
 			}
-			if attrs.GetToken().GetValue() == "" {
-				badFields[vaultTokenField] = "Field required for creating a vault credential store."
+			hasAppRole := attrs.GetApproleRoleId() != nil || attrs.GetApproleSecretId() != nil
+			hasKubernetes := attrs.GetKubernetesRole() != nil || attrs.GetKubernetesJwt() != nil
+			switch {
+			case hasAppRole && hasKubernetes:
+				badFields[kubernetesRoleField] = "Cannot set both approle and kubernetes auth method fields."
+			case hasAppRole:
+				if attrs.GetApproleRoleId().GetValue() == "" {
+					badFields[approleRoleIdField] = "Field required when using the approle auth method."
+				}
+				if attrs.GetApproleSecretId().GetValue() == "" {
+					badFields[approleSecretIdField] = "Field required when using the approle auth method."
+				}
+			case hasKubernetes:
+				if attrs.GetKubernetesRole().GetValue() == "" {
+					badFields[kubernetesRoleField] = "Field required when using the kubernetes auth method."
+				}
+				if attrs.GetKubernetesJwt().GetValue() == "" {
+					badFields[kubernetesJwtField] = "Field required when using the kubernetes auth method."
+				}
+			}
+			switch {
+			case hasAppRole || hasKubernetes:
+				if attrs.GetToken().GetValue() != "" {
+					badFields[vaultTokenField] = "Cannot set a token when using the approle or kubernetes auth method."
+				}
+			default:
+				if attrs.GetToken().GetValue() == "" {
+					badFields[vaultTokenField] = "Field required for creating a vault credential store."
+				}
+				if attrs.GetAuthMountPath() != nil {
+					badFields[authMountPathField] = "Can only be set when using the approle or kubernetes auth method."
+				}
 			}
 			if attrs.GetTokenHmac() != "" {
 				badFields[vaultTokenHmacField] = "This is a read only field."
 			}
+			validateVaultAuthMethodOutputFields(attrs, badFields)
 			if attrs.GetWorkerFilter().GetValue() != "" {
 				err := validateVaultWorkerFilterFn(attrs.WorkerFilter.GetValue())
 				if err != nil {
@@ -949,6 +1058,11 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 				if attrs.GetTokenHmac() != "" {
 					badFields[vaultTokenHmacField] = "This is a read only field."
 				}
+				if (attrs.GetApproleRoleId() != nil || attrs.GetApproleSecretId() != nil) &&
+					(attrs.GetKubernetesRole() != nil || attrs.GetKubernetesJwt() != nil) {
+					badFields[kubernetesRoleField] = "Cannot set both approle and kubernetes auth method fields."
+				}
+				validateVaultAuthMethodOutputFields(attrs, badFields)
 				if attrs.WorkerFilter.GetValue() != "" {
 					err := validateVaultWorkerFilterFn(attrs.WorkerFilter.GetValue())
 					if err != nil {
@@ -972,6 +1086,20 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
 }
 
+// validateVaultAuthMethodOutputFields adds an entry to badFields for each
+// output only auth method field set in attrs.
+func validateVaultAuthMethodOutputFields(attrs *pb.VaultCredentialStoreAttributes, badFields map[string]string) {
+	if attrs.GetAuthMethod() != "" {
+		badFields[authMethodField] = "This is a read only field."
+	}
+	if attrs.GetApproleSecretIdHmac() != "" {
+		badFields[approleSecretHmacField] = "This is a read only field."
+	}
+	if attrs.GetKubernetesJwtHmac() != "" {
+		badFields[kubernetesJwtHmacField] = "This is a read only field."
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
 }
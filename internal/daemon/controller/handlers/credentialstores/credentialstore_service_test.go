@@ -1646,6 +1646,111 @@ func TestUpdateStatic(t *testing.T) {
 	}
 }
 
+func TestVaultAppRoleAuthMethod(t *testing.T) {
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+	err := vault.RegisterJobs(testCtx, sche, rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(testCtx, rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(testCtx, rw, rw, kms)
+	}
+	credStoreServiceFn := func() (*credential.StoreRepository, error) {
+		return credential.NewStoreRepository(context.Background(), rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	require.NoError(t, err)
+
+	v := vault.NewTestVaultServer(t)
+	roleId, secretId := v.MountAppRole(t)
+	otherRoleId, otherSecretId := v.MountAppRole(t, vault.WithTestMountPath("approle/other/"))
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId: prj.GetPublicId(),
+		Type:    vault.Subtype.String(),
+		Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+			VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+				Address:         wrapperspb.String(v.Addr),
+				ApproleRoleId:   wrapperspb.String(roleId),
+				ApproleSecretId: wrapperspb.String(secretId),
+			},
+		},
+	}})
+	require.NoError(err)
+	attrs := created.GetItem().GetVaultCredentialStoreAttributes()
+	assert.Equal(string(vault.AppRoleAuthMethod), attrs.GetAuthMethod())
+	assert.Equal("approle", attrs.GetAuthMountPath().GetValue())
+	assert.Equal(roleId, attrs.GetApproleRoleId().GetValue())
+	assert.Empty(attrs.GetApproleSecretId())
+	assert.NotEmpty(attrs.GetApproleSecretIdHmac())
+	assert.NotEmpty(attrs.GetTokenHmac())
+
+	updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         created.GetItem().GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{authMountPathField, approleRoleIdField, approleSecretIdField}},
+		Item: &pb.CredentialStore{
+			Version: created.GetItem().GetVersion(),
+			Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+				VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+					AuthMountPath:   wrapperspb.String("approle/other"),
+					ApproleRoleId:   wrapperspb.String(otherRoleId),
+					ApproleSecretId: wrapperspb.String(otherSecretId),
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	attrs = updated.GetItem().GetVaultCredentialStoreAttributes()
+	assert.Equal(string(vault.AppRoleAuthMethod), attrs.GetAuthMethod())
+	assert.Equal("approle/other", attrs.GetAuthMountPath().GetValue())
+	assert.Equal(otherRoleId, attrs.GetApproleRoleId().GetValue())
+	assert.NotEqual(created.GetItem().GetVaultCredentialStoreAttributes().GetTokenHmac(), attrs.GetTokenHmac())
+
+	// A mount path only update keeps the approle auth method and fails to
+	// log in since the role does not exist on the mount.
+	_, err = s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         created.GetItem().GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{authMountPathField}},
+		Item: &pb.CredentialStore{
+			Version: updated.GetItem().GetVersion(),
+			Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+				VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+					AuthMountPath: wrapperspb.String("approle"),
+				},
+			},
+		},
+	})
+	assert.Error(err)
+
+	_, err = s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId: prj.GetPublicId(),
+		Type:    vault.Subtype.String(),
+		Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+			VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+				Address:        wrapperspb.String(v.Addr),
+				KubernetesRole: wrapperspb.String("role"),
+			},
+		},
+	}})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+}
+
 func TestListPagination(t *testing.T) {
 	// Set database read timeout to avoid duplicates in response
 	oldReadTimeout := globals.RefreshReadLookbackDuration
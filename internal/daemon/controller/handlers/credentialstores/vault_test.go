@@ -9,8 +9,11 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential/vault"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestPkAndClientCerts(t *testing.T) {
@@ -64,3 +67,169 @@ func TestPkAndClientCerts(t *testing.T) {
 		assert.Empty(t, c)
 	})
 }
+
+func TestToStorageVaultAuthMethod(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		attrs         *pb.VaultCredentialStoreAttributes
+		current       vault.AuthMethodType
+		wantNil       bool
+		wantType      vault.AuthMethodType
+		wantMountPath string
+	}{
+		{
+			name:    "no-auth-method",
+			attrs:   &pb.VaultCredentialStoreAttributes{Address: wrapperspb.String("http://vault")},
+			wantNil: true,
+		},
+		{
+			name: "approle",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				ApproleRoleId:   wrapperspb.String("role-id"),
+				ApproleSecretId: wrapperspb.String("secret-id"),
+			},
+			wantType:      vault.AppRoleAuthMethod,
+			wantMountPath: "approle",
+		},
+		{
+			name: "kubernetes",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				KubernetesRole: wrapperspb.String("role"),
+				KubernetesJwt:  wrapperspb.String("jwt"),
+				AuthMountPath:  wrapperspb.String("k8s/prod"),
+			},
+			wantType:      vault.KubernetesAuthMethod,
+			wantMountPath: "k8s/prod",
+		},
+		{
+			name:          "mount-path-on-create",
+			attrs:         &pb.VaultCredentialStoreAttributes{AuthMountPath: wrapperspb.String("other")},
+			wantType:      vault.AppRoleAuthMethod,
+			wantMountPath: "other",
+		},
+		{
+			name:          "mount-path-on-approle-store",
+			attrs:         &pb.VaultCredentialStoreAttributes{AuthMountPath: wrapperspb.String("other")},
+			current:       vault.AppRoleAuthMethod,
+			wantType:      vault.AppRoleAuthMethod,
+			wantMountPath: "other",
+		},
+		{
+			name:          "mount-path-on-kubernetes-store",
+			attrs:         &pb.VaultCredentialStoreAttributes{AuthMountPath: wrapperspb.String("other")},
+			current:       vault.KubernetesAuthMethod,
+			wantType:      vault.KubernetesAuthMethod,
+			wantMountPath: "other",
+		},
+		{
+			name: "approle-on-kubernetes-store",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				ApproleRoleId: wrapperspb.String("role-id"),
+				AuthMountPath: wrapperspb.String("other"),
+			},
+			current:       vault.KubernetesAuthMethod,
+			wantType:      vault.AppRoleAuthMethod,
+			wantMountPath: "other",
+		},
+		{
+			name:          "kubernetes-on-approle-store",
+			attrs:         &pb.VaultCredentialStoreAttributes{KubernetesJwt: wrapperspb.String("jwt")},
+			current:       vault.AppRoleAuthMethod,
+			wantType:      vault.KubernetesAuthMethod,
+			wantMountPath: "kubernetes",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := toStorageVaultAuthMethod(tt.attrs, tt.current)
+			require.NoError(err)
+			if tt.wantNil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(tt.wantType, got.Type())
+			assert.Equal(tt.wantMountPath, got.GetMountPath())
+		})
+	}
+}
+
+func TestValidateCreateRequest_VaultAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		attrs     *pb.VaultCredentialStoreAttributes
+		wantField string
+	}{
+		{
+			name: "approle",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				ApproleRoleId:   wrapperspb.String("role-id"),
+				ApproleSecretId: wrapperspb.String("secret-id"),
+			},
+		},
+		{
+			name: "approle-missing-secret-id",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				ApproleRoleId: wrapperspb.String("role-id"),
+			},
+			wantField: approleSecretIdField,
+		},
+		{
+			name: "kubernetes",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				KubernetesRole: wrapperspb.String("role"),
+				KubernetesJwt:  wrapperspb.String("jwt"),
+			},
+		},
+		{
+			name: "kubernetes-missing-jwt",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				KubernetesRole: wrapperspb.String("role"),
+			},
+			wantField: kubernetesJwtField,
+		},
+		{
+			name: "kubernetes-missing-role",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				KubernetesJwt: wrapperspb.String("jwt"),
+			},
+			wantField: kubernetesRoleField,
+		},
+		{
+			name: "kubernetes-with-token",
+			attrs: &pb.VaultCredentialStoreAttributes{
+				KubernetesRole: wrapperspb.String("role"),
+				KubernetesJwt:  wrapperspb.String("jwt"),
+				Token:          wrapperspb.String("token"),
+			},
+			wantField: vaultTokenField,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.attrs.Address = wrapperspb.String("http://vault")
+			req := &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: "p_1234567890",
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: tt.attrs,
+				},
+			}}
+			err := validateCreateRequest(ctx, req)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantField)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package host_catalogs
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"github.com/mr-tron/base58"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -46,12 +48,21 @@ var (
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
-	IdActions = action.NewActionSet(
-		action.NoOp,
-		action.Read,
-		action.Update,
-		action.Delete,
-	)
+	idActionsTypeMap = map[globals.Subtype]action.ActionSet{
+		static.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+			action.ImportHosts,
+		),
+		hostplugin.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
+	}
 
 	// CollectionActions contains the set of actions that can be performed on
 	// this collection
@@ -74,7 +85,11 @@ var (
 	}
 )
 
-const domain = "host"
+const (
+	domain        = "host"
+	hostsField    = "hosts"
+	hostSetsField = "host_sets"
+)
 
 func init() {
 	var err error
@@ -94,7 +109,7 @@ func init() {
 	}
 
 	// TODO: refactor to remove idActionsMap and CollectionActions package variables
-	action.RegisterResource(resource.HostCatalog, IdActions, CollectionActions)
+	action.RegisterResource(resource.HostCatalog, action.Union(maps.Values(idActionsTypeMap)...), CollectionActions)
 }
 
 type Service struct {
@@ -331,7 +346,8 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		idActions := idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -388,7 +404,8 @@ func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		idActions := idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -451,7 +468,8 @@ func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		idActions := idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -493,6 +511,31 @@ func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatal
 	return nil, nil
 }
 
+// ImportHosts implements the interface pbs.HostCatalogServiceServer.
+func (s Service) ImportHosts(ctx context.Context, req *pbs.ImportHostsRequest) (*pbs.ImportHostsResponse, error) {
+	const op = "host_catalogs.(Service).ImportHosts"
+
+	if err := validateImportHostsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ImportHosts)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	result, err := s.importHostsInRepo(ctx, authResults.Scope.GetId(), req)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.ImportHostsResponse{
+		HostsCreated:    uint32(result.HostsCreated),
+		HostsUpdated:    uint32(result.HostsUpdated),
+		HostsDeleted:    uint32(result.HostsDeleted),
+		HostSetsCreated: uint32(result.HostSetsCreated),
+		HostSetsUpdated: uint32(result.HostSetsUpdated),
+		HostSetsDeleted: uint32(result.HostSetsDeleted),
+	}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plugins.PluginInfo, error) {
 	var plg *plugins.PluginInfo
 	var cat host.Catalog
@@ -685,6 +728,44 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
+func (s Service) importHostsInRepo(ctx context.Context, projId string, req *pbs.ImportHostsRequest) (*static.ImportResult, error) {
+	const op = "host_catalogs.(Service).importHostsInRepo"
+	hosts := make([]*static.Host, 0, len(req.GetHosts()))
+	for _, h := range req.GetHosts() {
+		sh, err := static.NewHost(ctx, req.GetId(),
+			static.WithName(h.GetName()),
+			static.WithDescription(h.GetDescription()),
+			static.WithAddress(h.GetAddress()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build host for import"))
+		}
+		hosts = append(hosts, sh)
+	}
+	sets := make([]*static.HostSet, 0, len(req.GetHostSets()))
+	members := make(map[string][]string, len(req.GetHostSets()))
+	for _, hs := range req.GetHostSets() {
+		ss, err := static.NewHostSet(ctx, req.GetId(),
+			static.WithName(hs.GetName()),
+			static.WithDescription(hs.GetDescription()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build host set for import"))
+		}
+		sets = append(sets, ss)
+		if len(hs.GetHostNames()) > 0 {
+			members[hs.GetName()] = hs.GetHostNames()
+		}
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	result, err := repo.ImportHosts(ctx, projId, req.GetId(), hosts, sets, members)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to import hosts"))
+	}
+	return result, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -772,7 +853,8 @@ func newOutputOpts(
 		Id:      item.GetPublicId(),
 		ScopeId: item.GetProjectId(),
 	}
-	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+	idActions := idActionsTypeMap[globals.ResourceInfoFromPrefix(item.GetPublicId()).Subtype]
+	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
 	if len(authorizedActions) == 0 {
 		return nil, false, nil
 	}
@@ -996,6 +1078,44 @@ func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
 }
 
+func validateImportHostsRequest(req *pbs.ImportHostsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.StaticHostCatalogPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	hostNames := make(map[string]bool, len(req.GetHosts()))
+	for _, h := range req.GetHosts() {
+		switch {
+		case h.GetName() == "":
+			badFields[hostsField] = "Each host must have a name."
+		case hostNames[h.GetName()]:
+			badFields[hostsField] = fmt.Sprintf("Duplicate host name %q.", h.GetName())
+		case len(strings.TrimSpace(h.GetAddress())) < static.MinHostAddressLength || len(strings.TrimSpace(h.GetAddress())) > static.MaxHostAddressLength:
+			badFields[hostsField] = fmt.Sprintf("Host %q must have an address between %d and %d characters long.", h.GetName(), static.MinHostAddressLength, static.MaxHostAddressLength)
+		}
+		hostNames[h.GetName()] = true
+	}
+	setNames := make(map[string]bool, len(req.GetHostSets()))
+	for _, hs := range req.GetHostSets() {
+		switch {
+		case hs.GetName() == "":
+			badFields[hostSetsField] = "Each host set must have a name."
+		case setNames[hs.GetName()]:
+			badFields[hostSetsField] = fmt.Sprintf("Duplicate host set name %q.", hs.GetName())
+		}
+		setNames[hs.GetName()] = true
+		for _, n := range hs.GetHostNames() {
+			if !hostNames[n] {
+				badFields[hostSetsField] = fmt.Sprintf("Host set %q contains unknown host %q.", hs.GetName(), n)
+			}
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListHostCatalogsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
@@ -82,7 +82,10 @@ var authorizedCollectionActions = map[globals.Subtype]map[string]*structpb.ListV
 	},
 }
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = map[globals.Subtype][]string{
+	static.Subtype:     {"no-op", "read", "update", "delete", "import-hosts"},
+	hostplugin.Subtype: {"no-op", "read", "update", "delete"},
+}
 
 func pluginCatalogToProto(hc *hostplugin.HostCatalog, plg *plugin.Plugin, project *iam.Scope) *pb.HostCatalog {
 	return &pb.HostCatalog{
@@ -100,7 +103,7 @@ func pluginCatalogToProto(hc *hostplugin.HostCatalog, plg *plugin.Plugin, projec
 		Version:                     1,
 		Type:                        hostplugin.Subtype.String(),
 		SecretsHmac:                 base58.Encode(hc.SecretsHmac),
-		AuthorizedActions:           testAuthorizedActions,
+		AuthorizedActions:           testAuthorizedActions[hostplugin.Subtype],
 		AuthorizedCollectionActions: authorizedCollectionActions[hostplugin.Subtype],
 	}
 }
@@ -114,7 +117,7 @@ func staticCatalogToProto(hc *static.HostCatalog, project *iam.Scope) *pb.HostCa
 		UpdatedTime:                 hc.UpdateTime.GetTimestamp(),
 		Version:                     1,
 		Type:                        "static",
-		AuthorizedActions:           testAuthorizedActions,
+		AuthorizedActions:           testAuthorizedActions[static.Subtype],
 		AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 	}
 }
@@ -1151,7 +1154,7 @@ func TestCreate_Static(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "name"},
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1333,7 +1336,7 @@ func TestCreate_Plugin(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "name"},
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					Type:                        hostplugin.Subtype.String(),
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[hostplugin.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[hostplugin.Subtype],
 				},
 			},
@@ -1535,7 +1538,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1561,7 +1564,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1616,7 +1619,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1639,7 +1642,7 @@ func TestUpdate_Static(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1664,7 +1667,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1689,7 +1692,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "notignored"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
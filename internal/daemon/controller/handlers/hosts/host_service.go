@@ -78,16 +78,17 @@ func init() {
 type Service struct {
 	pbs.UnsafeHostServiceServer
 
-	staticRepoFn common.StaticRepoFactory
-	pluginRepoFn common.PluginHostRepoFactory
-	maxPageSize  uint
+	staticRepoFn  common.StaticRepoFactory
+	pluginRepoFn  common.PluginHostRepoFactory
+	serversRepoFn common.ServersRepoFactory
+	maxPageSize   uint
 }
 
 var _ pbs.HostServiceServer = (*Service)(nil)
 
 // NewService returns a host Service which handles host related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, serversRepoFn common.ServersRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "hosts.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static repository")
@@ -95,10 +96,13 @@ func NewService(ctx context.Context, repoFn common.StaticRepoFactory, pluginRepo
 	if pluginRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin host repository")
 	}
+	if serversRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing servers repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, maxPageSize: maxPageSize}, nil
+	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, serversRepoFn: serversRepoFn, maxPageSize: maxPageSize}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
@@ -317,6 +321,12 @@ func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.Get
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.HealthField) {
+		item.Health, err = s.hostHealth(ctx, h.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetHostResponse{Item: item}, nil
 }
@@ -650,6 +660,29 @@ func newOutputOpts(ctx context.Context, item host.Host, plg *plugin.Plugin, auth
 	return outputOpts, true
 }
 
+// hostHealth returns the most recent health check of the host with hostId,
+// or nil if the host has not been checked.
+func (s Service) hostHealth(ctx context.Context, hostId string) (*pb.HostHealth, error) {
+	repo, err := s.serversRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.LookupHostHealthCheck(ctx, hostId)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, nil
+	}
+	return &pb.HostHealth{
+		Status:            string(c.CurrentStatus()),
+		Port:              c.Port,
+		LastCheckTime:     c.LastCheckTime.GetTimestamp(),
+		LastCheckError:    c.LastCheckError,
+		LastCheckWorkerId: c.LastCheckWorkerId,
+	}, nil
+}
+
 func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Host, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
@@ -115,6 +115,9 @@ func TestGet_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 	s := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
@@ -155,7 +158,7 @@ func TestGet_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -206,6 +209,9 @@ func TestGet_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := hostplugin.TestHost(t, conn, hc.GetPublicId(), "test", hostplugin.WithExternalName("test-ext-name"))
 	hPrev := hostplugin.TestHost(t, conn, hc.GetPublicId(), "test-prev",
@@ -263,7 +269,7 @@ func TestGet_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -310,6 +316,9 @@ func TestList_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
@@ -383,7 +392,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -442,6 +451,9 @@ func TestList_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hcs := hostplugin.TestCatalogs(t, conn, proj.GetPublicId(), plg.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 	hs := hostplugin.TestSet(t, conn, kms, sche, hc, plgm)
@@ -517,7 +529,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -634,7 +646,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := hosts.NewService(ctx, staticRepoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(ctx, staticRepoFn, pluginRepoFn, serversRepoFn, 1000)
 	require.NoError(t, err)
 
 	t.Run("static-hosts", func(t *testing.T) {
@@ -1110,6 +1122,9 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -1117,7 +1132,7 @@ func TestDelete(t *testing.T) {
 	pluginHc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	pluginH := hostplugin.TestHost(t, conn, pluginHc.GetPublicId(), "test")
 
-	s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -1202,10 +1217,13 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(testCtx, rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(testCtx, repoFn, pluginRepoFn, 1000)
+	s, err := hosts.NewService(testCtx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostRequest{
 		Id: h.GetPublicId(),
@@ -1240,6 +1258,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
 	plg := plugin.TestPlugin(t, conn, "test")
@@ -1417,7 +1438,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+			s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -1484,6 +1505,9 @@ func TestUpdate_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -1507,7 +1531,7 @@ func TestUpdate_Static(t *testing.T) {
 
 	hCreated := h.GetCreateTime().GetTimestamp().AsTime()
 
-	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1959,12 +1983,15 @@ func TestUpdate_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
 
 	plg := plugin.TestPlugin(t, conn, "test")
 	hc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := hostplugin.TestHost(t, conn, hc.GetPublicId(), "test")
 
-	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	tested, err := hosts.NewService(ctx, repoFn, pluginRepoFn, serversRepoFn, 1000)
 	require.NoError(t, err)
 
 	got, err := tested.UpdateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.UpdateHostRequest{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/db"
@@ -17,6 +18,7 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -102,6 +104,9 @@ func BenchmarkSessionList(b *testing.B) {
 			sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 				return session.NewRepository(ctx, rw, rw, kmsThing, opt...)
 			}
+			vaultRepoFn := func() (*vault.Repository, error) {
+				return vault.NewRepository(ctx, rw, rw, kmsThing, scheduler.TestScheduler(b, conn, wrap))
+			}
 			authTokenRepoFn := func() (*authtoken.Repository, error) {
 				return authTokenRepo, nil
 			}
@@ -109,7 +114,7 @@ func BenchmarkSessionList(b *testing.B) {
 				return serversRepo, nil
 			}
 
-			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 			require.NoError(b, err)
 
 			var users []*userWithToken
@@ -7,6 +7,7 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -37,6 +38,8 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.Extend,
+		action.ExtendSelf,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -57,13 +60,18 @@ type Service struct {
 
 	repoFn      session.RepositoryFactory
 	iamRepoFn   common.IamRepoFactory
+	vaultRepoFn common.VaultCredentialRepoFactory
 	maxPageSize uint
+	maxLifetime time.Duration
 }
 
 var _ pbs.SessionServiceServer = (*Service)(nil)
 
-// NewService returns a session service which handles session related requests to boundary.
-func NewService(ctx context.Context, repoFn session.RepositoryFactory, iamRepoFn common.IamRepoFactory, maxPageSize uint) (Service, error) {
+// NewService returns a session service which handles session related requests
+// to boundary. Sessions cannot be extended beyond maxLifetime, or the max
+// lifetime of the grants allowing the extension if it is shorter; if it is zero,
+// session.DefaultMaxLifetime is used.
+func NewService(ctx context.Context, repoFn session.RepositoryFactory, iamRepoFn common.IamRepoFactory, vaultRepoFn common.VaultCredentialRepoFactory, maxPageSize uint, maxLifetime time.Duration) (Service, error) {
 	const op = "sessions.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing session repository")
@@ -71,10 +79,16 @@ func NewService(ctx context.Context, repoFn session.RepositoryFactory, iamRepoFn
 	if iamRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
+	if vaultRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, maxPageSize: maxPageSize}, nil
+	if maxLifetime == 0 {
+		maxLifetime = session.DefaultMaxLifetime
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, vaultRepoFn: vaultRepoFn, maxPageSize: maxPageSize, maxLifetime: maxLifetime}, nil
 }
 
 // GetSessions implements the interface pbs.SessionServiceServer.
@@ -127,6 +141,12 @@ func (s Service) GetSession(ctx context.Context, req *pbs.GetSessionRequest) (*p
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.KvSecretVersionChangedField) {
+		item.KvSecretVersionChanged, err = s.kvSecretVersionChanged(ctx, ses.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetSessionResponse{Item: item}, nil
 }
@@ -366,6 +386,169 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ExtendSession implements the interface pbs.SessionServiceServer.
+func (s Service) ExtendSession(ctx context.Context, req *pbs.ExtendSessionRequest) (*pbs.ExtendSessionResponse, error) {
+	const op = "sessions.(Service).ExtendSession"
+
+	if err := validateExtendRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ExtendSelf, false)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ses, _, err := repo.LookupSession(ctx, req.GetId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+		}
+		return nil, err
+	}
+	if ses == nil {
+		return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+	}
+
+	var outputFields *perms.OutputFields
+	authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+	sessionResource := perms.Resource{
+		Id:      ses.GetPublicId(),
+		ScopeId: ses.ProjectId,
+		Type:    resource.Session,
+	}
+
+	// Check to see if we need to verify Extend vs. just ExtendSelf
+	extendAction := action.ExtendSelf
+	if ses.UserId != authResults.UserId {
+		if !authorizedActions.HasAction(action.Extend) {
+			return nil, handlers.ForbiddenError()
+		}
+		extendAction = action.Extend
+		outputFields = authResults.FetchOutputFields(sessionResource, action.Extend).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	if len(ses.States) > 0 {
+		switch ses.States[0].Status {
+		case session.StatusPending, session.StatusActive:
+		default:
+			return nil, handlers.ConflictErrorf(fmt.Sprintf("Session %q is %s and cannot be extended.", req.GetId(), ses.States[0].Status))
+		}
+	}
+
+	// The grants allowing the extension can limit the lifetime of the
+	// session further than the controller does.
+	maxLifetime := s.maxLifetime
+	if grantMax := authResults.FetchMaxLifetime(sessionResource, extendAction); grantMax > 0 && grantMax < maxLifetime {
+		maxLifetime = grantMax
+	}
+	ses, err = repo.ExtendSession(ctx, req.GetId(), req.GetVersion(), time.Duration(req.GetExtensionSeconds())*time.Second, session.WithMaxLifetime(maxLifetime))
+	if err != nil {
+		if errors.Match(errors.T(errors.InvalidSessionState), err) {
+			return nil, handlers.ConflictErrorf(fmt.Sprintf("Session %q cannot be extended: it has expired, reached its maximum lifetime of %s, already expires later, or the version does not match.", req.GetId(), maxLifetime))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to extend session"))
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ExtendSessionResponse{Item: item}, nil
+}
+
+// CancelSessionConnection implements the interface pbs.SessionServiceServer.
+func (s Service) CancelSessionConnection(ctx context.Context, req *pbs.CancelSessionConnectionRequest) (*pbs.CancelSessionConnectionResponse, error) {
+	const op = "sessions.(Service).CancelSessionConnection"
+
+	if err := validateCancelConnectionRequest(req); err != nil {
+		return nil, err
+	}
+	// Ignore decryption failures to ensure the user can always cancel a connection.
+	authResults := s.authResult(ctx, req.GetId(), action.CancelSelf, true)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ses, _, err := repo.LookupSession(ctx, req.GetId(), session.WithIgnoreDecryptionFailures(true))
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+		}
+		return nil, err
+	}
+	if ses == nil {
+		return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+	}
+
+	var outputFields *perms.OutputFields
+	authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+
+	// Canceling a single connection requires the same permissions as canceling
+	// the whole session, so check Cancel vs. just CancelSelf.
+	if ses.UserId != authResults.UserId {
+		if !authorizedActions.HasAction(action.Cancel) {
+			return nil, handlers.ForbiddenError()
+		}
+		outputFields = authResults.FetchOutputFields(perms.Resource{
+			Id:      ses.GetPublicId(),
+			ScopeId: ses.ProjectId,
+			Type:    resource.Session,
+		}, action.Cancel).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	ses, err = repo.CancelConnection(ctx, req.GetId(), req.GetConnectionId(), session.WithIgnoreDecryptionFailures(true))
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Session %q has no open connection %q.", req.GetId(), req.GetConnectionId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to cancel connection"))
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.CancelSessionConnectionResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -384,6 +567,26 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session,
 	return sess, nil
 }
 
+// kvSecretVersionChanged reports whether a Vault KV version 2 secret issued
+// to the session has a different version than the one its library issued to
+// the previous session.
+func (s Service) kvSecretVersionChanged(ctx context.Context, id string) (bool, error) {
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return false, err
+	}
+	versions, err := repo.ListSessionKvSecretVersions(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range versions {
+		if v.SecretVersionChanged {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type, ignoreSessionDecryptionFailure bool) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -406,7 +609,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, ignor
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.Extend, action.ExtendSelf:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -518,7 +721,8 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 		if outputFields.Has(globals.ConnectionsField) {
 			connections := make([]*pb.Connection, 0, len(in.Connections))
 			for _, c := range in.Connections {
-				connections = append(connections, &pb.Connection{
+				pbConn := &pb.Connection{
+					Id:                 c.PublicId,
 					ClientTcpAddress:   c.ClientTcpAddress,
 					ClientTcpPort:      c.ClientTcpPort,
 					EndpointTcpAddress: c.EndpointTcpAddress,
@@ -526,7 +730,11 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 					BytesUp:            c.BytesUp,
 					BytesDown:          c.BytesDown,
 					ClosedReason:       c.ClosedReason,
-				})
+				}
+				if c.CreateTime != nil {
+					pbConn.CreatedTime = c.CreateTime.GetTimestamp()
+				}
+				connections = append(connections, pbConn)
 			}
 			out.Connections = append(out.Connections, connections...)
 		}
@@ -573,6 +781,34 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	return nil
 }
 
+func validateExtendRequest(req *pbs.ExtendSessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateCancelConnectionRequest(req *pbs.CancelSessionConnectionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetConnectionId()), session.ConnectionPrefix) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item *session.Session, scopeIds map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		Type:    resource.Session,
@@ -14,10 +14,12 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -25,6 +27,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -57,6 +60,9 @@ func TestGetSession(t *testing.T) {
 	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 		return session.NewRepository(ctx, rw, rw, kms, opt...)
 	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -140,7 +146,7 @@ func TestGetSession(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			requestInfo := authpb.RequestInfo{
@@ -170,6 +176,32 @@ func TestGetSession(t *testing.T) {
 			), "GetSession(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
 		})
 	}
+
+	t.Run("Get a session issued a changed kv secret version", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cs := vault.TestCredentialStores(t, conn, wrap, p.GetPublicId(), 1)[0]
+		lib := vault.TestCredentialLibraries(t, conn, wrap, cs.GetPublicId(), 1)[0]
+		_, err := rw.Exec(ctx, `
+insert into credential_vault_session_kv_secret_version
+  (session_id, library_id, vault_path, secret_version, previous_secret_version, secret_version_changed)
+values
+  (?, ?, 'secret/data/app', 2, 1, true)`, []any{sess.GetPublicId(), lib.GetPublicId()})
+		require.NoError(err)
+
+		s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
+		require.NoError(err, "Couldn't create new session service.")
+		requestInfo := authpb.RequestInfo{
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+		ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+
+		got, err := s.GetSession(ctx, &pbs.GetSessionRequest{Id: sess.GetPublicId()})
+		require.NoError(err)
+		assert.True(got.GetItem().GetKvSecretVersionChanged())
+	})
 }
 
 func TestList_Self(t *testing.T) {
@@ -187,6 +219,9 @@ func TestList_Self(t *testing.T) {
 	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 		return session.NewRepository(ctx, rw, rw, kms, opt...)
 	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -234,7 +269,7 @@ func TestList_Self(t *testing.T) {
 		Endpoint:    "tcp://127.0.0.1:22",
 	})
 
-	s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+	s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 	require.NoError(t, err, "Couldn't create new session service.")
 
 	cases := []struct {
@@ -299,6 +334,9 @@ func TestList(t *testing.T) {
 	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 		return session.NewRepository(ctx, rw, rw, kms, opt...)
 	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -632,7 +670,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			require, assert := require.New(t), assert.New(t)
-			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			// Test without anon user
@@ -739,6 +777,9 @@ func TestListPagination(t *testing.T) {
 	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 		return session.NewRepository(ctx, rw, rw, kms, opt...)
 	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -814,7 +855,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+	s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 	require.NoError(t, err, "Couldn't create new session service.")
 
 	// Start paginating, recursively
@@ -1123,6 +1164,9 @@ func TestCancel(t *testing.T) {
 	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
 		return session.NewRepository(ctx, rw, rw, kms, opt...)
 	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1212,7 +1256,7 @@ func TestCancel(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			tc.req.Version = version
@@ -1294,3 +1338,229 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestExtend(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opt...)
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, p.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	role := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "ids=*;type=session;actions=extend:self")
+	iam.TestUserRole(t, conn, role.GetPublicId(), uId)
+
+	const lifetime = time.Minute
+	sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+		UserId:         uId,
+		HostId:         h.GetPublicId(),
+		TargetId:       tar.GetPublicId(),
+		HostSetId:      hs.GetPublicId(),
+		AuthTokenId:    at.GetPublicId(),
+		ProjectId:      p.GetPublicId(),
+		Endpoint:       "tcp://127.0.0.1:22",
+		ExpirationTime: timestamp.New(time.Now().Add(lifetime)),
+	})
+
+	cases := []struct {
+		name string
+		req  *pbs.ExtendSessionRequest
+		err  error
+	}{
+		{
+			name: "Extend a session",
+			req:  &pbs.ExtendSessionRequest{Id: sess.GetPublicId(), Version: sess.Version, ExtensionSeconds: 3600},
+		},
+		{
+			name: "Stale version",
+			req:  &pbs.ExtendSessionRequest{Id: sess.GetPublicId(), Version: sess.Version},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name: "Extend a non existing Session",
+			req:  &pbs.ExtendSessionRequest{Id: globals.SessionPrefix + "_DoesntExis", Version: 1},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Missing version",
+			req:  &pbs.ExtendSessionRequest{Id: sess.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ExtendSessionRequest{Id: "j_1234567890", Version: 1},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	// Sleep so that extending by the original lifetime moves the expiration
+	// time.
+	time.Sleep(time.Second)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
+			require.NoError(err, "Couldn't create new session service.")
+
+			requestInfo := authpb.RequestInfo{
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    at.GetPublicId(),
+				Token:       at.GetToken(),
+			}
+			requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+			ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			got, gErr := s.ExtendSession(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ExtendSession(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			exp := got.GetItem().GetExpirationTime().AsTime()
+			assert.True(exp.After(sess.ExpirationTime.AsTime()))
+			// A single extension is limited to the original lifetime.
+			assert.False(exp.After(time.Now().Add(lifetime)))
+			assert.Equal(sess.Version+1, got.GetItem().GetVersion())
+		})
+	}
+}
+
+func TestCancelConnection(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opt...)
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, scheduler.TestScheduler(t, conn, wrap))
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, p.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	role := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "ids=*;type=session;actions=cancel:self")
+	iam.TestUserRole(t, conn, role.GetPublicId(), uId)
+
+	sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+		UserId:      uId,
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   p.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+	})
+	c1 := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 22, "127.0.0.1", 222, "127.0.0.1")
+	c2 := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 23, "127.0.0.1", 222, "127.0.0.1")
+
+	cases := []struct {
+		name string
+		req  *pbs.CancelSessionConnectionRequest
+		err  error
+	}{
+		{
+			name: "Cancel a connection",
+			req:  &pbs.CancelSessionConnectionRequest{Id: sess.GetPublicId(), ConnectionId: c1.GetPublicId()},
+		},
+		{
+			name: "Cancel an already closed connection",
+			req:  &pbs.CancelSessionConnectionRequest{Id: sess.GetPublicId(), ConnectionId: c1.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Cancel a connection of a non existing Session",
+			req:  &pbs.CancelSessionConnectionRequest{Id: globals.SessionPrefix + "_DoesntExis", ConnectionId: c2.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong connection id prefix",
+			req:  &pbs.CancelSessionConnectionRequest{Id: sess.GetPublicId(), ConnectionId: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.CancelSessionConnectionRequest{Id: "j_1234567890", ConnectionId: c2.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, vaultRepoFn, 1000, 0)
+			require.NoError(err, "Couldn't create new session service.")
+
+			requestInfo := authpb.RequestInfo{
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    at.GetPublicId(),
+				Token:       at.GetToken(),
+			}
+			requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+			ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			got, gErr := s.CancelSessionConnection(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CancelSessionConnection(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			reasons := make(map[string]string, len(got.GetItem().GetConnections()))
+			for _, c := range got.GetItem().GetConnections() {
+				reasons[c.GetId()] = c.GetClosedReason()
+			}
+			assert.Equal(session.ConnectionCanceled.String(), reasons[c1.GetPublicId()])
+			assert.Empty(reasons[c2.GetPublicId()])
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+// hostSourceEndpoints returns the endpoints of the hosts in hostSources.
+func (s Service) hostSourceEndpoints(ctx context.Context, hostSources []target.HostSource) ([]*host.Endpoint, error) {
+	staticHostRepo, err := s.staticHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	pluginHostRepo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginHostSetIds []string
+	var endpoints []*host.Endpoint
+	for _, hSource := range hostSources {
+		hsId := hSource.Id()
+		switch globals.ResourceInfoFromPrefix(hsId).Subtype {
+		case static.Subtype:
+			eps, err := staticHostRepo.Endpoints(ctx, hsId)
+			if err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, eps...)
+		default:
+			// Batch the plugin host set ids since each round trip to the plugin
+			// has the potential to be expensive.
+			pluginHostSetIds = append(pluginHostSetIds, hsId)
+		}
+	}
+	if len(pluginHostSetIds) > 0 {
+		eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, eps...)
+	}
+	return endpoints, nil
+}
+
+// registerHostHealthChecks registers a host health check for each endpoint
+// of t if t has host health checks enabled, and removes the checks of t
+// otherwise. Targets without a default port or with an address have no
+// endpoints to check. It is called after t or its host sources change so
+// the hosts of t are checked before a session is requested. Errors are only
+// logged since the change to t has already been made; the host health check
+// registration job registers the checks of t on its next run.
+func (s Service) registerHostHealthChecks(ctx context.Context, t target.Target) {
+	const op = "targets.(Service).registerHostHealthChecks"
+	if t == nil {
+		return
+	}
+	serversRepo, err := s.serversRepoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting servers repository"))
+		return
+	}
+	if !t.GetEnableHostHealthChecks() || t.GetDefaultPort() == 0 || t.GetAddress() != "" || len(t.GetHostSources()) == 0 {
+		if _, err := serversRepo.DeleteTargetHostHealthChecks(ctx, t.GetPublicId()); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error removing host health checks", "target_id", t.GetPublicId()))
+		}
+		return
+	}
+	endpoints, err := s.hostSourceEndpoints(ctx, t.GetHostSources())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting target endpoints", "target_id", t.GetPublicId()))
+		return
+	}
+	if _, err := serversRepo.UpsertTargetHostHealthChecks(ctx, t.GetPublicId(), t.GetDefaultPort(), endpoints); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error registering host health checks", "target_id", t.GetPublicId()))
+	}
+}
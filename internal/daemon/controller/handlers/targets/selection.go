@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+// orderWorkers orders workers in place according to strategy. Clients try the
+// workers in the returned order. userId is the id of the user the session is
+// for and is used by the sticky strategy. Unknown strategies are treated as
+// the random strategy.
+func orderWorkers(strategy target.WorkerSelectionStrategy, workers []*server.Worker, userId string) {
+	// Shuffling first breaks ties randomly for the strategies which sort the
+	// workers.
+	rand.Shuffle(len(workers), func(i, j int) {
+		workers[i], workers[j] = workers[j], workers[i]
+	})
+
+	switch strategy {
+	case target.LeastConnectionsWorkerSelection:
+		sort.SliceStable(workers, func(i, j int) bool {
+			return workers[i].ActiveConnectionCount() < workers[j].ActiveConnectionCount()
+		})
+
+	case target.LatencyWeightedWorkerSelection:
+		// Workers which have not reported a round trip time are treated as
+		// having the average round trip time of the other workers. Workers
+		// report a round trip time of at least 1ms once it is known, so a
+		// round trip time of 0 means it is unknown.
+		var total, known float64
+		for _, w := range workers {
+			if rtt := w.GetStatusRttMs(); rtt > 0 {
+				total += float64(rtt)
+				known++
+			}
+		}
+		defaultRtt := 1.0
+		if known > 0 {
+			defaultRtt = total / known
+		}
+		// This is a weighted random permutation where the weight of a worker
+		// is the inverse of its round trip time: each worker gets the key
+		// u^(1/weight) for a random u in [0, 1) and the workers are sorted by
+		// their key in descending order. The logarithm of the key is used
+		// since the key underflows to 0 for round trip times of a few hundred
+		// milliseconds, which would leave the slow workers in random order.
+		keys := make(map[*server.Worker]float64, len(workers))
+		for _, w := range workers {
+			rtt := defaultRtt
+			if w.GetStatusRttMs() > 0 {
+				rtt = float64(w.GetStatusRttMs())
+			}
+			keys[w] = math.Log(rand.Float64()) * rtt
+		}
+		sort.SliceStable(workers, func(i, j int) bool {
+			return keys[workers[i]] > keys[workers[j]]
+		})
+
+	case target.StickyWorkerSelection:
+		// Rendezvous hashing keeps the order of the workers for a user stable
+		// and only moves the sessions of the affected users when a worker is
+		// added or removed.
+		scores := make(map[*server.Worker]uint64, len(workers))
+		for _, w := range workers {
+			h := fnv.New64a()
+			_, _ = h.Write([]byte(userId))
+			_, _ = h.Write([]byte{0})
+			_, _ = h.Write([]byte(w.GetPublicId()))
+			scores[w] = h.Sum64()
+		}
+		sort.SliceStable(workers, func(i, j int) bool {
+			return scores[workers[i]] > scores[workers[j]]
+		})
+	}
+}
+
+// hostRoundRobin keeps a counter per target which the round-robin host
+// selection strategy uses to pick the next host of the target. The counters
+// are kept in memory and are not shared between controllers, so with more
+// than one controller each controller cycles through the hosts of a target
+// on its own and the rotation across all sessions is approximate. The
+// counters of deleted targets are never removed, which is acceptable since a
+// counter is only a few bytes.
+type hostRoundRobin struct {
+	counters sync.Map // map[string]*atomic.Uint64
+}
+
+// next returns the next turn of the target targetId. Concurrent calls for
+// the same target return different turns.
+func (rr *hostRoundRobin) next(targetId string) uint64 {
+	c, _ := rr.counters.LoadOrStore(targetId, new(atomic.Uint64))
+	return c.(*atomic.Uint64).Add(1) - 1
+}
+
+// selectEndpoint returns the endpoint for a session from endpoints according
+// to strategy. stats contains the active session statistics of the hosts of
+// the target and is used by the least-connections strategy. turn is used by
+// the round-robin strategy and selects the endpoint at position turn of the
+// endpoints ordered by their host id. Unknown strategies are treated as the
+// random strategy. endpoints must not be empty.
+func selectEndpoint(strategy target.HostSelectionStrategy, endpoints []*host.Endpoint, stats []*session.HostSessionStats, turn uint64) *host.Endpoint {
+	switch strategy {
+	case target.RoundRobinHostSelection:
+		ordered := make([]*host.Endpoint, len(endpoints))
+		copy(ordered, endpoints)
+		sort.Slice(ordered, func(i, j int) bool {
+			if ordered[i].HostId != ordered[j].HostId {
+				return ordered[i].HostId < ordered[j].HostId
+			}
+			return ordered[i].Address < ordered[j].Address
+		})
+		return ordered[turn%uint64(len(ordered))]
+
+	case target.LeastConnectionsHostSelection:
+		byHost := make(map[string]*session.HostSessionStats, len(stats))
+		for _, s := range stats {
+			byHost[s.HostId] = s
+		}
+		less := func(a, b *host.Endpoint) bool {
+			as, bs := byHost[a.HostId], byHost[b.HostId]
+			switch {
+			case bs == nil:
+				return false
+			case as == nil:
+				return true
+			case as.ActiveConnectionCount != bs.ActiveConnectionCount:
+				return as.ActiveConnectionCount < bs.ActiveConnectionCount
+			default:
+				return as.ActiveSessionCount < bs.ActiveSessionCount
+			}
+		}
+		// Start at a random endpoint so that ties are broken randomly.
+		offset := rand.Intn(len(endpoints))
+		chosen := endpoints[offset]
+		for i := 1; i < len(endpoints); i++ {
+			if ep := endpoints[(offset+i)%len(endpoints)]; less(ep, chosen) {
+				chosen = ep
+			}
+		}
+		return chosen
+
+	default:
+		return endpoints[rand.Intn(len(endpoints))]
+	}
+}
+
+// healthyEndpoints returns the endpoints which did not fail their most recent
+// health check. Endpoints which have not been checked within
+// server.HostHealthCheckStaleDuration are considered healthy.
+func healthyEndpoints(endpoints []*host.Endpoint, checks []*server.HostHealthCheck) []*host.Endpoint {
+	type key struct{ hostId, address string }
+	unhealthy := make(map[key]bool, len(checks))
+	for _, c := range checks {
+		if c.CurrentStatus() == server.UnhealthyHostHealth {
+			unhealthy[key{c.HostId, c.Address}] = true
+		}
+	}
+	healthy := make([]*host.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		if !unhealthy[key{ep.HostId, ep.Address}] {
+			healthy = append(healthy, ep)
+		}
+	}
+	return healthy
+}
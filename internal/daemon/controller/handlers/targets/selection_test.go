@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSelectionWorker(t *testing.T, id string, opt ...server.Option) *server.Worker {
+	t.Helper()
+	w := server.NewWorker(scope.Global.String(), opt...)
+	w.PublicId = id
+	return w
+}
+
+func workerIds(workers []*server.Worker) []string {
+	ids := make([]string, 0, len(workers))
+	for _, w := range workers {
+		ids = append(ids, w.GetPublicId())
+	}
+	return ids
+}
+
+func TestOrderWorkers(t *testing.T) {
+	t.Run("random", func(t *testing.T) {
+		workers := []*server.Worker{
+			testSelectionWorker(t, "w_1"),
+			testSelectionWorker(t, "w_2"),
+			testSelectionWorker(t, "w_3"),
+		}
+		orderWorkers(target.RandomWorkerSelection, workers, "u_1")
+		assert.ElementsMatch(t, []string{"w_1", "w_2", "w_3"}, workerIds(workers))
+	})
+	t.Run("least-connections", func(t *testing.T) {
+		workers := []*server.Worker{
+			testSelectionWorker(t, "w_1", server.WithTestActiveConnectionCount(5)),
+			testSelectionWorker(t, "w_2", server.WithTestActiveConnectionCount(0)),
+			testSelectionWorker(t, "w_3", server.WithTestActiveConnectionCount(2)),
+		}
+		orderWorkers(target.LeastConnectionsWorkerSelection, workers, "u_1")
+		assert.Equal(t, []string{"w_2", "w_3", "w_1"}, workerIds(workers))
+	})
+	t.Run("latency-weighted", func(t *testing.T) {
+		var fastFirst int
+		for i := 0; i < 100; i++ {
+			workers := []*server.Worker{
+				testSelectionWorker(t, "w_slow", server.WithStatusRttMs(500)),
+				testSelectionWorker(t, "w_fast", server.WithStatusRttMs(5)),
+				testSelectionWorker(t, "w_unknown"),
+			}
+			orderWorkers(target.LatencyWeightedWorkerSelection, workers, "u_1")
+			require.Len(t, workers, 3)
+			if workers[0].GetPublicId() == "w_fast" {
+				fastFirst++
+			}
+		}
+		// The fast worker has a weight 100 times the weight of the slow worker
+		// and about 50 times the weight of the worker without a round trip time.
+		assert.Greater(t, fastFirst, 80)
+	})
+	t.Run("latency-weighted slow workers", func(t *testing.T) {
+		// u^rtt underflows to 0 for round trip times this large, which would
+		// leave the workers in the order of the shuffle.
+		var fasterFirst int
+		for i := 0; i < 300; i++ {
+			workers := []*server.Worker{
+				testSelectionWorker(t, "w_slower", server.WithStatusRttMs(2400)),
+				testSelectionWorker(t, "w_slow", server.WithStatusRttMs(1200)),
+			}
+			orderWorkers(target.LatencyWeightedWorkerSelection, workers, "u_1")
+			if workers[0].GetPublicId() == "w_slow" {
+				fasterFirst++
+			}
+		}
+		// The faster worker comes first two thirds of the time.
+		assert.Greater(t, fasterFirst, 170)
+	})
+	t.Run("sticky", func(t *testing.T) {
+		newWorkers := func() []*server.Worker {
+			return []*server.Worker{
+				testSelectionWorker(t, "w_1"),
+				testSelectionWorker(t, "w_2"),
+				testSelectionWorker(t, "w_3"),
+				testSelectionWorker(t, "w_4"),
+			}
+		}
+		workers := newWorkers()
+		orderWorkers(target.StickyWorkerSelection, workers, "u_1")
+		want := workerIds(workers)
+		for i := 0; i < 10; i++ {
+			workers := newWorkers()
+			orderWorkers(target.StickyWorkerSelection, workers, "u_1")
+			assert.Equal(t, want, workerIds(workers))
+		}
+
+		// Removing a worker other than the first does not change the first.
+		var remaining []*server.Worker
+		for _, w := range newWorkers() {
+			if w.GetPublicId() != want[len(want)-1] {
+				remaining = append(remaining, w)
+			}
+		}
+		orderWorkers(target.StickyWorkerSelection, remaining, "u_1")
+		assert.Equal(t, want[:3], workerIds(remaining))
+	})
+}
+
+func TestSelectEndpoint(t *testing.T) {
+	endpoints := []*host.Endpoint{
+		{HostId: "hst_2", SetId: "hsst_1", Address: "10.0.0.2"},
+		{HostId: "hst_1", SetId: "hsst_1", Address: "10.0.0.1"},
+		{HostId: "hst_3", SetId: "hsst_1", Address: "10.0.0.3"},
+	}
+
+	t.Run("random", func(t *testing.T) {
+		got := selectEndpoint(target.RandomHostSelection, endpoints, nil, 0)
+		assert.Contains(t, endpoints, got)
+	})
+	t.Run("round-robin", func(t *testing.T) {
+		want := []string{"hst_1", "hst_2", "hst_3", "hst_1"}
+		for turn, w := range want {
+			got := selectEndpoint(target.RoundRobinHostSelection, endpoints, nil, uint64(turn))
+			assert.Equal(t, w, got.HostId)
+		}
+		assert.Equal(t, "hst_2", endpoints[0].HostId, "endpoints must not be reordered")
+	})
+	t.Run("least-connections", func(t *testing.T) {
+		stats := []*session.HostSessionStats{
+			{HostId: "hst_1", ActiveConnectionCount: 3, ActiveSessionCount: 1},
+			{HostId: "hst_2", ActiveConnectionCount: 1, ActiveSessionCount: 4},
+			{HostId: "hst_3", ActiveConnectionCount: 1, ActiveSessionCount: 2},
+		}
+		for i := 0; i < 10; i++ {
+			got := selectEndpoint(target.LeastConnectionsHostSelection, endpoints, stats, 0)
+			assert.Equal(t, "hst_3", got.HostId)
+		}
+	})
+	t.Run("least-connections-host-without-sessions", func(t *testing.T) {
+		stats := []*session.HostSessionStats{
+			{HostId: "hst_1", ActiveSessionCount: 1},
+			{HostId: "hst_3", ActiveSessionCount: 1},
+		}
+		for i := 0; i < 10; i++ {
+			got := selectEndpoint(target.LeastConnectionsHostSelection, endpoints, stats, 0)
+			assert.Equal(t, "hst_2", got.HostId)
+		}
+	})
+}
+
+func TestHostRoundRobin(t *testing.T) {
+	rr := &hostRoundRobin{}
+	assert.Equal(t, uint64(0), rr.next("ttcp_1"))
+	assert.Equal(t, uint64(1), rr.next("ttcp_1"))
+	assert.Equal(t, uint64(0), rr.next("ttcp_2"))
+
+	// Concurrent authorizations for a target get different turns.
+	const n = 100
+	turns := make(chan uint64, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			turns <- rr.next("ttcp_3")
+		}()
+	}
+	wg.Wait()
+	close(turns)
+	seen := make(map[uint64]bool, n)
+	for turn := range turns {
+		assert.False(t, seen[turn], "duplicate turn %d", turn)
+		seen[turn] = true
+	}
+	assert.Len(t, seen, n)
+}
+
+func TestHealthyEndpoints(t *testing.T) {
+	endpoints := []*host.Endpoint{
+		{HostId: "hst_1", SetId: "hsst_1", Address: "10.0.0.1"},
+		{HostId: "hst_2", SetId: "hsst_1", Address: "10.0.0.2"},
+		{HostId: "hst_3", SetId: "hsst_1", Address: "10.0.0.3"},
+		{HostId: "hst_4", SetId: "hsst_1", Address: "10.0.0.4"},
+	}
+	checks := []*server.HostHealthCheck{
+		{HostId: "hst_1", Address: "10.0.0.1", Status: string(server.HealthyHostHealth), LastCheckTime: timestamp.Now()},
+		{HostId: "hst_2", Address: "10.0.0.2", Status: string(server.UnhealthyHostHealth), LastCheckTime: timestamp.Now()},
+		// Stale results are ignored.
+		{HostId: "hst_3", Address: "10.0.0.3", Status: string(server.UnhealthyHostHealth), LastCheckTime: timestamp.New(time.Now().Add(-2 * server.HostHealthCheckStaleDuration))},
+		// Unchecked endpoints are considered healthy.
+		{HostId: "hst_4", Address: "10.0.0.4", Status: string(server.UnknownHostHealth)},
+	}
+	got := healthyEndpoints(endpoints, checks)
+	var ids []string
+	for _, ep := range got {
+		ids = append(ids, ep.HostId)
+	}
+	assert.Equal(t, []string{"hst_1", "hst_3", "hst_4"}, ids)
+	assert.Equal(t, endpoints, healthyEndpoints(endpoints, nil))
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/url"
 	"strconv"
@@ -21,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -130,6 +130,7 @@ type Service struct {
 	workerStatusGracePeriod *atomic.Int64
 	maxPageSize             uint
 	controllerExt           intglobals.ControllerExtension
+	hostRoundRobin          *hostRoundRobin
 }
 
 var _ pbs.TargetServiceServer = (*Service)(nil)
@@ -196,6 +197,7 @@ func NewService(
 		workerStatusGracePeriod: workerStatusGracePeriod,
 		maxPageSize:             maxPageSize,
 		controllerExt:           controllerExt,
+		hostRoundRobin:          &hostRoundRobin{},
 	}, nil
 }
 
@@ -447,6 +449,7 @@ func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	s.registerHostHealthChecks(ctx, t)
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -503,6 +506,7 @@ func (s Service) AddTargetHostSources(ctx context.Context, req *pbs.AddTargetHos
 	if err != nil {
 		return nil, err
 	}
+	s.registerHostHealthChecks(ctx, t)
 	ts := t.GetHostSources()
 	cl := t.GetCredentialSources()
 
@@ -545,6 +549,7 @@ func (s Service) SetTargetHostSources(ctx context.Context, req *pbs.SetTargetHos
 	if err != nil {
 		return nil, err
 	}
+	s.registerHostHealthChecks(ctx, t)
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -585,6 +590,7 @@ func (s Service) RemoveTargetHostSources(ctx context.Context, req *pbs.RemoveTar
 	if err != nil {
 		return nil, err
 	}
+	s.registerHostHealthChecks(ctx, t)
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -790,6 +796,13 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		target.WithProjectName(req.GetScopeName()),
 	)
 	if authResults.Error != nil {
+		// Only label the metric with a target which was resolved, not with
+		// the values of the request.
+		if t, ok := authResults.RoundTripValue.(target.Target); ok && t != nil {
+			metric.RecordSessionAuthorization(t.GetPublicId(), t.GetProjectId(), metric.SessionDenied)
+		} else {
+			metric.RecordUnresolvedSessionAuthorization(metric.SessionDenied)
+		}
 		return nil, authResults.Error
 	}
 
@@ -819,8 +832,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	// * u_recovery access (which is fine, recovery is meant for recovering
 	// system state, no real reason to allow it to then connect to systems)
 	if authResults.AuthTokenId == "" {
+		metric.RecordSessionAuthorization(t.GetPublicId(), t.GetProjectId(), metric.SessionDenied)
 		return nil, handlers.ForbiddenError()
 	}
+	defer func() {
+		result := metric.SessionAuthorized
+		if retErr != nil {
+			result = metric.SessionAuthorizationFailed
+		}
+		metric.RecordSessionAuthorization(t.GetPublicId(), t.GetProjectId(), result)
+	}()
 
 	if t.GetDefaultPort() == 0 {
 		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
@@ -868,42 +889,29 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	default:
 		requestedId := req.GetHostId()
-		staticHostRepo, err := s.staticHostRepoFn()
-		if err != nil {
-			return nil, err
-		}
-		pluginHostRepo, err := s.pluginHostRepoFn()
+		endpoints, err := s.hostSourceEndpoints(ctx, hostSources)
 		if err != nil {
 			return nil, err
 		}
 
-		var pluginHostSetIds []string
-		var endpoints []*host.Endpoint
-		for _, hSource := range hostSources {
-			hsId := hSource.Id()
-			switch globals.ResourceInfoFromPrefix(hsId).Subtype {
-			case static.Subtype:
-				eps, err := staticHostRepo.Endpoints(ctx, hsId)
-				if err != nil {
-					return nil, err
-				}
-				endpoints = append(endpoints, eps...)
-			default:
-				// Batch the plugin host set ids since each round trip to the plugin
-				// has the potential to be expensive.
-				pluginHostSetIds = append(pluginHostSetIds, hsId)
-			}
+		if len(endpoints) == 0 {
+			return nil, handlers.NotFoundErrorf("No host sources or address found for given target.")
 		}
-		if len(pluginHostSetIds) > 0 {
-			eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
+
+		if t.GetEnableHostHealthChecks() {
+			checks, err := serversRepo.ListTargetHostHealthChecks(ctx, t.GetPublicId())
 			if err != nil {
 				return nil, err
 			}
-			endpoints = append(endpoints, eps...)
-		}
-
-		if len(endpoints) == 0 {
-			return nil, handlers.NotFoundErrorf("No host sources or address found for given target.")
+			// A specifically requested host is used regardless of its health.
+			if requestedId == "" {
+				endpoints = healthyEndpoints(endpoints, checks)
+				if len(endpoints) == 0 {
+					return nil, handlers.ApiErrorWithCodeAndMessage(
+						codes.FailedPrecondition,
+						"No healthy hosts are available for this target.")
+				}
+			}
 		}
 
 		var chosenEndpoint *host.Endpoint
@@ -924,7 +932,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 
 		if chosenEndpoint == nil {
-			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
+			strategy := target.HostSelectionStrategy(t.GetHostSelectionStrategy())
+			var stats []*session.HostSessionStats
+			var turn uint64
+			switch strategy {
+			case target.RoundRobinHostSelection:
+				turn = s.hostRoundRobin.next(t.GetPublicId())
+			case target.LeastConnectionsHostSelection:
+				stats, err = sessionRepo.ListHostSessionStats(ctx, t.GetPublicId())
+				if err != nil {
+					return nil, err
+				}
+			}
+			chosenEndpoint = selectEndpoint(strategy, endpoints, stats, turn)
 		}
 
 		hostId = chosenEndpoint.HostId
@@ -974,10 +994,8 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// Randomize the workers
-	rand.Shuffle(len(selectedWorkers), func(i, j int) {
-		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
-	})
+	// Order the workers using the target's worker selection strategy
+	orderWorkers(target.WorkerSelectionStrategy(t.GetWorkerSelectionStrategy()), selectedWorkers, authResults.UserId)
 
 	var vaultReqs []credential.Request
 	var staticIds []string
@@ -1000,20 +1018,25 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:              authResults.UserId,
-		HostId:              hostId,
-		TargetId:            t.GetPublicId(),
-		HostSetId:           hostSetId,
-		AuthTokenId:         authResults.AuthTokenId,
-		ProjectId:           authResults.Scope.Id,
-		Endpoint:            endpointUrl.String(),
-		ExpirationTime:      &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:     t.GetSessionConnectionLimit(),
-		WorkerFilter:        t.GetWorkerFilter(),
-		EgressWorkerFilter:  t.GetEgressWorkerFilter(),
-		IngressWorkerFilter: t.GetIngressWorkerFilter(),
-		DynamicCredentials:  dynCreds,
-		StaticCredentials:   staticCreds,
+		UserId:                          authResults.UserId,
+		HostId:                          hostId,
+		TargetId:                        t.GetPublicId(),
+		HostSetId:                       hostSetId,
+		AuthTokenId:                     authResults.AuthTokenId,
+		ProjectId:                       authResults.Scope.Id,
+		Endpoint:                        endpointUrl.String(),
+		ExpirationTime:                  &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:                 t.GetSessionConnectionLimit(),
+		WorkerFilter:                    t.GetWorkerFilter(),
+		EgressWorkerFilter:              t.GetEgressWorkerFilter(),
+		IngressWorkerFilter:             t.GetIngressWorkerFilter(),
+		BandwidthLimit:                  t.GetBandwidthLimit(),
+		SessionBandwidthLimit:           t.GetSessionBandwidthLimit(),
+		MaxConcurrentConnections:        t.GetMaxConcurrentConnections(),
+		SessionMaxConcurrentConnections: t.GetSessionMaxConcurrentConnections(),
+		ConnectionIdleTimeoutSeconds:    t.GetConnectionIdleTimeoutSeconds(),
+		DynamicCredentials:              dynCreds,
+		StaticCredentials:               staticCreds,
 	}
 	if protoWorker != nil {
 		sessionComposition.ProtocolWorkerId = protoWorker.GetPublicId()
@@ -1248,6 +1271,30 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetIngressWorkerFilter() != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetWorkerSelectionStrategy() != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(target.WorkerSelectionStrategy(item.GetWorkerSelectionStrategy().GetValue())))
+	}
+	if item.GetHostSelectionStrategy() != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(target.HostSelectionStrategy(item.GetHostSelectionStrategy().GetValue())))
+	}
+	if item.GetEnableHostHealthChecks() != nil {
+		opts = append(opts, target.WithEnableHostHealthChecks(item.GetEnableHostHealthChecks().GetValue()))
+	}
+	if item.GetBandwidthLimit() != nil {
+		opts = append(opts, target.WithBandwidthLimit(item.GetBandwidthLimit().GetValue()))
+	}
+	if item.GetSessionBandwidthLimit() != nil {
+		opts = append(opts, target.WithSessionBandwidthLimit(item.GetSessionBandwidthLimit().GetValue()))
+	}
+	if item.GetMaxConcurrentConnections() != nil {
+		opts = append(opts, target.WithMaxConcurrentConnections(item.GetMaxConcurrentConnections().GetValue()))
+	}
+	if item.GetSessionMaxConcurrentConnections() != nil {
+		opts = append(opts, target.WithSessionMaxConcurrentConnections(item.GetSessionMaxConcurrentConnections().GetValue()))
+	}
+	if item.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(item.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
@@ -1305,6 +1352,30 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if ingressFilter := item.GetIngressWorkerFilter(); ingressFilter != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if strategy := item.GetWorkerSelectionStrategy(); strategy != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(target.WorkerSelectionStrategy(strategy.GetValue())))
+	}
+	if strategy := item.GetHostSelectionStrategy(); strategy != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(target.HostSelectionStrategy(strategy.GetValue())))
+	}
+	if enable := item.GetEnableHostHealthChecks(); enable != nil {
+		opts = append(opts, target.WithEnableHostHealthChecks(enable.GetValue()))
+	}
+	if limit := item.GetBandwidthLimit(); limit != nil {
+		opts = append(opts, target.WithBandwidthLimit(limit.GetValue()))
+	}
+	if limit := item.GetSessionBandwidthLimit(); limit != nil {
+		opts = append(opts, target.WithSessionBandwidthLimit(limit.GetValue()))
+	}
+	if limit := item.GetMaxConcurrentConnections(); limit != nil {
+		opts = append(opts, target.WithMaxConcurrentConnections(limit.GetValue()))
+	}
+	if limit := item.GetSessionMaxConcurrentConnections(); limit != nil {
+		opts = append(opts, target.WithSessionMaxConcurrentConnections(limit.GetValue()))
+	}
+	if timeout := item.GetConnectionIdleTimeoutSeconds(); timeout != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(timeout.GetValue()))
+	}
 	if item.GetAddress() != nil {
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
@@ -1643,6 +1714,30 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
 		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
 	}
+	if outputFields.Has(globals.WorkerSelectionStrategyField) && in.GetWorkerSelectionStrategy() != "" {
+		out.WorkerSelectionStrategy = wrapperspb.String(in.GetWorkerSelectionStrategy())
+	}
+	if outputFields.Has(globals.HostSelectionStrategyField) && in.GetHostSelectionStrategy() != "" {
+		out.HostSelectionStrategy = wrapperspb.String(in.GetHostSelectionStrategy())
+	}
+	if outputFields.Has(globals.EnableHostHealthChecksField) && in.GetEnableHostHealthChecks() {
+		out.EnableHostHealthChecks = wrapperspb.Bool(true)
+	}
+	if outputFields.Has(globals.BandwidthLimitField) && in.GetBandwidthLimit() != 0 {
+		out.BandwidthLimit = wrapperspb.UInt32(in.GetBandwidthLimit())
+	}
+	if outputFields.Has(globals.SessionBandwidthLimitField) && in.GetSessionBandwidthLimit() != 0 {
+		out.SessionBandwidthLimit = wrapperspb.UInt32(in.GetSessionBandwidthLimit())
+	}
+	if outputFields.Has(globals.MaxConcurrentConnectionsField) && in.GetMaxConcurrentConnections() != 0 {
+		out.MaxConcurrentConnections = wrapperspb.UInt32(in.GetMaxConcurrentConnections())
+	}
+	if outputFields.Has(globals.SessionMaxConcurrentConnectionsField) && in.GetSessionMaxConcurrentConnections() != 0 {
+		out.SessionMaxConcurrentConnections = wrapperspb.UInt32(in.GetSessionMaxConcurrentConnections())
+	}
+	if outputFields.Has(globals.ConnectionIdleTimeoutSecondsField) && in.GetConnectionIdleTimeoutSeconds() != 0 {
+		out.ConnectionIdleTimeoutSeconds = wrapperspb.UInt32(in.GetConnectionIdleTimeoutSeconds())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1760,6 +1855,7 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		validateSelectionStrategies(item, badFields)
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1792,6 +1888,22 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	})
 }
 
+// validateSelectionStrategies adds an entry to badFields for each selection
+// strategy of item which is set to an unknown strategy. An empty strategy
+// clears the strategy of the target.
+func validateSelectionStrategies(item *pb.Target, badFields map[string]string) {
+	if strategy := item.GetWorkerSelectionStrategy(); strategy != nil && strategy.GetValue() != "" {
+		if !target.WorkerSelectionStrategy(strategy.GetValue()).IsValid() {
+			badFields[globals.WorkerSelectionStrategyField] = fmt.Sprintf("Unknown worker selection strategy %q.", strategy.GetValue())
+		}
+	}
+	if strategy := item.GetHostSelectionStrategy(); strategy != nil && strategy.GetValue() != "" {
+		if !target.HostSelectionStrategy(strategy.GetValue()).IsValid() {
+			badFields[globals.HostSelectionStrategyField] = fmt.Sprintf("Unknown host selection strategy %q.", strategy.GetValue())
+		}
+	}
+}
+
 func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 	item := req.GetItem()
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
@@ -1837,6 +1949,7 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		validateSelectionStrategies(item, badFields)
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
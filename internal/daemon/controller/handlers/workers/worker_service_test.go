@@ -44,7 +44,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags", "drain"}
 
 func structListValue(t *testing.T, ss ...string) *structpb.ListValue {
 	t.Helper()
@@ -129,6 +129,7 @@ func TestGet(t *testing.T) {
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
 		AuthorizedActions:     strutil.StrListDelete(deprecatedKmsAuthzActions, action.Update.String()),
 		LastStatusTime:        deprecatedKmsWorker.GetLastStatusTime().GetTimestamp(),
+		OperationalState:      server.ActiveOperationalState.String(),
 		ReleaseVersion:        deprecatedKmsWorker.ReleaseVersion,
 		CanonicalTags: map[string]*structpb.ListValue{
 			"key": structListValue(t, "val"),
@@ -171,6 +172,7 @@ func TestGet(t *testing.T) {
 		AuthorizedActions:     testAuthorizedActions,
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
 		LastStatusTime:        pkiWorker.GetLastStatusTime().GetTimestamp(),
+		OperationalState:      server.ActiveOperationalState.String(),
 		ReleaseVersion:        pkiWorker.ReleaseVersion,
 		CanonicalTags: map[string]*structpb.ListValue{
 			"config": structListValue(t, "test"),
@@ -227,6 +229,7 @@ func TestGet(t *testing.T) {
 		AuthorizedActions:     managedPkiAuthzActions,
 		ActiveConnectionCount: &wrapperspb.UInt32Value{Value: 0},
 		LastStatusTime:        managedPkiWorker.GetLastStatusTime().GetTimestamp(),
+		OperationalState:      server.ActiveOperationalState.String(),
 		ReleaseVersion:        managedPkiWorker.ReleaseVersion,
 		CanonicalTags: map[string]*structpb.ListValue{
 			wl.ManagedWorkerTag: structListValue(t, "true"),
@@ -352,6 +355,7 @@ func TestList(t *testing.T) {
 			Address:                            w.GetAddress(),
 			Type:                               KmsWorkerType,
 			LastStatusTime:                     w.GetLastStatusTime().GetTimestamp(),
+			OperationalState:                   server.ActiveOperationalState.String(),
 			ReleaseVersion:                     w.ReleaseVersion,
 			DirectlyConnectedDownstreamWorkers: connectedDownstreams,
 		})
@@ -373,6 +377,7 @@ func TestList(t *testing.T) {
 			Address:                            w.GetAddress(),
 			Type:                               PkiWorkerType,
 			LastStatusTime:                     w.GetLastStatusTime().GetTimestamp(),
+			OperationalState:                   server.ActiveOperationalState.String(),
 			ReleaseVersion:                     w.ReleaseVersion,
 			DirectlyConnectedDownstreamWorkers: connectedDownstreams,
 		})
@@ -647,6 +652,7 @@ func TestUpdate(t *testing.T) {
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -676,6 +682,7 @@ func TestUpdate(t *testing.T) {
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -747,6 +754,7 @@ func TestUpdate(t *testing.T) {
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -773,6 +781,7 @@ func TestUpdate(t *testing.T) {
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -807,6 +816,7 @@ func TestUpdate(t *testing.T) {
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						ActiveConnectionCount:              &wrapperspb.UInt32Value{Value: 0},
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -841,6 +851,7 @@ func TestUpdate(t *testing.T) {
 						Description:                        wrapperspb.String("notignored"),
 						CreatedTime:                        wkr.GetCreateTime().GetTimestamp(),
 						LastStatusTime:                     wkr.GetLastStatusTime().GetTimestamp(),
+						OperationalState:                   server.ActiveOperationalState.String(),
 						AuthorizedActions:                  testAuthorizedActions,
 						Type:                               PkiWorkerType,
 						DirectlyConnectedDownstreamWorkers: connectedDownstreams,
@@ -2454,6 +2465,72 @@ func TestService_RemoveWorkerTags(t *testing.T) {
 	}
 }
 
+func TestService_DrainWorker(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, testKms)
+	}
+	workerAuthRepo, err := server.NewRepositoryStorage(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return workerAuthRepo, nil
+	}
+	s, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	require.NoError(t, err)
+	worker := server.TestPkiWorker(t, conn, wrapper)
+
+	tests := []struct {
+		name            string
+		req             *pbs.DrainWorkerRequest
+		wantErrContains string
+	}{
+		{
+			name:            "bad-id",
+			req:             &pbs.DrainWorkerRequest{Id: "bad_id"},
+			wantErrContains: "Incorrectly formatted identifier.",
+		},
+		{
+			name:            "not-found",
+			req:             &pbs.DrainWorkerRequest{Id: globals.WorkerPrefix + "_doesntexis"},
+			wantErrContains: "doesn't exist",
+		},
+		{
+			name: "success",
+			req:  &pbs.DrainWorkerRequest{Id: worker.GetPublicId()},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := s.DrainWorker(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.wantErrContains != "" {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(worker.GetPublicId(), got.GetItem().GetId())
+			assert.Equal(worker.GetVersion(), got.GetItem().GetVersion())
+
+			repo, err := repoFn()
+			require.NoError(err)
+			w, err := repo.LookupWorker(ctx, worker.GetPublicId())
+			require.NoError(err)
+			assert.True(w.GetDrainRequested())
+		})
+	}
+}
+
 func TestReadCertificateAuthority(t *testing.T) {
 	require, assert := require.New(t), assert.New(t)
 	ctx := context.Background()
@@ -22,6 +22,7 @@ import (
 	pberrors "github.com/hashicorp/boundary/internal/gen/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -438,6 +439,7 @@ func workerRequestInfoInterceptor(ctx context.Context, eventer *event.Eventer) (
 			Id:      commonSrv.GeneratedTraceId(interceptorCtx),
 			Method:  srvInfo.FullMethod,
 		}
+		info.TraceId, _ = tracing.TraceId(interceptorCtx)
 		interceptorCtx, err = event.NewRequestInfoContext(interceptorCtx, info)
 		if err != nil {
 			event.WriteError(interceptorCtx, op, err, event.WithInfoMsg("unable to create context with request info", "method", srvInfo.FullMethod))
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	authSubsystem = "controller_auth"
+
+	labelResourceType = "resource_type"
+)
+
+// AclEvaluationResult is the outcome of evaluating the ACL of a request.
+type AclEvaluationResult string
+
+const (
+	AclAuthorized AclEvaluationResult = "authorized"
+	AclDenied     AclEvaluationResult = "denied"
+	AclError      AclEvaluationResult = "error"
+)
+
+// aclEvaluationLatency collects measurements of how long it takes to look up
+// the grants of the caller and evaluate them against the requested resource
+// and action.
+var aclEvaluationLatency = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: authSubsystem,
+		Name:      "acl_evaluation_duration_seconds",
+		Help:      "Histogram of latencies for authenticating a request and evaluating its ACL, by resource type and result.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{labelResourceType, labelResult},
+)
+
+// InitializeAuthCollectors registers the auth collectors onto `r`. It panics
+// upon the first registration that causes an error.
+func InitializeAuthCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(aclEvaluationLatency)
+}
+
+// RecordAclEvaluation records how long authenticating a request for the
+// resource type and evaluating its ACL took.
+func RecordAclEvaluation(resourceType string, result AclEvaluationResult, d time.Duration) {
+	aclEvaluationLatency.WithLabelValues(resourceType, string(result)).Observe(d.Seconds())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	sessionSubsystem = "controller_session"
+
+	labelTargetId = "target_id"
+	labelScopeId  = "scope_id"
+	labelResult   = "result"
+
+	// unknownLabelValue is used as the target and scope of requests whose
+	// target could not be resolved, so that the values of such requests do
+	// not become label values.
+	unknownLabelValue = "unknown"
+)
+
+// AuthorizeSessionResult is the outcome of a request to authorize a session.
+type AuthorizeSessionResult string
+
+const (
+	// SessionAuthorized is used when a session was created for the request.
+	SessionAuthorized AuthorizeSessionResult = "authorized"
+	// SessionDenied is used when the caller was not permitted to authorize a
+	// session.
+	SessionDenied AuthorizeSessionResult = "denied"
+	// SessionAuthorizationFailed is used when the caller was permitted to
+	// authorize a session but no session could be created, e.g. because no
+	// host or worker was available or credentials could not be brokered.
+	SessionAuthorizationFailed AuthorizeSessionResult = "failed"
+)
+
+// sessionAuthorizations counts the requests to authorize a session by target,
+// scope and result.
+var sessionAuthorizations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: sessionSubsystem,
+		Name:      "authorizations_total",
+		Help:      "Count of requests to authorize a session, by target, scope and result.",
+	},
+	[]string{labelTargetId, labelScopeId, labelResult},
+)
+
+// InitializeSessionCollectors registers the session collectors onto `r`. It
+// panics upon the first registration that causes an error.
+func InitializeSessionCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(sessionAuthorizations)
+}
+
+// RecordSessionAuthorization counts a request to authorize a session for the
+// target in the scope. The ids must be those of a resolved target rather
+// than values taken from the request.
+func RecordSessionAuthorization(targetId, scopeId string, result AuthorizeSessionResult) {
+	sessionAuthorizations.WithLabelValues(targetId, scopeId, string(result)).Inc()
+}
+
+// RecordUnresolvedSessionAuthorization counts a request to authorize a session
+// for a target which could not be resolved.
+func RecordUnresolvedSessionAuthorization(result AuthorizeSessionResult) {
+	sessionAuthorizations.WithLabelValues(unknownLabelValue, unknownLabelValue, string(result)).Inc()
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeSessionCollectors(t *testing.T) {
+	require.NotPanics(t, func() { InitializeSessionCollectors(nil) })
+	require.NotPanics(t, func() { InitializeSessionCollectors(prometheus.NewRegistry()) })
+}
+
+func TestRecordSessionAuthorization(t *testing.T) {
+	const targetId, scopeId = "ttcp_1234567890", "p_1234567890"
+	RecordSessionAuthorization(targetId, scopeId, SessionAuthorized)
+	RecordSessionAuthorization(targetId, scopeId, SessionAuthorized)
+	RecordSessionAuthorization(targetId, scopeId, SessionDenied)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(sessionAuthorizations.WithLabelValues(targetId, scopeId, string(SessionAuthorized))))
+	assert.Equal(t, float64(1), testutil.ToFloat64(sessionAuthorizations.WithLabelValues(targetId, scopeId, string(SessionDenied))))
+	assert.Equal(t, float64(0), testutil.ToFloat64(sessionAuthorizations.WithLabelValues(targetId, scopeId, string(SessionAuthorizationFailed))))
+}
+
+func TestRecordUnresolvedSessionAuthorization(t *testing.T) {
+	RecordUnresolvedSessionAuthorization(SessionDenied)
+	assert.Equal(t, float64(1), testutil.ToFloat64(sessionAuthorizations.WithLabelValues(unknownLabelValue, unknownLabelValue, string(SessionDenied))))
+}
+
+func TestRecordAclEvaluation(t *testing.T) {
+	require.NotPanics(t, func() { InitializeAuthCollectors(nil) })
+	require.NotPanics(t, func() { InitializeAuthCollectors(prometheus.NewRegistry()) })
+
+	RecordAclEvaluation("target", AclDenied, time.Millisecond)
+	assert.Equal(t, 1, testutil.CollectAndCount(aclEvaluationLatency))
+}
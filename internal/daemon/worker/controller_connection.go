@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -222,7 +223,7 @@ func createDefaultGRPCDialOptions(res resolver.Builder, upstreamDialerFn func(co
 
 	dialOpts := []grpc.DialOption{
 		grpc.WithResolvers(res),
-		grpc.WithUnaryInterceptor(metric.InstrumentClusterClient()),
+		grpc.WithChainUnaryInterceptor(metric.InstrumentClusterClient(), tracing.UnaryClientInterceptor()),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
 		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)),
 		grpc.WithContextDialer(upstreamDialerFn),
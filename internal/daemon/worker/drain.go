@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
+)
+
+const (
+	// DefaultDrainTimeout is the amount of time a draining worker waits for
+	// its active connections to close before shutting down anyway.
+	DefaultDrainTimeout = time.Hour
+
+	drainCheckInterval = time.Second
+)
+
+// Drain puts an active worker into the "draining" operational state. A
+// draining worker reports its state to the controller, which stops selecting
+// it for new sessions, but it continues to proxy the connections it already
+// has. Once the worker has no active connections, or the configured drain
+// timeout passes, a server side shutdown is triggered. Calling Drain on a
+// worker that is not active is a no-op.
+func (w *Worker) Drain() {
+	const op = "worker.(Worker).Drain"
+	if !w.operationalState.CompareAndSwap(server.ActiveOperationalState, server.DrainingOperationalState) {
+		return
+	}
+	timeout := w.conf.RawConfig.Worker.DrainTimeoutDuration
+	if timeout == 0 {
+		timeout = DefaultDrainTimeout
+	}
+	event.WriteSysEvent(w.baseContext, op, "worker entering drain mode", "drain_timeout", timeout.String())
+	go w.waitForDrain(timeout)
+}
+
+// waitForDrain blocks until the worker has no active connections or the
+// timeout passes and then triggers a server side shutdown.
+func (w *Worker) waitForDrain(timeout time.Duration) {
+	const op = "worker.(Worker).waitForDrain"
+	ctx, cancel := context.WithTimeout(w.baseContext, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for n := w.activeConnectionCount(); n > 0; n = w.activeConnectionCount() {
+		select {
+		case <-ctx.Done():
+			if w.baseContext.Err() != nil {
+				// The worker is already shutting down
+				return
+			}
+			event.WriteSysEvent(w.baseContext, op, "drain timeout reached with active connections", "active_connections", n)
+			w.drainTimedOut.Store(true)
+			w.triggerShutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+	event.WriteSysEvent(w.baseContext, op, "worker connections have drained")
+	w.triggerShutdown()
+}
+
+// triggerShutdown asks the server hosting the worker to shut it down.
+func (w *Worker) triggerShutdown() {
+	select {
+	case w.conf.ServerSideShutdownCh <- struct{}{}:
+	case <-w.baseContext.Done():
+	}
+}
+
+// activeConnectionCount returns the number of authorized or connected
+// connections across all of the sessions known to the worker.
+func (w *Worker) activeConnectionCount() int {
+	if w.sessionManager == nil {
+		return 0
+	}
+	var count int
+	w.sessionManager.ForEachLocalSession(func(s session.Session) bool {
+		for _, ci := range s.GetLocalConnections() {
+			switch ci.Status {
+			case pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+				pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED:
+				count++
+			}
+		}
+		return true
+	})
+	return count
+}
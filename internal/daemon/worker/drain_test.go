@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type drainTestSession struct {
+	session.Session
+
+	status *atomic.Int32
+}
+
+func (s *drainTestSession) GetLocalConnections() map[string]session.ConnInfo {
+	return map[string]session.ConnInfo{
+		"conn": {Id: "conn", Status: pbs.CONNECTIONSTATUS(s.status.Load())},
+	}
+}
+
+type drainTestManager struct {
+	session.Manager
+
+	session *drainTestSession
+}
+
+func (m *drainTestManager) ForEachLocalSession(f func(session.Session) bool) {
+	f(m.session)
+}
+
+func TestWorkerDrain(t *testing.T) {
+	testConfig := event.DefaultEventerConfig()
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+	})
+	err := event.InitSysEventer(testLogger, testLock, "TestWorkerDrain", event.WithEventerConfig(testConfig))
+	require.NoError(t, err)
+
+	newWorker := func(t *testing.T, state server.OperationalState, timeout time.Duration, connStatus pbs.CONNECTIONSTATUS) (*Worker, *atomic.Int32) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		status := new(atomic.Int32)
+		status.Store(int32(connStatus))
+		w := &Worker{
+			baseContext:      ctx,
+			operationalState: new(atomic.Value),
+			sessionManager:   &drainTestManager{session: &drainTestSession{status: status}},
+			conf: &Config{
+				Server: &base.Server{
+					ServerSideShutdownCh: make(chan struct{}),
+				},
+				RawConfig: &config.Config{
+					Worker: &config.Worker{DrainTimeoutDuration: timeout},
+				},
+			},
+		}
+		w.operationalState.Store(state)
+		return w, status
+	}
+
+	t.Run("not-active", func(t *testing.T) {
+		w, _ := newWorker(t, server.ShutdownOperationalState, time.Minute, pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED)
+		w.Drain()
+		assert.Equal(t, server.ShutdownOperationalState, w.operationalState.Load())
+		select {
+		case <-w.conf.ServerSideShutdownCh:
+			t.Fatal("unexpected shutdown")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("connections-close", func(t *testing.T) {
+		w, status := newWorker(t, server.ActiveOperationalState, time.Minute, pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED)
+		w.Drain()
+		assert.Equal(t, server.DrainingOperationalState, w.operationalState.Load())
+
+		// Draining twice does not start another wait
+		w.Drain()
+
+		select {
+		case <-w.conf.ServerSideShutdownCh:
+			t.Fatal("shutdown before connections closed")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		status.Store(int32(pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED))
+		select {
+		case <-w.conf.ServerSideShutdownCh:
+		case <-time.After(5 * time.Second):
+			t.Fatal("worker did not shut down after connections closed")
+		}
+		assert.False(t, w.drainTimedOut.Load())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		w, _ := newWorker(t, server.ActiveOperationalState, 100*time.Millisecond, pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED)
+		w.Drain()
+		select {
+		case <-w.conf.ServerSideShutdownCh:
+		case <-time.After(5 * time.Second):
+			t.Fatal("worker did not shut down after drain timeout")
+		}
+		assert.True(t, w.drainTimedOut.Load())
+	})
+}
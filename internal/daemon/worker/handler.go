@@ -14,6 +14,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
@@ -23,12 +25,15 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	isession "github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/sdk/pbs/proxy"
 	"github.com/hashicorp/boundary/sdk/wspb"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/nodeenrollment"
 	"github.com/hashicorp/nodeenrollment/types"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -71,7 +76,18 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		return nil, fmt.Errorf("%s: missing listener config", op)
 	}
 	return func(wr http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+		// The setup span covers everything up to proxying the connection,
+		// including the cluster RPCs made to authorize and connect it. It is
+		// ended with an error unless the connection is proxied.
+		ctx, setupSpan := tracing.StartSpan(tracing.ExtractHeaders(r.Context(), r.Header), "worker.ProxyConnectionSetup")
+		setupDone := false
+		endSetup := func(err error) {
+			if !setupDone {
+				setupDone = true
+				tracing.EndSpan(setupSpan, err)
+			}
+		}
+		defer endSetup(stderrors.New("proxy connection setup failed"))
 		if r.TLS == nil {
 			event.WriteError(ctx, op, stderrors.New("no request tls information found"))
 			wr.WriteHeader(http.StatusInternalServerError)
@@ -123,6 +139,9 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			wr.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+		setupSpan.SetAttributes(
+			attribute.String("boundary.session.id", sessionId),
+			attribute.String("boundary.target.id", sess.GetTargetId()))
 
 		opts := &websocket.AcceptOptions{
 			Subprotocols: []string{globals.TcpProxyV1},
@@ -136,11 +155,16 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		// Later calls will cause this to noop if they return a different status
 		defer conn.Close(websocket.StatusNormalClosure, "done")
 
-		connCtx, connCancel := context.WithDeadline(ctx, sess.GetExpiration())
+		// Sessions can be extended, so instead of using the expiration as a
+		// deadline the connection is canceled once the session is found to
+		// have expired.
+		connCtx, connCancel := context.WithCancel(ctx)
 		defer connCancel()
+		go cancelAtSessionExpiration(connCtx, sess.GetExpiration(), w.sessionExpirationRefresher(sessionManager, sessionId), connCancel)
 
 		var handshake proxy.ClientHandshake
 		if err := wspb.Read(connCtx, conn, &handshake); err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeInvalid)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error reading handshake from client"))
 			if err = conn.Close(websocket.StatusPolicyViolation, "invalid handshake received"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -148,6 +172,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 		if len(handshake.GetTofuToken()) != 20 {
+			metric.RecordHandshakeFailure(metric.HandshakeInvalidTofuToken)
 			event.WriteError(ctx, op, stderrors.New("invalid tofu token"))
 			if err = conn.Close(websocket.StatusUnsupportedData, "invalid tofu token"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -171,6 +196,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 
 		if sess.GetTofuToken() != "" {
 			if subtle.ConstantTimeCompare([]byte(sess.GetTofuToken()), []byte(handshake.GetTofuToken())) != 1 {
+				metric.RecordHandshakeFailure(metric.HandshakeMismatchedTofu)
 				event.WriteError(ctx, op, stderrors.New("WARNING: mismatched tofu token"), event.WithInfo("session_id", sessionId))
 				if err = conn.Close(websocket.StatusPolicyViolation, "tofu token not allowed"); err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -179,6 +205,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		} else {
 			if sess.GetStatus() != pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING {
+				metric.RecordHandshakeFailure(metric.HandshakeActivationFailed)
 				event.WriteError(ctx, op, stderrors.New("no tofu token but not in correct session state"), event.WithInfo("session_id", sessionId))
 				if err = conn.Close(websocket.StatusInternalError, "refusing to activate session"); err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -188,6 +215,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_UNSPECIFIED {
 				err = sess.RequestActivate(ctx, handshake.GetTofuToken())
 				if err != nil {
+					metric.RecordHandshakeFailure(metric.HandshakeActivationFailed)
 					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to validate session"))
 					if err = conn.Close(websocket.StatusInternalError, "unable to activate session"); err != nil {
 						event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -199,6 +227,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 
 		if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
+			metric.RecordHandshakeFailure(metric.HandshakeWorkerNotReady)
 			event.WriteError(ctx, op, stderrors.New("worker id is empty"))
 			if err = conn.Close(websocket.StatusInternalError, "worker id is empty"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -207,10 +236,41 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 		workerId := w.LastStatusSuccess().WorkerId
 
+		// Decrypt any injected SSH private keys before authorizing the
+		// connection so a key the worker cannot use does not consume one.
+		if _, err := sess.GetSshSigners(ctx); err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeProxySetupFailed)
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to load injected ssh credentials", "session_id", sessionId))
+			if err = conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to load injected ssh credentials"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
+		// Check the concurrent connection limits before authorizing the
+		// connection. A refused connection is still recorded against the
+		// session with the limit as its closed reason, which the controller
+		// does not count against the connection limit of the session. The
+		// limits are enforced by each worker independently.
+		limiters, releaseLimits, err := w.connLimiter.acquire(sess.GetTargetId(), sess.GetId(), connLimitsForSession(sess))
+		if err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeLimitExceeded)
+			event.WriteError(ctx, op, err, event.WithInfo("session_id", sessionId))
+			if err := recordLimitExceeded(ctx, sessionManager, sess, workerId, connCancel); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record connection refused for exceeding a limit", "session_id", sessionId))
+			}
+			if err = conn.Close(websocket.StatusPolicyViolation, "connection limit exceeded"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+		defer releaseLimits()
+
 		var acResp *pbs.AuthorizeConnectionResponse
 		var connsLeft int32
 		acResp, connsLeft, err = sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
 		if err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeUnauthorized)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize connection"))
 			if err = conn.Close(websocket.StatusInternalError, "unable to authorize connection"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -220,8 +280,9 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		event.WriteSysEvent(ctx, op, "connection successfully authorized", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 
 		// Wrapping the client websocket with a `net.Conn` implementation that
-		// records the bytes that go across Read() and Write().
-		cc := &countingConn{Conn: websocket.NetConn(connCtx, conn, websocket.MessageBinary)}
+		// records the bytes that go across Read() and Write(), after limiting
+		// their bandwidth.
+		cc := &countingConn{Conn: newRateLimitedConn(connCtx, websocket.NetConn(connCtx, conn, websocket.MessageBinary), limiters)}
 		err = sess.ApplyConnectionCounterCallbacks(acResp.GetConnectionId(), cc.BytesRead, cc.BytesWritten)
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to set counter callbacks for session connection"))
@@ -232,12 +293,25 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		var closeReason isession.ClosedReason
+		var idleClosed atomic.Bool
 		defer func() {
+			switch {
+			case idleClosed.Load():
+				closeReason = isession.ConnectionIdleTimeout
+			case closeReason == "":
+				// The controller reports connections canceled by an
+				// administrator as closed; keep that as the reason.
+				if ci, ok := sess.GetLocalConnections()[acResp.GetConnectionId()]; ok && ci.Status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED {
+					closeReason = isession.ConnectionCanceled
+				}
+			}
 			ccd := map[string]*session.ConnectionCloseData{
 				acResp.GetConnectionId(): {
 					SessionId: sess.GetId(),
 					BytesUp:   cc.BytesRead(),
 					BytesDown: cc.BytesWritten(),
+					Reason:    closeReason,
 				},
 			}
 			if sessionManager.RequestCloseConnections(ctx, ccd) {
@@ -251,6 +325,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			ConnectionsLeft: connsLeft,
 		}
 		if err := wspb.Write(connCtx, conn, handshakeResult); err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeResultWriteFailure)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
 			if err = conn.Close(websocket.StatusProtocolError, "unable to send handshake result"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -270,6 +345,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		if protocolCtx == nil {
 			// TODO: Remove this if block once pre v0.12.0 controllers are no longer supported.
 			if protocolCtx, err = GetProtocolContext(ctx, workerId, sess, endpointUrl.Scheme); err != nil {
+				metric.RecordHandshakeFailure(metric.HandshakeProxySetupFailed)
 				conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy context")
 				event.WriteError(ctx, op, err)
 				return
@@ -278,6 +354,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 
 		pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, proxyHandlers.WithDnsServerAddress(w.conf.WorkerDnsServer))
 		if err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeProxySetupFailed)
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get endpoint dialer")
 			event.WriteError(ctx, op, err)
 			return
@@ -286,6 +363,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		// Verify the protocol has a supported proxy before calling RequestAuthorizeConnection
 		handleProxyFn, err := proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
 		if err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeProxySetupFailed)
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy handler")
 			event.WriteError(ctx, op, err)
 			return
@@ -296,7 +374,11 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			event.WriteError(ctx, op, err)
 		}
 		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
+		if d := pDialer.LastDialDuration(); d > 0 {
+			metric.RecordEndpointDial(sess.GetTargetId(), d)
+		}
 		if err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeProxySetupFailed)
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
 			event.WriteError(ctx, op, err)
 			return
@@ -315,6 +397,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			UserClientIp:       userClientIp,
 		}
 		if err = sess.RequestConnectConnection(ctx, connectionInfo); err != nil {
+			metric.RecordHandshakeFailure(metric.HandshakeConnectFailed)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error requesting connect connection", "session_id", sess.GetId(), "connection_id", acResp.GetConnectionId()))
 			if err = conn.Close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -322,6 +405,20 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		if idleTimeout := time.Duration(sess.GetConnectionIdleTimeoutSeconds()) * time.Second; idleTimeout > 0 {
+			go closeWhenIdle(connCtx, idleTimeout, func() int64 {
+				return cc.BytesRead() + cc.BytesWritten()
+			}, func() {
+				idleClosed.Store(true)
+				event.WriteSysEvent(ctx, op, "closing idle connection", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
+				connCancel()
+			})
+		}
+
+		go metric.RecordProxiedBytes(connCtx, sess.GetTargetId(), sess.GetProjectId(), cc.BytesRead, cc.BytesWritten)
+
+		setupSpan.SetAttributes(attribute.String("boundary.connection.id", acResp.GetConnectionId()))
+		endSetup(nil)
 		runProxy()
 	}, nil
 }
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// hostHealthCheckInterval is how often each host endpoint is checked.
+	hostHealthCheckInterval = 30 * time.Second
+
+	// hostHealthCheckTimeout is how long a health check waits for a TCP
+	// connection to be established before considering the endpoint unhealthy.
+	hostHealthCheckTimeout = 5 * time.Second
+
+	// hostHealthCheckConcurrency is the maximum number of health checks run at
+	// the same time.
+	hostHealthCheckConcurrency = 16
+)
+
+type hostHealthCheckKey struct {
+	hostId, address string
+	port            uint32
+}
+
+func keyOf(hostId, address string, port uint32) hostHealthCheckKey {
+	return hostHealthCheckKey{hostId: hostId, address: address, port: port}
+}
+
+// hostHealthChecker checks the health of the host endpoints assigned to the
+// worker by the controller by establishing TCP connections to them. The
+// results are kept until they are reported in a status request.
+type hostHealthChecker struct {
+	dialFn func(ctx context.Context, network, address string) (net.Conn, error)
+
+	mu          sync.Mutex
+	endpoints   []*pbs.HostHealthCheck
+	lastChecked map[hostHealthCheckKey]time.Time
+	results     map[hostHealthCheckKey]*pbs.HostHealthCheckResult
+}
+
+func newHostHealthChecker() *hostHealthChecker {
+	d := &net.Dialer{Timeout: hostHealthCheckTimeout}
+	return &hostHealthChecker{
+		dialFn:      d.DialContext,
+		lastChecked: make(map[hostHealthCheckKey]time.Time),
+		results:     make(map[hostHealthCheckKey]*pbs.HostHealthCheckResult),
+	}
+}
+
+// setEndpoints replaces the endpoints to check.
+func (c *hostHealthChecker) setEndpoints(endpoints []*pbs.HostHealthCheck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endpoints = endpoints
+	current := make(map[hostHealthCheckKey]bool, len(endpoints))
+	for _, ep := range endpoints {
+		current[keyOf(ep.GetHostId(), ep.GetAddress(), ep.GetPort())] = true
+	}
+	for k := range c.lastChecked {
+		if !current[k] {
+			delete(c.lastChecked, k)
+		}
+	}
+}
+
+// dueEndpoints returns the endpoints which have not been checked within
+// hostHealthCheckInterval.
+func (c *hostHealthChecker) dueEndpoints(now time.Time) []*pbs.HostHealthCheck {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var due []*pbs.HostHealthCheck
+	for _, ep := range c.endpoints {
+		if last, ok := c.lastChecked[keyOf(ep.GetHostId(), ep.GetAddress(), ep.GetPort())]; ok && now.Sub(last) < hostHealthCheckInterval {
+			continue
+		}
+		due = append(due, ep)
+	}
+	return due
+}
+
+// checkDue checks the endpoints which are due to be checked and records the
+// results.
+func (c *hostHealthChecker) checkDue(ctx context.Context) {
+	due := c.dueEndpoints(time.Now())
+	if len(due) == 0 {
+		return
+	}
+	sem := make(chan struct{}, hostHealthCheckConcurrency)
+	var wg sync.WaitGroup
+	for _, ep := range due {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(ep *pbs.HostHealthCheck) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c.record(c.check(ctx, ep))
+		}(ep)
+	}
+	wg.Wait()
+}
+
+// check establishes a TCP connection to the endpoint and closes it.
+func (c *hostHealthChecker) check(ctx context.Context, ep *pbs.HostHealthCheck) *pbs.HostHealthCheckResult {
+	ctx, cancel := context.WithTimeout(ctx, hostHealthCheckTimeout)
+	defer cancel()
+	res := &pbs.HostHealthCheckResult{
+		HostId:    ep.GetHostId(),
+		Address:   ep.GetAddress(),
+		Port:      ep.GetPort(),
+		CheckTime: timestamppb.Now(),
+	}
+	conn, err := c.dialFn(ctx, "tcp", net.JoinHostPort(ep.GetAddress(), strconv.FormatUint(uint64(ep.GetPort()), 10)))
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	_ = conn.Close()
+	res.Healthy = true
+	return res
+}
+
+func (c *hostHealthChecker) record(res *pbs.HostHealthCheckResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := keyOf(res.GetHostId(), res.GetAddress(), res.GetPort())
+	c.lastChecked[k] = res.GetCheckTime().AsTime()
+	c.results[k] = res
+}
+
+// pendingResults returns the most recent result of each endpoint which has
+// not been reported yet.
+func (c *hostHealthChecker) pendingResults() []*pbs.HostHealthCheckResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.results) == 0 {
+		return nil
+	}
+	results := make([]*pbs.HostHealthCheckResult, 0, len(c.results))
+	for _, res := range c.results {
+		results = append(results, res)
+	}
+	return results
+}
+
+// reported removes results which were reported successfully, unless they
+// have since been replaced by a newer result.
+func (c *hostHealthChecker) reported(results []*pbs.HostHealthCheckResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, res := range results {
+		k := keyOf(res.GetHostId(), res.GetAddress(), res.GetPort())
+		if c.results[k] == res {
+			delete(c.results, k)
+		}
+	}
+}
+
+func (w *Worker) startHostHealthCheckTicking(cancelCtx context.Context) {
+	const op = "worker.(Worker).startHostHealthCheckTicking"
+	// Checking for due endpoints more often than the check interval means
+	// newly assigned endpoints are checked soon after the controller sends
+	// them.
+	ticker := time.NewTicker(hostHealthCheckInterval / 6)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(w.baseContext, op, "host health check ticking shutting down")
+			return
+		case <-ticker.C:
+			w.hostHealthChecker.checkDue(cancelCtx)
+		}
+	}
+}
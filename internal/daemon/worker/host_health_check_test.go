@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostHealthChecker(t *testing.T) {
+	ctx := context.Background()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	openPort := l.Addr().(*net.TCPAddr).Port
+
+	// Find a port nothing is listening on.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	require.NoError(t, closed.Close())
+
+	c := newHostHealthChecker()
+	assert.Empty(t, c.pendingResults())
+
+	healthy := &pbs.HostHealthCheck{HostId: "hst_1", Address: "127.0.0.1", Port: uint32(openPort)}
+	unhealthy := &pbs.HostHealthCheck{HostId: "hst_2", Address: "127.0.0.1", Port: uint32(closedPort)}
+	c.setEndpoints([]*pbs.HostHealthCheck{healthy, unhealthy})
+	assert.Len(t, c.dueEndpoints(time.Now()), 2)
+
+	c.checkDue(ctx)
+	results := c.pendingResults()
+	require.Len(t, results, 2)
+	for _, res := range results {
+		assert.NotNil(t, res.GetCheckTime())
+		switch res.GetHostId() {
+		case "hst_1":
+			assert.True(t, res.GetHealthy())
+			assert.Empty(t, res.GetError())
+		case "hst_2":
+			assert.False(t, res.GetHealthy())
+			assert.NotEmpty(t, res.GetError())
+		}
+	}
+
+	// Endpoints are not due again until the interval has passed.
+	assert.Empty(t, c.dueEndpoints(time.Now()))
+	assert.Len(t, c.dueEndpoints(time.Now().Add(hostHealthCheckInterval)), 2)
+
+	// A newer result is not removed when an older one is reported.
+	c.record(&pbs.HostHealthCheckResult{HostId: "hst_1", Address: "127.0.0.1", Port: uint32(openPort), Healthy: true})
+	c.reported(results)
+	require.Len(t, c.pendingResults(), 1)
+	c.reported(c.pendingResults())
+	assert.Empty(t, c.pendingResults())
+
+	// Removing an endpoint stops it from being checked.
+	c.setEndpoints([]*pbs.HostHealthCheck{unhealthy})
+	due := c.dueEndpoints(time.Now().Add(hostHealthCheckInterval))
+	require.Len(t, due, 1)
+	assert.Equal(t, "hst_2", due[0].GetHostId())
+}
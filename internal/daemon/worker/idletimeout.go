@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// maxIdleCheckInterval bounds how often the bytes of a connection are sampled
+// for long idle timeouts.
+const maxIdleCheckInterval = 5 * time.Second
+
+// closeWhenIdle calls closeFn once the value returned by bytes has not changed
+// for the given timeout. It returns when ctx is done or closeFn was called and
+// should be run in its own goroutine. A timeout of zero disables the check.
+func closeWhenIdle(ctx context.Context, timeout time.Duration, bytes func() int64, closeFn func()) {
+	if timeout <= 0 {
+		return
+	}
+	interval := min(timeout/4, maxIdleCheckInterval)
+	if interval <= 0 {
+		interval = timeout
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := bytes()
+	lastActivity := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if cur := bytes(); cur != last {
+				last = cur
+				lastActivity = now
+				continue
+			}
+			if now.Sub(lastActivity) >= timeout {
+				closeFn()
+				return
+			}
+		}
+	}
+}
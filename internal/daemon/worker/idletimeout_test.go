@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloseWhenIdle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("idle", func(t *testing.T) {
+		t.Parallel()
+		closed := make(chan struct{})
+		start := time.Now()
+		go closeWhenIdle(ctx, 100*time.Millisecond, func() int64 { return 10 }, func() { close(closed) })
+		select {
+		case <-closed:
+			assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+		case <-time.After(5 * time.Second):
+			t.Fatal("idle connection was not closed")
+		}
+	})
+
+	t.Run("active", func(t *testing.T) {
+		t.Parallel()
+		var n atomic.Int64
+		var closed atomic.Bool
+		done := make(chan struct{})
+		activeCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			closeWhenIdle(activeCtx, 100*time.Millisecond, n.Load, func() { closed.Store(true) })
+			close(done)
+		}()
+		for i := 0; i < 10; i++ {
+			n.Add(1)
+			time.Sleep(25 * time.Millisecond)
+		}
+		assert.False(t, closed.Load())
+		cancel()
+		<-done
+		assert.False(t, closed.Load())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+		closeWhenIdle(ctx, 0, func() int64 { return 0 }, func() { t.Fatal("closed without a timeout") })
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	proxySessionSubsystem = "worker_proxy_session"
+
+	labelTargetId  = "target_id"
+	labelProjectId = "project_id"
+	labelReason    = "reason"
+
+	// bytesRecordInterval is how often the bytes proxied by a connection are
+	// added to the bytes counters while the connection is open.
+	bytesRecordInterval = 5 * time.Second
+)
+
+// HandshakeFailureReason is the reason a worker refused to proxy a
+// connection before any bytes were proxied.
+type HandshakeFailureReason string
+
+const (
+	HandshakeInvalid            HandshakeFailureReason = "invalid_handshake"
+	HandshakeInvalidTofuToken   HandshakeFailureReason = "invalid_tofu_token"
+	HandshakeMismatchedTofu     HandshakeFailureReason = "mismatched_tofu_token"
+	HandshakeActivationFailed   HandshakeFailureReason = "activation_failed"
+	HandshakeUnauthorized       HandshakeFailureReason = "authorization_failed"
+	HandshakeLimitExceeded      HandshakeFailureReason = "limit_exceeded"
+	HandshakeProxySetupFailed   HandshakeFailureReason = "proxy_setup_failed"
+	HandshakeConnectFailed      HandshakeFailureReason = "connect_failed"
+	HandshakeWorkerNotReady     HandshakeFailureReason = "worker_not_ready"
+	HandshakeResultWriteFailure HandshakeFailureReason = "handshake_result_write_failed"
+)
+
+var handshakeFailureReasons = []HandshakeFailureReason{
+	HandshakeInvalid,
+	HandshakeInvalidTofuToken,
+	HandshakeMismatchedTofu,
+	HandshakeActivationFailed,
+	HandshakeUnauthorized,
+	HandshakeLimitExceeded,
+	HandshakeProxySetupFailed,
+	HandshakeConnectFailed,
+	HandshakeWorkerNotReady,
+	HandshakeResultWriteFailure,
+}
+
+var (
+	proxiedBytesUp = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: proxySessionSubsystem,
+			Name:      "bytes_up_total",
+			Help:      "Count of bytes proxied from clients to targets, by target and project.",
+		},
+		[]string{labelTargetId, labelProjectId},
+	)
+
+	proxiedBytesDown = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: proxySessionSubsystem,
+			Name:      "bytes_down_total",
+			Help:      "Count of bytes proxied from targets to clients, by target and project.",
+		},
+		[]string{labelTargetId, labelProjectId},
+	)
+
+	endpointDialLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: proxySessionSubsystem,
+			Name:      "endpoint_dial_duration_seconds",
+			Help:      "Histogram of latencies for dialing the upstream host of a target.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{labelTargetId},
+	)
+
+	handshakeFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: proxySessionSubsystem,
+			Name:      "handshake_failures_total",
+			Help:      "Count of proxy connections refused by the worker before proxying, by reason.",
+		},
+		[]string{labelReason},
+	)
+
+	activeSessionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(globals.MetricNamespace, proxySessionSubsystem, "active_sessions"),
+		"Count of sessions with at least one open connection on this worker, by target.",
+		[]string{labelTargetId}, nil,
+	)
+
+	activeConnectionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(globals.MetricNamespace, proxySessionSubsystem, "active_connections"),
+		"Count of open session connections on this worker, by target.",
+		[]string{labelTargetId}, nil,
+	)
+)
+
+// TargetStats are the active sessions and connections a worker is proxying
+// for a single target.
+type TargetStats struct {
+	ActiveSessions    int
+	ActiveConnections int
+}
+
+// sessionCollector reports the active sessions and connections per target
+// as gauges computed at collection time.
+type sessionCollector struct {
+	statsFn func() map[string]TargetStats
+}
+
+func (c *sessionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeSessionsDesc
+	ch <- activeConnectionsDesc
+}
+
+func (c *sessionCollector) Collect(ch chan<- prometheus.Metric) {
+	for targetId, s := range c.statsFn() {
+		ch <- prometheus.MustNewConstMetric(activeSessionsDesc, prometheus.GaugeValue, float64(s.ActiveSessions), targetId)
+		ch <- prometheus.MustNewConstMetric(activeConnectionsDesc, prometheus.GaugeValue, float64(s.ActiveConnections), targetId)
+	}
+}
+
+// InitializeSessionCollectors registers the proxied session collectors onto
+// `r`. statsFn is called on every collection to report the active sessions
+// and connections per target. It panics upon the first registration that
+// causes an error.
+func InitializeSessionCollectors(r prometheus.Registerer, statsFn func() map[string]TargetStats) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(proxiedBytesUp, proxiedBytesDown, endpointDialLatency, handshakeFailures)
+	if statsFn != nil {
+		r.MustRegister(&sessionCollector{statsFn: statsFn})
+	}
+	for _, reason := range handshakeFailureReasons {
+		handshakeFailures.WithLabelValues(string(reason))
+	}
+}
+
+// RecordHandshakeFailure counts a proxy connection refused for the given
+// reason.
+func RecordHandshakeFailure(reason HandshakeFailureReason) {
+	handshakeFailures.WithLabelValues(string(reason)).Inc()
+}
+
+// RecordEndpointDial records how long dialing the upstream host of the
+// target took.
+func RecordEndpointDial(targetId string, d time.Duration) {
+	endpointDialLatency.With(prometheus.Labels{labelTargetId: targetId}).Observe(d.Seconds())
+}
+
+// RecordProxiedBytes adds the bytes proxied by a connection to the bytes
+// counters of its target and project every few seconds until ctx is done,
+// at which point the remaining bytes are added. bytesUp and bytesDown must
+// return the total number of bytes proxied by the connection so far.
+func RecordProxiedBytes(ctx context.Context, targetId, projectId string, bytesUp, bytesDown func() int64) {
+	up := proxiedBytesUp.WithLabelValues(targetId, projectId)
+	down := proxiedBytesDown.WithLabelValues(targetId, projectId)
+	var lastUp, lastDown int64
+	record := func() {
+		curUp, curDown := bytesUp(), bytesDown()
+		up.Add(float64(curUp - lastUp))
+		down.Add(float64(curDown - lastDown))
+		lastUp, lastDown = curUp, curDown
+	}
+
+	ticker := time.NewTicker(bytesRecordInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			record()
+			return
+		case <-ticker.C:
+			record()
+		}
+	}
+}
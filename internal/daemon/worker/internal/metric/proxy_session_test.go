@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeSessionCollectors(t *testing.T) {
+	require.NotPanics(t, func() { InitializeSessionCollectors(nil, nil) })
+	require.NotPanics(t, func() {
+		InitializeSessionCollectors(prometheus.NewRegistry(), func() map[string]TargetStats { return nil })
+	})
+}
+
+func TestSessionCollector(t *testing.T) {
+	c := &sessionCollector{statsFn: func() map[string]TargetStats {
+		return map[string]TargetStats{
+			"ttcp_1": {ActiveSessions: 1, ActiveConnections: 3},
+			"ttcp_2": {ActiveSessions: 2, ActiveConnections: 2},
+		}
+	}}
+	// Two gauges for each of the two targets.
+	assert.Equal(t, 4, testutil.CollectAndCount(c))
+}
+
+func TestRecordHandshakeFailure(t *testing.T) {
+	before := testutil.ToFloat64(handshakeFailures.WithLabelValues(string(HandshakeMismatchedTofu)))
+	RecordHandshakeFailure(HandshakeMismatchedTofu)
+	assert.Equal(t, before+1, testutil.ToFloat64(handshakeFailures.WithLabelValues(string(HandshakeMismatchedTofu))))
+}
+
+func TestRecordProxiedBytes(t *testing.T) {
+	const targetId, projectId = "ttcp_bytes", "p_bytes"
+	var up, down atomic.Int64
+	up.Store(10)
+	down.Store(20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		RecordProxiedBytes(ctx, targetId, projectId, up.Load, down.Load)
+		close(done)
+	}()
+	up.Add(5)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RecordProxiedBytes did not return after the context was canceled")
+	}
+
+	// The remaining bytes are recorded once the connection is done.
+	assert.Equal(t, float64(15), testutil.ToFloat64(proxiedBytesUp.WithLabelValues(targetId, projectId)))
+	assert.Equal(t, float64(20), testutil.ToFloat64(proxiedBytesDown.WithLabelValues(targetId, projectId)))
+}
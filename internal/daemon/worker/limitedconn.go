@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	isession "github.com/hashicorp/boundary/internal/session"
+	"golang.org/x/time/rate"
+)
+
+// errConnectionLimitExceeded is returned when a connection would exceed the
+// concurrent connection limit of its session or target.
+var errConnectionLimitExceeded = stderrors.New("concurrent connection limit exceeded")
+
+// connLimits are the limits which apply to a proxied connection. The target
+// limits apply to all connections to the target through this worker and the
+// session limits apply to all connections of the session. Zero means no limit.
+// Bandwidth limits are in bytes per second.
+type connLimits struct {
+	targetBandwidth    uint32
+	sessionBandwidth   uint32
+	targetConnections  uint32
+	sessionConnections uint32
+}
+
+func connLimitsForSession(s session.Session) connLimits {
+	return connLimits{
+		targetBandwidth:    s.GetBandwidthLimit(),
+		sessionBandwidth:   s.GetSessionBandwidthLimit(),
+		targetConnections:  s.GetMaxConcurrentConnections(),
+		sessionConnections: s.GetSessionMaxConcurrentConnections(),
+	}
+}
+
+// recordLimitExceeded records a connection the worker refuses to proxy because
+// it exceeds a concurrent connection limit against the session. The connection
+// is authorized, since only an authorized connection can be recorded, and then
+// closed with the ConnectionLimitExceeded reason. Connections closed with that
+// reason don't count toward the connection limit of the session, so refused
+// connections don't use it up.
+func recordLimitExceeded(ctx context.Context, sessionManager session.Manager, sess session.Session, workerId string, connCancel context.CancelFunc) error {
+	acResp, _, err := sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
+	if err != nil {
+		return err
+	}
+	sessionManager.RequestCloseConnections(ctx, map[string]*session.ConnectionCloseData{
+		acResp.GetConnectionId(): {
+			SessionId: sess.GetId(),
+			Reason:    isession.ConnectionLimitExceeded,
+		},
+	})
+	return nil
+}
+
+type limitedScope struct {
+	conns   uint32
+	limiter *rate.Limiter
+}
+
+// connLimiter tracks the connections proxied by the worker for each target
+// and session in order to enforce their concurrent connection limits, and
+// holds the token buckets shared by those connections to enforce their
+// bandwidth limits.
+type connLimiter struct {
+	mu       sync.Mutex
+	targets  map[string]*limitedScope
+	sessions map[string]*limitedScope
+}
+
+func newConnLimiter() *connLimiter {
+	return &connLimiter{
+		targets:  make(map[string]*limitedScope),
+		sessions: make(map[string]*limitedScope),
+	}
+}
+
+// acquire registers a new connection for the target and session. It returns
+// errConnectionLimitExceeded if the connection would exceed a concurrent
+// connection limit. Otherwise it returns the token buckets the bandwidth of
+// the connection counts against and a function which must be called once the
+// connection is closed.
+func (l *connLimiter) acquire(targetId, sessionId string, limits connLimits) ([]*rate.Limiter, func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.targets[targetId]
+	if !ok {
+		t = &limitedScope{}
+	}
+	s, ok := l.sessions[sessionId]
+	if !ok {
+		s = &limitedScope{}
+	}
+	if (limits.targetConnections > 0 && t.conns >= limits.targetConnections) ||
+		(limits.sessionConnections > 0 && s.conns >= limits.sessionConnections) {
+		return nil, nil, errConnectionLimitExceeded
+	}
+	l.targets[targetId] = t
+	l.sessions[sessionId] = s
+	t.conns++
+	s.conns++
+	t.limiter = updateLimiter(t.limiter, limits.targetBandwidth)
+	s.limiter = updateLimiter(s.limiter, limits.sessionBandwidth)
+
+	var limiters []*rate.Limiter
+	for _, lim := range []*rate.Limiter{s.limiter, t.limiter} {
+		if lim != nil {
+			limiters = append(limiters, lim)
+		}
+	}
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			releaseScope(l.targets, targetId)
+			releaseScope(l.sessions, sessionId)
+		})
+	}
+	return limiters, release, nil
+}
+
+func releaseScope(scopes map[string]*limitedScope, id string) {
+	s, ok := scopes[id]
+	if !ok {
+		return
+	}
+	s.conns--
+	if s.conns == 0 {
+		delete(scopes, id)
+	}
+}
+
+// updateLimiter returns a token bucket allowing bytesPerSecond with a burst of
+// one second, reusing l if possible so the bucket stays shared with existing
+// connections. It returns nil if bytesPerSecond is zero.
+func updateLimiter(l *rate.Limiter, bytesPerSecond uint32) *rate.Limiter {
+	switch {
+	case bytesPerSecond == 0:
+		return nil
+	case l == nil:
+		return rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+	case l.Limit() != rate.Limit(bytesPerSecond):
+		l.SetLimit(rate.Limit(bytesPerSecond))
+		l.SetBurst(int(bytesPerSecond))
+	}
+	return l
+}
+
+// rateLimitedConn is a `net.Conn` implementation that limits the bandwidth of
+// Read() and Write() using token buckets. Bytes in either direction take
+// tokens from every bucket. All other `net.Conn` function calls are a
+// pass-through to the underlying `net.Conn`.
+type rateLimitedConn struct {
+	net.Conn
+
+	ctx      context.Context
+	limiters []*rate.Limiter
+}
+
+// newRateLimitedConn returns conn unchanged if there are no limiters.
+func newRateLimitedConn(ctx context.Context, conn net.Conn, limiters []*rate.Limiter) net.Conn {
+	if len(limiters) == 0 {
+		return conn
+	}
+	return &rateLimitedConn{Conn: conn, ctx: ctx, limiters: limiters}
+}
+
+// Read wraps the embedded conn's Read() and waits for tokens for the bytes
+// read before returning. At most one burst of bytes is read at a time.
+func (c *rateLimitedConn) Read(in []byte) (int, error) {
+	if chunk := c.chunkSize(); len(in) > chunk {
+		in = in[:chunk]
+	}
+	n, err := c.Conn.Read(in)
+	if n > 0 {
+		if waitErr := c.wait(n); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+	return n, err
+}
+
+// Write wraps the embedded conn's Write() and waits for tokens before writing
+// each burst of bytes.
+func (c *rateLimitedConn) Write(in []byte) (int, error) {
+	var written int
+	for len(in) > 0 {
+		chunk := min(len(in), c.chunkSize())
+		if err := c.wait(chunk); err != nil {
+			return written, err
+		}
+		n, err := c.Conn.Write(in[:chunk])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		in = in[chunk:]
+	}
+	return written, nil
+}
+
+// chunkSize returns the smallest burst of the limiters, since waiting for
+// more tokens than the burst of a limiter fails.
+func (c *rateLimitedConn) chunkSize() int {
+	chunk := c.limiters[0].Burst()
+	for _, l := range c.limiters[1:] {
+		chunk = min(chunk, l.Burst())
+	}
+	return max(chunk, 1)
+}
+
+func (c *rateLimitedConn) wait(n int) error {
+	for _, l := range c.limiters {
+		if err := l.WaitN(c.ctx, min(n, l.Burst())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
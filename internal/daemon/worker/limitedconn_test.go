@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	isession "github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestConnLimiter(t *testing.T) {
+	t.Parallel()
+	l := newConnLimiter()
+	limits := connLimits{
+		targetBandwidth:    1024,
+		targetConnections:  2,
+		sessionConnections: 1,
+	}
+
+	limiters, release1, err := l.acquire("ttcp_1", "s_1", limits)
+	require.NoError(t, err)
+	require.Len(t, limiters, 1)
+	targetLimiter := limiters[0]
+	assert.Equal(t, rate.Limit(1024), targetLimiter.Limit())
+
+	// The session only allows one connection.
+	_, _, err = l.acquire("ttcp_1", "s_1", limits)
+	assert.ErrorIs(t, err, errConnectionLimitExceeded)
+
+	// Another session shares the bucket of the target.
+	limiters, release2, err := l.acquire("ttcp_1", "s_2", limits)
+	require.NoError(t, err)
+	require.Len(t, limiters, 1)
+	assert.Same(t, targetLimiter, limiters[0])
+
+	// The target only allows two connections.
+	_, _, err = l.acquire("ttcp_1", "s_3", limits)
+	assert.ErrorIs(t, err, errConnectionLimitExceeded)
+
+	// Other targets are not affected, and a session bandwidth limit adds a
+	// bucket for the session.
+	limiters, release3, err := l.acquire("ttcp_2", "s_4", connLimits{sessionBandwidth: 512})
+	require.NoError(t, err)
+	require.Len(t, limiters, 1)
+	assert.Equal(t, rate.Limit(512), limiters[0].Limit())
+	release3()
+
+	// Releasing more than once has no effect.
+	release1()
+	release1()
+	_, release4, err := l.acquire("ttcp_1", "s_1", limits)
+	require.NoError(t, err)
+	_, _, err = l.acquire("ttcp_1", "s_3", limits)
+	assert.ErrorIs(t, err, errConnectionLimitExceeded)
+
+	release2()
+	release4()
+	assert.Empty(t, l.targets)
+	assert.Empty(t, l.sessions)
+
+	// No limits means no buckets.
+	limiters, release5, err := l.acquire("ttcp_1", "s_1", connLimits{})
+	require.NoError(t, err)
+	assert.Empty(t, limiters)
+	release5()
+}
+
+type limitExceededSession struct {
+	session.Session
+	authorizedWorkerId string
+}
+
+func (s *limitExceededSession) GetId() string { return "s_1" }
+
+func (s *limitExceededSession) RequestAuthorizeConnection(_ context.Context, workerId string, _ context.CancelFunc) (*pbs.AuthorizeConnectionResponse, int32, error) {
+	s.authorizedWorkerId = workerId
+	return &pbs.AuthorizeConnectionResponse{ConnectionId: "sc_1"}, 0, nil
+}
+
+type limitExceededManager struct {
+	session.Manager
+	closed map[string]*session.ConnectionCloseData
+}
+
+func (m *limitExceededManager) RequestCloseConnections(_ context.Context, closeInfo map[string]*session.ConnectionCloseData) bool {
+	m.closed = closeInfo
+	return true
+}
+
+func TestRecordLimitExceeded(t *testing.T) {
+	t.Parallel()
+	sess := &limitExceededSession{}
+	manager := &limitExceededManager{}
+	require.NoError(t, recordLimitExceeded(context.Background(), manager, sess, "w_1", func() {}))
+	assert.Equal(t, "w_1", sess.authorizedWorkerId)
+	assert.Equal(t, map[string]*session.ConnectionCloseData{
+		"sc_1": {SessionId: "s_1", Reason: isession.ConnectionLimitExceeded},
+	}, manager.closed)
+}
+
+func TestRateLimitedConn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	conn := &testNetConn{}
+	assert.Same(t, conn, newRateLimitedConn(ctx, conn, nil))
+
+	client, server := net.Pipe()
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = server.Close()
+	})
+	const bytesPerSecond = 1000
+	limiter := rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)
+	lc := newRateLimitedConn(ctx, server, []*rate.Limiter{limiter})
+
+	// Writes larger than the burst are split and take longer than a second
+	// once the initial burst is used.
+	payload := bytes.Repeat([]byte("a"), 2*bytesPerSecond)
+	received := make(chan []byte)
+	go func() {
+		buf := make([]byte, 0, len(payload))
+		tmp := make([]byte, 4096)
+		for len(buf) < len(payload) {
+			n, err := client.Read(tmp)
+			if err != nil {
+				break
+			}
+			buf = append(buf, tmp[:n]...)
+		}
+		received <- buf
+	}()
+	start := time.Now()
+	n, err := lc.Write(payload)
+	require.NoError(t, err)
+	assert.Equal(t, len(payload), n)
+	assert.Equal(t, payload, <-received)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+
+	// Reads are limited to the burst.
+	go func() {
+		_, _ = client.Write(payload)
+	}()
+	buf := make([]byte, len(payload))
+	n, err = lc.Read(buf)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, n, bytesPerSecond)
+
+	// Waiting for tokens stops when the context is canceled.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	lc = newRateLimitedConn(cancelCtx, server, []*rate.Limiter{rate.NewLimiter(1, 1)})
+	_, err = lc.Write([]byte("ab"))
+	assert.Error(t, err)
+}
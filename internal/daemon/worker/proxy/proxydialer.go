@@ -10,6 +10,7 @@ import (
 	"net/netip"
 	"net/url"
 	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/miekg/dns"
@@ -113,8 +114,9 @@ func (p *proxyAddr) Port() uint32 {
 
 // ProxyDialer dials downstream to eventually get to the target host.
 type ProxyDialer struct {
-	dialFn     func(...Option) (net.Conn, error)
-	latestAddr atomic.Pointer[proxyAddr]
+	dialFn       func(...Option) (net.Conn, error)
+	latestAddr   atomic.Pointer[proxyAddr]
+	dialDuration atomic.Int64
 }
 
 // Returns a new proxy dialer using the provided function to get the net.Conn.
@@ -135,6 +137,12 @@ func (d *ProxyDialer) LastConnectionAddr() *proxyAddr {
 	return d.latestAddr.Load()
 }
 
+// LastDialDuration returns how long the last Dial() call took, whether or not
+// it succeeded. Zero is returned if Dial() has never been called.
+func (d *ProxyDialer) LastDialDuration() time.Duration {
+	return time.Duration(d.dialDuration.Load())
+}
+
 // portAndIpGetter allows a dialing function to return a connection that can
 // provide it's ip address and port through the GetIp and GetPort methods
 // instead of providing directly a *net.TCPConn.  This might be helpful if the
@@ -151,7 +159,9 @@ type portAndIpGetter interface {
 // dial function associated with this ProxyDialer.
 func (d *ProxyDialer) Dial(ctx context.Context, opt ...Option) (net.Conn, error) {
 	const op = "proxy.(*ProxyDialer).Dial"
+	start := time.Now()
 	c, err := d.dialFn(opt...)
+	d.dialDuration.Store(int64(time.Since(start)))
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +42,12 @@ func TestProxyDialer(t *testing.T) {
 		})
 		require.NoError(t, err)
 		assert.Nil(t, d.LastConnectionAddr())
+		assert.Zero(t, d.LastDialDuration())
 		badC, err := d.Dial(ctx)
 		require.Error(t, err)
 		require.Nil(t, badC)
 		assert.Nil(t, d.LastConnectionAddr())
+		assert.NotZero(t, d.LastDialDuration())
 	})
 
 	t.Run("Successful Dial", func(t *testing.T) {
@@ -61,5 +63,6 @@ func TestProxyDialer(t *testing.T) {
 		tcpAddr := l.Addr().(*net.TCPAddr)
 		assert.Equal(t, tcpAddr.IP.String(), d.LastConnectionAddr().Ip())
 		assert.EqualValues(t, tcpAddr.Port, d.LastConnectionAddr().Port())
+		assert.NotZero(t, d.LastDialDuration())
 	})
 }
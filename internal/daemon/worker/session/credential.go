@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"golang.org/x/crypto/ssh"
+)
+
+// SshSigner returns an ssh.Signer for the provided injected credential. SSH
+// private key credentials protected by a passphrase are decrypted using the
+// passphrase brokered with the credential. SSH certificate credentials return
+// a signer which presents the certificate during authentication.
+func SshSigner(ctx context.Context, c *pbs.Credential) (ssh.Signer, error) {
+	switch {
+	case c.GetSshPrivateKey() != nil:
+		k := c.GetSshPrivateKey()
+		cs, err := credential.SshPrivateKeySigner(ctx, credential.PrivateKey(k.GetPrivateKey()), []byte(k.GetPrivateKeyPassphrase()))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ssh private key: %w", err)
+		}
+		signer, err := ssh.NewSignerFromSigner(cs)
+		if err != nil {
+			return nil, fmt.Errorf("error creating ssh signer: %w", err)
+		}
+		return signer, nil
+
+	case c.GetSshCertificate() != nil:
+		k := c.GetSshCertificate()
+		cs, err := credential.SshPrivateKeySigner(ctx, credential.PrivateKey(k.GetPrivateKey()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ssh certificate private key: %w", err)
+		}
+		signer, err := ssh.NewSignerFromSigner(cs)
+		if err != nil {
+			return nil, fmt.Errorf("error creating ssh signer: %w", err)
+		}
+		pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k.GetCertificate()))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ssh certificate: %w", err)
+		}
+		cert, ok := pub.(*ssh.Certificate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected ssh certificate type %T", pub)
+		}
+		certSigner, err := ssh.NewCertSigner(cert, signer)
+		if err != nil {
+			return nil, fmt.Errorf("error creating ssh certificate signer: %w", err)
+		}
+		return certSigner, nil
+
+	default:
+		return nil, errors.New("credential is not an ssh credential")
+	}
+}
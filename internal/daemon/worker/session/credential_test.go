@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSshSigner(t *testing.T) {
+	ctx := context.Background()
+
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	plainKey := string(pem.EncodeToMemory(block))
+	block, err = ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte("passphrase"))
+	require.NoError(t, err)
+	encryptedKey := string(pem.EncodeToMemory(block))
+
+	_, caKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromKey(caKey)
+	require.NoError(t, err)
+	cert := &ssh.Certificate{
+		Key:             sshPub,
+		CertType:        ssh.UserCert,
+		ValidPrincipals: []string{"user"},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, caSigner))
+
+	t.Run("private-key", func(t *testing.T) {
+		signer, err := SshSigner(ctx, &pbs.Credential{
+			Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{
+					Username:   "user",
+					PrivateKey: plainKey,
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sshPub.Marshal(), signer.PublicKey().Marshal())
+	})
+	t.Run("encrypted-private-key", func(t *testing.T) {
+		signer, err := SshSigner(ctx, &pbs.Credential{
+			Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{
+					Username:             "user",
+					PrivateKey:           encryptedKey,
+					PrivateKeyPassphrase: "passphrase",
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sshPub.Marshal(), signer.PublicKey().Marshal())
+	})
+	t.Run("encrypted-private-key-wrong-passphrase", func(t *testing.T) {
+		_, err := SshSigner(ctx, &pbs.Credential{
+			Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{
+					Username:             "user",
+					PrivateKey:           encryptedKey,
+					PrivateKeyPassphrase: "wrong",
+				},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "incorrect private key passphrase")
+	})
+	t.Run("certificate", func(t *testing.T) {
+		signer, err := SshSigner(ctx, &pbs.Credential{
+			Credential: &pbs.Credential_SshCertificate{
+				SshCertificate: &pbs.SshCertificate{
+					Username:    "user",
+					PrivateKey:  plainKey,
+					Certificate: string(ssh.MarshalAuthorizedKey(cert)),
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cert.Marshal(), signer.PublicKey().Marshal())
+	})
+	t.Run("not-ssh", func(t *testing.T) {
+		_, err := SshSigner(ctx, &pbs.Credential{
+			Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{Username: "user", Password: "pass"},
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestSess_GetSshSigners(t *testing.T) {
+	ctx := context.Background()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte("passphrase"))
+	require.NoError(t, err)
+	encryptedKey := string(pem.EncodeToMemory(block))
+
+	sshKey := func(passphrase string) *pbs.Credential {
+		return &pbs.Credential{
+			Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{
+					Username:             "user",
+					PrivateKey:           encryptedKey,
+					PrivateKeyPassphrase: passphrase,
+				},
+			},
+		}
+	}
+	userPass := &pbs.Credential{
+		Credential: &pbs.Credential_UsernamePassword{
+			UsernamePassword: &pbs.UsernamePassword{Username: "user", Password: "pass"},
+		},
+	}
+
+	s := &sess{resp: &pbs.LookupSessionResponse{Credentials: []*pbs.Credential{userPass, sshKey("passphrase")}}}
+	signers, err := s.GetSshSigners(ctx)
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+
+	s.ApplySessionUpdate(&pbs.LookupSessionResponse{Credentials: []*pbs.Credential{sshKey("wrong")}})
+	_, err = s.GetSshSigners(ctx)
+	assert.ErrorContains(t, err, "incorrect private key passphrase")
+}
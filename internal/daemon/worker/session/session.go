@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/session"
+	"golang.org/x/crypto/ssh"
 )
 
 // ValidateSessionTimeout is the duration of the timeout when the worker queries the
@@ -48,6 +49,9 @@ type ConnectionCloseData struct {
 	SessionId string
 	BytesUp   int64
 	BytesDown int64
+	// Reason is the reason the connection was closed. If empty, the reason is
+	// unknown.
+	Reason session.ClosedReason
 }
 
 // Session is the local representation of a session.  After initial loading
@@ -70,9 +74,20 @@ type Session interface {
 
 	GetTofuToken() string
 	GetConnectionLimit() int32
+	GetTargetId() string
+	GetProjectId() string
+	GetBandwidthLimit() uint32
+	GetSessionBandwidthLimit() uint32
+	GetMaxConcurrentConnections() uint32
+	GetSessionMaxConcurrentConnections() uint32
+	GetConnectionIdleTimeoutSeconds() uint32
 	GetEndpoint() string
 	GetHostKeys() ([]crypto.Signer, error)
 	GetCredentials() []*pbs.Credential
+	// GetSshSigners returns a signer for each SSH credential injected into
+	// the session. Passphrase protected private keys are decrypted with the
+	// passphrase brokered alongside them.
+	GetSshSigners(ctx context.Context) ([]ssh.Signer, error)
 	GetExpiration() time.Time
 	GetCertificate() *x509.Certificate
 	GetPrivateKey() []byte
@@ -132,6 +147,7 @@ type sess struct {
 	cert        *x509.Certificate
 	sessionId   string
 	tofuToken   string
+	sshSigners  []ssh.Signer
 }
 
 func newSess(client pbs.SessionServiceClient, resp *pbs.LookupSessionResponse) (*sess, error) {
@@ -182,7 +198,12 @@ func (s *sess) ApplySessionUpdate(r *pbs.LookupSessionResponse) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	s.resp = r
+	s.sshSigners = nil
 	s.status = r.Status
+	// The certificate is reissued when the session is extended.
+	if cert, err := x509.ParseCertificate(r.GetAuthorization().GetCertificate()); err == nil {
+		s.cert = cert
+	}
 }
 
 func (s *sess) ApplyLocalStatus(st pbs.SESSIONSTATUS) {
@@ -233,6 +254,48 @@ func (s *sess) GetConnectionLimit() int32 {
 	return s.resp.GetConnectionLimit()
 }
 
+func (s *sess) GetTargetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTargetId()
+}
+
+func (s *sess) GetProjectId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetProjectId()
+}
+
+func (s *sess) GetBandwidthLimit() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetBandwidthLimit()
+}
+
+func (s *sess) GetSessionBandwidthLimit() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetSessionBandwidthLimit()
+}
+
+func (s *sess) GetMaxConcurrentConnections() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetMaxConcurrentConnections()
+}
+
+func (s *sess) GetSessionMaxConcurrentConnections() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetSessionMaxConcurrentConnections()
+}
+
+func (s *sess) GetConnectionIdleTimeoutSeconds() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetConnectionIdleTimeoutSeconds()
+}
+
 func (s *sess) GetEndpoint() string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -267,6 +330,27 @@ func (s *sess) GetCredentials() []*pbs.Credential {
 	return s.resp.GetCredentials()
 }
 
+func (s *sess) GetSshSigners(ctx context.Context) ([]ssh.Signer, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.sshSigners != nil {
+		return s.sshSigners, nil
+	}
+	signers := []ssh.Signer{}
+	for _, c := range s.resp.GetCredentials() {
+		if c.GetSshPrivateKey() == nil && c.GetSshCertificate() == nil {
+			continue
+		}
+		signer, err := SshSigner(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	s.sshSigners = signers
+	return signers, nil
+}
+
 func (s *sess) GetStatus() pbs.SESSIONSTATUS {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -550,9 +634,13 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 func makeCloseConnectionRequest(closeInfo map[string]*ConnectionCloseData) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
 	for connId, data := range closeInfo {
+		reason := data.Reason
+		if reason == "" {
+			reason = session.UnknownReason
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			Reason:       reason.String(),
 			BytesUp:      data.BytesUp,
 			BytesDown:    data.BytesDown,
 		})
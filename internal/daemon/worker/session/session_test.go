@@ -191,11 +191,13 @@ func TestWorkerMakeCloseConnectionRequest(t *testing.T) {
 	in := map[string]*ConnectionCloseData{
 		"foo": {SessionId: "one", BytesUp: 1000, BytesDown: 2000},
 		"bar": {SessionId: "two", BytesUp: 1000, BytesDown: 2000},
+		"baz": {SessionId: "two", Reason: session.ConnectionIdleTimeout},
 	}
 	expected := &pbs.CloseConnectionRequest{
 		CloseRequestData: []*pbs.CloseConnectionRequestData{
 			{ConnectionId: "foo", Reason: session.UnknownReason.String(), BytesUp: 1000, BytesDown: 2000},
 			{ConnectionId: "bar", Reason: session.UnknownReason.String(), BytesUp: 1000, BytesDown: 2000},
+			{ConnectionId: "baz", Reason: session.ConnectionIdleTimeout.String()},
 		},
 	}
 	actual := makeCloseConnectionRequest(in)
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+)
+
+// expirationRefreshLead is how long before the known expiration of a session
+// the worker looks the session up again to learn if it was extended. This
+// keeps the local expiration up to date before the connections of the
+// session would be cleaned up as expired.
+const expirationRefreshLead = 30 * time.Second
+
+// cancelAtSessionExpiration calls cancel once the session expires. Since
+// sessions can be extended, refresh is called shortly before and at the last
+// known expiration to get the current expiration of the session, and the
+// wait continues if it was extended. It returns when ctx is done or cancel was
+// called and should be run in its own goroutine.
+func cancelAtSessionExpiration(ctx context.Context, expiration time.Time, refresh func(context.Context) (time.Time, error), cancel context.CancelFunc) {
+	next := expiration.Add(-expirationRefreshLead)
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		latest, err := refresh(ctx)
+		switch {
+		case err == nil && latest.After(expiration):
+			expiration = latest
+			next = expiration.Add(-expirationRefreshLead)
+		case time.Now().Before(expiration):
+			// Check again at the expiration in case the session is extended
+			// in the meantime.
+			next = expiration
+		default:
+			cancel()
+			return
+		}
+	}
+}
+
+// sessionExpirationRefresher returns a function which looks up the session
+// with the given id through the session manager, updating the local session,
+// and returns its expiration.
+func (w *Worker) sessionExpirationRefresher(sessionManager session.Manager, sessionId string) func(context.Context) (time.Time, error) {
+	return func(ctx context.Context) (time.Time, error) {
+		lastSuccess := w.LastStatusSuccess()
+		if lastSuccess == nil {
+			return time.Time{}, fmt.Errorf("no last status information found")
+		}
+		ctx, cancel := context.WithTimeout(ctx, session.ValidateSessionTimeout)
+		defer cancel()
+		sess, err := sessionManager.LoadLocalSession(ctx, sessionId, lastSuccess.GetWorkerId())
+		if err != nil {
+			return time.Time{}, err
+		}
+		return sess.GetExpiration(), nil
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelAtSessionExpiration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("expires", func(t *testing.T) {
+		t.Parallel()
+		expiration := time.Now().Add(100 * time.Millisecond)
+		var refreshes atomic.Int32
+		refresh := func(context.Context) (time.Time, error) {
+			refreshes.Add(1)
+			return expiration, nil
+		}
+		canceled := make(chan struct{})
+		go cancelAtSessionExpiration(ctx, expiration, refresh, func() { close(canceled) })
+		select {
+		case <-canceled:
+			assert.False(t, time.Now().Before(expiration))
+			// Once ahead of the expiration and once at the expiration.
+			assert.Equal(t, int32(2), refreshes.Load())
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection was not canceled at expiration")
+		}
+	})
+
+	t.Run("extended", func(t *testing.T) {
+		t.Parallel()
+		expiration := time.Now().Add(100 * time.Millisecond)
+		extended := expiration.Add(200 * time.Millisecond)
+		refresh := func(context.Context) (time.Time, error) {
+			return extended, nil
+		}
+		canceled := make(chan struct{})
+		go cancelAtSessionExpiration(ctx, expiration, refresh, func() { close(canceled) })
+		select {
+		case <-canceled:
+			assert.False(t, time.Now().Before(extended))
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection was not canceled at extended expiration")
+		}
+	})
+
+	t.Run("refresh-error", func(t *testing.T) {
+		t.Parallel()
+		expiration := time.Now().Add(100 * time.Millisecond)
+		refresh := func(context.Context) (time.Time, error) {
+			return time.Time{}, errors.New("session is expired")
+		}
+		canceled := make(chan struct{})
+		go cancelAtSessionExpiration(ctx, expiration, refresh, func() { close(canceled) })
+		select {
+		case <-canceled:
+			assert.False(t, time.Now().Before(expiration))
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection was not canceled after refresh error")
+		}
+	})
+
+	t.Run("context-done", func(t *testing.T) {
+		t.Parallel()
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		done := make(chan struct{})
+		go func() {
+			cancelAtSessionExpiration(cancelCtx, time.Now().Add(time.Hour), nil, func() { t.Error("canceled before expiration") })
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("did not return when the context was done")
+		}
+	})
+}
+
+func TestVerifySessionPeerCertificate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const sessionId = "s_1234567890"
+	createTime := time.Now().Add(-time.Minute)
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	newCert := func(key ed25519.PrivateKey, notAfter time.Time) *x509.Certificate {
+		template := &x509.Certificate{
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			DNSNames:              []string{sessionId},
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			SerialNumber:          big.NewInt(notAfter.UnixNano()),
+			NotBefore:             createTime.Add(-time.Minute),
+			NotAfter:              notAfter,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert
+	}
+	sessCert := newCert(privKey, time.Now().Add(time.Hour))
+	verifyOpts := func() x509.VerifyOptions {
+		roots := x509.NewCertPool()
+		roots.AddCert(sessCert)
+		return x509.VerifyOptions{
+			DNSName:   sessionId,
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		peerCert *x509.Certificate
+		wantErr  bool
+	}{
+		{name: "current", peerCert: sessCert},
+		{name: "earlier", peerCert: newCert(privKey, time.Now().Add(time.Minute))},
+		{name: "expired", peerCert: newCert(privKey, time.Now().Add(-time.Second)), wantErr: true},
+		{name: "later", peerCert: newCert(privKey, time.Now().Add(2*time.Hour)), wantErr: true},
+		{name: "other-key", peerCert: newCert(otherKey, time.Now().Add(time.Minute)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifySessionPeerCertificate(ctx, tt.peerCert, sessCert, verifyOpts())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -31,6 +31,7 @@ var checkHCPBUpstreams func(w *Worker) bool
 type LastStatusInformation struct {
 	*pbs.StatusResponse
 	StatusTime              time.Time
+	StatusRtt               time.Duration
 	LastCalculatedUpstreams []string
 }
 
@@ -199,6 +200,16 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 	versionInfo := version.Get()
 	connectionState := w.downstreamConnManager.Connected()
+	// Report the round trip time of the previous successful status request so
+	// that controllers can take it into account when selecting workers. Zero
+	// means the round trip time is not known, so a round trip time below a
+	// millisecond is reported as one millisecond.
+	var statusRttMs uint32
+	if lastStatus, ok := w.lastStatusSuccess.Load().(*LastStatusInformation); ok && lastStatus != nil {
+		statusRttMs = uint32(max(lastStatus.StatusRtt.Milliseconds(), 1))
+	}
+	healthCheckResults := w.hostHealthChecker.pendingResults()
+	statusStart := time.Now()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
 		Jobs: activeJobs,
 		WorkerStatus: &pb.ServerWorkerStatus{
@@ -209,12 +220,15 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 			KeyId:            keyId,
 			ReleaseVersion:   versionInfo.FullVersionNumber(false),
 			OperationalState: w.operationalState.Load().(server.OperationalState).String(),
+			StatusRttMs:      statusRttMs,
 		},
 		ConnectedWorkerKeyIdentifiers:         connectionState.AllKeyIds(),
 		ConnectedUnmappedWorkerKeyIdentifiers: connectionState.UnmappedKeyIds(),
 		ConnectedWorkerPublicIds:              connectionState.WorkerIds(),
 		UpdateTags:                            w.updateTags.Load(),
+		HostHealthCheckResults:                healthCheckResults,
 	})
+	statusRtt := time.Since(statusStart)
 	if err != nil {
 		event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error making status request to controller"))
 		// Check for last successful status. Ignore nil last status, this probably
@@ -282,6 +296,11 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 
 	w.updateTags.Store(false)
+	w.hostHealthChecker.reported(healthCheckResults)
+	w.hostHealthChecker.setEndpoints(result.GetHostHealthChecks())
+	if result.GetDrainRequested() {
+		w.Drain()
+	}
 
 	if authorized := result.GetAuthorizedDownstreamWorkers(); authorized != nil {
 		connectionState.DisconnectMissingWorkers(authorized.GetWorkerPublicIds())
@@ -313,7 +332,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 
 	w.updateAddresses(cancelCtx, addrs, addressReceivers)
 
-	w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now(), LastCalculatedUpstreams: addrs})
+	w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now(), StatusRtt: statusRtt, LastCalculatedUpstreams: addrs})
 
 	var nonActiveMonitoredSessionIds []string
 
@@ -132,6 +132,7 @@ type Worker struct {
 	lastStatusSuccess       *atomic.Value
 	workerStartTime         time.Time
 	operationalState        *atomic.Value
+	drainTimedOut           ua.Bool
 	upstreamConnectionState *atomic.Value
 
 	controllerMultihopConn *atomic.Value
@@ -181,6 +182,12 @@ type Worker struct {
 	statusLock sync.Mutex
 
 	downstreamConnManager *cluster.DownstreamManager
+
+	hostHealthChecker *hostHealthChecker
+
+	// connLimiter enforces the bandwidth and concurrent connection limits of
+	// the proxied connections.
+	connLimiter *connLimiter
 }
 
 func New(ctx context.Context, conf *Config) (*Worker, error) {
@@ -210,9 +217,12 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 		successfulStatusGracePeriod: new(atomic.Int64),
 		statusCallTimeoutDuration:   new(atomic.Int64),
 		upstreamConnectionState:     new(atomic.Value),
+		hostHealthChecker:           newHostHealthChecker(),
+		connLimiter:                 newConnLimiter(),
 	}
 
 	w.operationalState.Store(server.UnknownOperationalState)
+	metric.InitializeSessionCollectors(conf.PrometheusRegisterer, w.proxySessionStats)
 
 	if reverseConnReceiverFactory != nil {
 		w.downstreamReceiver = reverseConnReceiverFactory()
@@ -525,7 +535,7 @@ func (w *Worker) Start() error {
 	// Rather than deal with some of the potential error conditions for Add on
 	// the waitgroup vs. Done (in case a function exits immediately), we will
 	// always start rotation and simply exit early if we're using KMS
-	w.tickerWg.Add(2)
+	w.tickerWg.Add(3)
 	go func() {
 		defer w.tickerWg.Done()
 		w.startStatusTicking(w.baseContext, w.sessionManager, &w.addressReceivers, w.recorderManager)
@@ -534,6 +544,10 @@ func (w *Worker) Start() error {
 		defer w.tickerWg.Done()
 		w.startAuthRotationTicking(w.baseContext)
 	}()
+	go func() {
+		defer w.tickerWg.Done()
+		w.startHostHealthCheckTicking(w.baseContext)
+	}()
 
 	if w.downstreamReceiver != nil {
 		w.tickerWg.Add(2)
@@ -590,8 +604,9 @@ func (w *Worker) GracefulShutdown() error {
 		}
 	}
 
-	// Wait for running proxy connections to drain
-	for proxy.ProxyState.CurrentProxiedConnections() > 0 {
+	// Wait for running proxy connections to drain, unless the worker was
+	// draining and already gave up waiting for them.
+	for proxy.ProxyState.CurrentProxiedConnections() > 0 && !w.drainTimedOut.Load() {
 		time.Sleep(time.Millisecond * 250)
 	}
 	event.WriteSysEvent(w.baseContext, op, "worker connections have drained")
@@ -692,6 +707,34 @@ func (w *Worker) parseAndStoreTags(incoming map[string][]string) {
 	w.updateTags.Store(true)
 }
 
+// proxySessionStats returns the sessions and connections this worker is
+// currently proxying, grouped by target.
+func (w *Worker) proxySessionStats() map[string]metric.TargetStats {
+	stats := make(map[string]metric.TargetStats)
+	if w.sessionManager == nil {
+		return stats
+	}
+	w.sessionManager.ForEachLocalSession(func(s session.Session) bool {
+		var open int
+		for _, ci := range s.GetLocalConnections() {
+			switch ci.Status {
+			case pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+				pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED:
+				open++
+			}
+		}
+		if open == 0 {
+			return true
+		}
+		ts := stats[s.GetTargetId()]
+		ts.ActiveSessions++
+		ts.ActiveConnections += open
+		stats[s.GetTargetId()] = ts
+		return true
+	})
+	return stats
+}
+
 func (w *Worker) getSessionTls(sessionManager session.Manager) func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
 	const op = "worker.(Worker).getSessionTls"
 	return func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
@@ -781,16 +824,38 @@ func (w *Worker) getSessionTls(sessionManager session.Manager) func(hello *tls.C
 			if len(cs.PeerCertificates) == 0 {
 				return errors.New(ctx, errors.InvalidParameter, op, "no peer certificates provided")
 			}
-			if subtle.ConstantTimeCompare(cs.PeerCertificates[0].Raw, sess.GetCertificate().Raw) != 1 {
-				return errors.New(ctx, errors.InvalidParameter, op, "expected peer certificate to match session certificate")
-			}
-			_, err := cs.PeerCertificates[0].Verify(verifyOpts)
-			return err
+			return verifySessionPeerCertificate(ctx, cs.PeerCertificates[0], sess.GetCertificate(), verifyOpts)
 		}
 		return tlsConf, nil
 	}
 }
 
+// verifySessionPeerCertificate verifies the certificate presented by a client
+// connecting to a session. The session certificate is reissued when the
+// session is extended, so the client may still hold an earlier one. A
+// certificate other than the current session certificate is accepted if it is
+// for the session key, which the client proves possession of in the handshake,
+// is valid now, and does not expire after the current session certificate.
+func verifySessionPeerCertificate(ctx context.Context, peerCert, sessCert *x509.Certificate, verifyOpts x509.VerifyOptions) error {
+	const op = "worker.verifySessionPeerCertificate"
+	if subtle.ConstantTimeCompare(peerCert.Raw, sessCert.Raw) == 1 {
+		_, err := peerCert.Verify(verifyOpts)
+		return err
+	}
+	peerKey, ok := peerCert.PublicKey.(ed25519.PublicKey)
+	if !ok || !peerKey.Equal(sessCert.PublicKey) {
+		return errors.New(ctx, errors.InvalidParameter, op, "expected peer certificate to match session certificate")
+	}
+	if peerCert.NotAfter.After(sessCert.NotAfter) {
+		return errors.New(ctx, errors.InvalidParameter, op, "peer certificate expires after session certificate")
+	}
+	opts := verifyOpts
+	opts.Roots = x509.NewCertPool()
+	opts.Roots.AddCert(peerCert)
+	_, err := peerCert.Verify(opts)
+	return err
+}
+
 // SendUpstreamMessage facilitates sending upstream messages to the controller.
 func (w *Worker) SendUpstreamMessage(ctx context.Context, m proto.Message) (proto.Message, error) {
 	const op = "worker.(Worker).SendUpstreamMessage"
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/go-dbw"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
@@ -232,8 +233,10 @@ func (rw *Db) UnderlyingDB() func() *dbw.DB {
 
 // Exec will execute the sql with the values as parameters. The int returned
 // is the number of rows affected by the sql. WithDebug is supported.
-func (rw *Db) Exec(ctx context.Context, sql string, values []any, opt ...Option) (int, error) {
+func (rw *Db) Exec(ctx context.Context, sql string, values []any, opt ...Option) (_ int, retErr error) {
 	const op = "db.Exec"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if sql == "" {
 		return NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing sql")
 	}
@@ -249,8 +252,10 @@ func (rw *Db) Exec(ctx context.Context, sql string, values []any, opt ...Option)
 // operate within the context of any ongoing transaction for the db.Reader.  The
 // caller must close the returned *sql.Rows. Query can/should be used in
 // combination with ScanRows.
-func (rw *Db) Query(ctx context.Context, sql string, values []any, opt ...Option) (*sql.Rows, error) {
+func (rw *Db) Query(ctx context.Context, sql string, values []any, opt ...Option) (_ *sql.Rows, retErr error) {
 	const op = "db.Query"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if sql == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing sql")
 	}
@@ -409,8 +414,10 @@ func (rw *Db) DeleteItems(ctx context.Context, deleteItems []any, opt ...Option)
 // you should ensure that any objects written to the db in your TxHandler are retryable, which
 // means that the object may be sent to the db several times (retried), so things like the primary key must
 // be reset before retry
-func (rw *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, handler TxHandler) (RetryInfo, error) {
+func (rw *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, handler TxHandler) (_ RetryInfo, retErr error) {
 	const op = "db.DoTx"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if rw.underlying == nil {
 		return RetryInfo{}, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -472,8 +479,10 @@ func (rw *Db) IsTx(_ context.Context) bool {
 
 // LookupByPublicId will lookup resource by its public_id or private_id, which
 // must be unique. WithDebug is the only valid option, all other options are ignored.
-func (rw *Db) LookupById(ctx context.Context, resourceWithIder any, opt ...Option) error {
+func (rw *Db) LookupById(ctx context.Context, resourceWithIder any, opt ...Option) (retErr error) {
 	const op = "db.LookupById"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -498,8 +507,10 @@ func (rw *Db) LookupByPublicId(ctx context.Context, resource ResourcePublicIder,
 
 // LookupWhere will lookup the first resource using a where clause with
 // parameters (it only returns the first one). WithDebug is supported.
-func (rw *Db) LookupWhere(ctx context.Context, resource any, where string, args []any, opt ...Option) error {
+func (rw *Db) LookupWhere(ctx context.Context, resource any, where string, args []any, opt ...Option) (retErr error) {
 	const op = "db.LookupWhere"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -523,8 +534,10 @@ func (rw *Db) LookupWhere(ctx context.Context, resource any, where string, args
 // Supports the WithLimit option.  If WithLimit < 0, then unlimited results are returned.
 // If WithLimit == 0, then default limits are used for results.
 // Supports the WithOrder and WithDebug options.
-func (rw *Db) SearchWhere(ctx context.Context, resources any, where string, args []any, opt ...Option) error {
+func (rw *Db) SearchWhere(ctx context.Context, resources any, where string, args []any, opt ...Option) (retErr error) {
 	const op = "db.SearchWhere"
+	ctx, span := startSpan(ctx, op)
+	defer func() { tracing.EndSpan(span, retErr) }()
 	if rw.underlying == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
@@ -0,0 +1,65 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_vault_store_health_status_enm (
+    name text primary key
+      constraint only_predefined_health_statuses_allowed
+        check (
+          name in (
+            'healthy',
+            'degraded',
+            'unhealthy'
+          )
+        )
+  );
+  comment on table credential_vault_store_health_status_enm is
+    'credential_vault_store_health_status_enm is an enumeration table for the health status of a Vault credential store.';
+
+  insert into credential_vault_store_health_status_enm (name)
+  values
+    ('healthy'),
+    ('degraded'),
+    ('unhealthy');
+
+  create table credential_vault_store_health (
+    store_id wt_public_id primary key
+      constraint credential_vault_store_fkey
+        references credential_vault_store (public_id)
+        on delete cascade
+        on update cascade,
+    status text not null
+      constraint credential_vault_store_health_status_enm_fkey
+        references credential_vault_store_health_status_enm (name)
+        on delete restrict
+        on update cascade,
+    vault_reachable boolean null,
+    token_expiration_time timestamp with time zone null,
+    missing_capabilities text null,
+    last_check_error text null,
+    last_check_time wt_timestamp,
+    last_renewal_error text null,
+    last_renewal_error_time timestamp with time zone null,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table credential_vault_store_health is
+    'credential_vault_store_health is a table where each row contains the result of the most recent health check '
+    'for one Vault credential store. Rows are written by the vault_credential_store_health job and the '
+    'vault_token_renewal job.';
+  comment on column credential_vault_store_health.vault_reachable is
+    'vault_reachable is null until the vault_credential_store_health job has checked the store.';
+  comment on column credential_vault_store_health.missing_capabilities is
+    'missing_capabilities contains a Vault policy describing the capabilities the current token is missing.';
+
+  create trigger update_time_column before update on credential_vault_store_health
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_vault_store_health
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_vault_store_health
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+commit;
@@ -0,0 +1,248 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_vault_library
+    add column kv_version text null
+      constraint kv_version_latest_or_positive_integer
+        check (
+          kv_version = 'latest'
+          or kv_version ~ '^[1-9][0-9]*$'
+        ),
+    add constraint kv_version_requires_get_method
+      check (
+        kv_version is null
+        or http_method = 'GET'
+      );
+  comment on column credential_vault_library.kv_version is
+    'kv_version is set if vault_path is a KV version 2 secret. '
+    'It is either ''latest'' or the version of the secret to read.';
+
+  create table credential_vault_library_kv_secret_version (
+    library_id wt_public_id not null
+      constraint credential_vault_library_fkey
+        references credential_vault_library (public_id)
+        on delete cascade
+        on update cascade,
+    vault_path text not null
+      constraint vault_path_must_not_be_empty
+        check(length(trim(vault_path)) > 0),
+    secret_version bigint not null
+      constraint secret_version_must_be_positive
+        check(secret_version > 0),
+    previous_secret_version bigint null,
+    last_session_id wt_public_id null
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key(library_id, vault_path)
+  );
+  comment on table credential_vault_library_kv_secret_version is
+    'credential_vault_library_kv_secret_version is a table where each row contains the version of a KV version 2 secret '
+    'most recently issued to a session by a Vault credential library. vault_path is the path after templating.';
+  comment on column credential_vault_library_kv_secret_version.previous_secret_version is
+    'previous_secret_version is the version of the secret that was issued to the session before last_session_id.';
+
+  create trigger update_time_column before update on credential_vault_library_kv_secret_version
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_vault_library_kv_secret_version
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_vault_library_kv_secret_version
+    for each row execute procedure immutable_columns('library_id', 'vault_path', 'create_time');
+
+  create table credential_vault_session_kv_secret_version (
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    library_id wt_public_id not null
+      constraint credential_vault_library_fkey
+        references credential_vault_library (public_id)
+        on delete cascade
+        on update cascade,
+    vault_path text not null
+      constraint vault_path_must_not_be_empty
+        check(length(trim(vault_path)) > 0),
+    secret_version bigint not null
+      constraint secret_version_must_be_positive
+        check(secret_version > 0),
+    previous_secret_version bigint null,
+    secret_version_changed boolean not null,
+    create_time wt_timestamp,
+    primary key(session_id, library_id, vault_path)
+  );
+  comment on table credential_vault_session_kv_secret_version is
+    'credential_vault_session_kv_secret_version is a table where each row contains the version of a KV version 2 secret '
+    'issued to a session by a Vault credential library. secret_version_changed is true if the version differs from the '
+    'version the library issued to the previous session.';
+
+  create trigger default_create_time_column before insert on credential_vault_session_kv_secret_version
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_vault_session_kv_secret_version
+    for each row execute procedure immutable_columns('session_id', 'library_id', 'vault_path', 'secret_version',
+                                                     'previous_secret_version', 'secret_version_changed', 'create_time');
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_library_list_lookup;
+  create view credential_vault_library_list_lookup as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    )
+  select library.public_id         as public_id,
+         library.store_id          as store_id,
+         library.name              as name,
+         library.description       as description,
+         library.create_time       as create_time,
+         library.update_time       as update_time,
+         library.version           as version,
+         library.vault_path        as vault_path,
+         library.http_method       as http_method,
+         library.http_request_body as http_request_body,
+         library.credential_type   as credential_type,
+         library.kv_version        as kv_version,
+         coalesce(upasso.username_attribute,sshpk.username_attribute)
+                                   as username_attribute,
+         upasso.password_attribute              as password_attribute,
+         sshpk.private_key_attribute            as private_key_attribute,
+         sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute
+    from credential_vault_library library
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id;
+  comment on view credential_vault_library_list_lookup is
+    'credential_vault_library_list_lookup is a view where each row contains a credential library and any of library''s credential mapping overrides. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 78/01_ssh_signed_certs_additional_valid_principals.up.sql
+  drop view credential_vault_library_issue_credentials;
+  create view credential_vault_library_issue_credentials as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    )
+  select library.public_id    as public_id,
+    library.store_id          as store_id,
+    library.name              as name,
+    library.description       as description,
+    library.create_time       as create_time,
+    library.update_time       as update_time,
+    library.version           as version,
+    library.vault_path        as vault_path,
+    library.http_method       as http_method,
+    library.http_request_body as http_request_body,
+    library.credential_type   as credential_type,
+    library.kv_version        as kv_version,
+    null                      as key_type,
+    null                      as key_bits,
+    null                      as username,
+    null                      as ttl,
+    null                      as key_id,
+    null                      as critical_options,
+    null                      as extensions,
+    store.project_id          as project_id,
+    store.vault_address       as vault_address,
+    store.namespace           as namespace,
+    store.ca_cert             as ca_cert,
+    store.tls_server_name     as tls_server_name,
+    store.tls_skip_verify     as tls_skip_verify,
+    store.worker_filter       as worker_filter,
+    store.ct_token            as ct_token, -- encrypted
+    store.token_hmac          as token_hmac,
+    store.token_status        as token_status,
+    store.token_key_id        as token_key_id,
+    store.client_cert         as client_cert,
+    store.ct_client_key       as ct_client_key, -- encrypted
+    store.client_key_id       as client_key_id,
+    coalesce(upasso.username_attribute,sshpk.username_attribute)
+      as username_attribute,
+    upasso.password_attribute              as password_attribute,
+    sshpk.private_key_attribute            as private_key_attribute,
+    sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute,
+    'generic'                              as cred_lib_type, -- used to switch on
+    null                                   as additional_valid_principals
+    from credential_vault_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+  union
+  select library.public_id      as public_id,
+    library.store_id            as store_id,
+    library.name                as name,
+    library.description         as description,
+    library.create_time         as create_time,
+    library.update_time         as update_time,
+    library.version             as version,
+    library.vault_path          as vault_path,
+    null                        as http_method,
+    null                        as http_request_body,
+    library.credential_type     as credential_type,
+    null                        as kv_version,
+    library.key_type            as key_type,
+    library.key_bits            as key_bits,
+    library.username            as username,
+    library.ttl                 as ttl,
+    library.key_id              as key_id,
+    library.critical_options    as critical_options,
+    library.extensions          as extensions,
+    store.project_id            as project_id,
+    store.vault_address         as vault_address,
+    store.namespace             as namespace,
+    store.ca_cert               as ca_cert,
+    store.tls_server_name       as tls_server_name,
+    store.tls_skip_verify       as tls_skip_verify,
+    store.worker_filter         as worker_filter,
+    store.ct_token              as ct_token, -- encrypted
+    store.token_hmac            as token_hmac,
+    store.token_status          as token_status,
+    store.token_key_id          as token_key_id,
+    store.client_cert           as client_cert,
+    store.ct_client_key         as ct_client_key, -- encrypted
+    store.client_key_id         as client_key_id,
+    null                        as username_attribute,
+    null                        as password_attribute,
+    null                        as private_key_attribute,
+    null                        as private_key_passphrase_attribute,
+    'ssh-signed-cert'           as cred_lib_type, -- used to switch on
+    additional_valid_principals as additional_valid_principals
+    from credential_vault_ssh_cert_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id;
+  comment on view credential_vault_library_issue_credentials is
+    'credential_vault_library_issue_credentials is a view where each row contains a credential library and the credential library''s data needed to connect to Vault. '
+    'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
+    'This view should not be used to retrieve data which will be returned external to boundary.';
+
+commit;
@@ -0,0 +1,148 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_vault_auth_method_enm (
+    name text primary key
+      constraint only_predefined_auth_methods_allowed
+        check (
+          name in (
+            'approle',
+            'kubernetes'
+          )
+        )
+  );
+  comment on table credential_vault_auth_method_enm is
+    'credential_vault_auth_method_enm is an enumeration table for the Vault auth methods a credential store can use to log in to Vault.';
+
+  insert into credential_vault_auth_method_enm (name)
+  values
+    ('approle'),
+    ('kubernetes');
+
+  create table credential_vault_store_auth_method (
+    store_id wt_public_id primary key
+      constraint credential_vault_store_fkey
+        references credential_vault_store (public_id)
+        on delete cascade
+        on update cascade,
+    auth_method text not null
+      constraint credential_vault_auth_method_enm_fkey
+        references credential_vault_auth_method_enm (name)
+        on delete restrict
+        on update cascade,
+    mount_path text not null
+      constraint mount_path_must_not_be_empty
+        check(length(trim(mount_path)) > 0),
+    role_id text null
+      constraint role_id_must_not_be_empty
+        check(length(trim(role_id)) > 0),
+    secret_id bytea null -- encrypted
+      constraint secret_id_must_not_be_empty
+        check(length(secret_id) > 0),
+    secret_id_hmac bytea null
+      constraint secret_id_hmac_must_not_be_empty
+        check(length(secret_id_hmac) > 0),
+    kubernetes_role text null
+      constraint kubernetes_role_must_not_be_empty
+        check(length(trim(kubernetes_role)) > 0),
+    jwt bytea null -- encrypted
+      constraint jwt_must_not_be_empty
+        check(length(jwt) > 0),
+    jwt_hmac bytea null
+      constraint jwt_hmac_must_not_be_empty
+        check(length(jwt_hmac) > 0),
+    key_id kms_private_id null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint approle_auth_method_fields
+      check (
+        auth_method != 'approle'
+        or (
+          role_id is not null
+          and secret_id is not null
+          and secret_id_hmac is not null
+          and kubernetes_role is null
+          and jwt is null
+        )
+      ),
+    constraint kubernetes_auth_method_fields
+      check (
+        auth_method != 'kubernetes'
+        or (
+          kubernetes_role is not null
+          and role_id is null
+          and secret_id is null
+          and (jwt is null) = (jwt_hmac is null)
+        )
+      ),
+    constraint encrypted_fields_require_key_id
+      check (
+        (secret_id is null and jwt is null)
+        or key_id is not null
+      )
+  );
+  comment on table credential_vault_store_auth_method is
+    'credential_vault_store_auth_method is a table where each row contains the Vault auth method a credential_vault_store uses to log in to Vault '
+    'and obtain a new token when its current token can no longer be renewed. '
+    'A credential_vault_store can have 0 or 1 auth methods. A credential_vault_store without an auth method uses the token it was created with.';
+  comment on column credential_vault_store_auth_method.jwt is
+    'jwt is the encrypted Kubernetes service account token used to log in with the kubernetes auth method. '
+    'If it is null the controller reads the service account token of the pod it is running in.';
+
+  create trigger update_time_column before update on credential_vault_store_auth_method
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_vault_store_auth_method
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_vault_store_auth_method
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_store_list_lookup;
+  create view credential_vault_store_list_lookup as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.name                        as name,
+         store.description                 as description,
+         store.create_time                 as create_time,
+         store.update_time                 as update_time,
+         store.delete_time                 as delete_time,
+         store.version                     as version,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         cert.certificate                  as client_cert,
+         cert.certificate_key_hmac         as client_cert_key_hmac,
+         auth.auth_method                  as auth_method,
+         auth.mount_path                   as auth_mount_path,
+         auth.role_id                      as approle_role_id,
+         auth.secret_id_hmac               as approle_secret_id_hmac,
+         auth.kubernetes_role              as kubernetes_role,
+         auth.jwt_hmac                     as kubernetes_jwt_hmac
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+    left join credential_vault_store_auth_method auth
+      on store.public_id = auth.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_list_lookup is
+    'credential_vault_store_list_lookup is a view where each row contains a credential store. '
+    'If the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired'' '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+commit;
@@ -0,0 +1,179 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table target_worker_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_worker_selection_strategies_allowed
+      check (
+        name in (
+          'random',
+          'least-connections',
+          'latency-weighted',
+          'sticky'
+        )
+      )
+  );
+  comment on table target_worker_selection_strategy_enm is
+    'target_worker_selection_strategy_enm is an enumeration table for the strategies used to order the workers '
+    'that can handle a session for a target.';
+
+  insert into target_worker_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('least-connections'),
+    ('latency-weighted'),
+    ('sticky');
+
+  create table target_host_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_host_selection_strategies_allowed
+      check (
+        name in (
+          'random',
+          'round-robin',
+          'least-connections'
+        )
+      )
+  );
+  comment on table target_host_selection_strategy_enm is
+    'target_host_selection_strategy_enm is an enumeration table for the strategies used to choose the host '
+    'of a session for a target.';
+
+  insert into target_host_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('round-robin'),
+    ('least-connections');
+
+  -- Update tables. Value can be null in all cases, which is the same as random.
+  alter table target_tcp
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column host_selection_strategy text
+      constraint target_host_selection_strategy_enm_fkey
+        references target_host_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade;
+  alter table target_ssh
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column host_selection_strategy text
+      constraint target_host_selection_strategy_enm_fkey
+        references target_host_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade;
+
+  -- Replaces target_all_subtypes defined in 71/07_targets.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    worker_selection_strategy,
+    host_selection_strategy
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    worker_selection_strategy,
+    host_selection_strategy
+  from
+    target_ssh;
+
+  alter table server_worker
+    add column status_rtt_ms integer null
+      constraint status_rtt_ms_must_not_be_negative
+        check(status_rtt_ms >= 0);
+  comment on column server_worker.status_rtt_ms is
+    'status_rtt_ms is the round trip time in milliseconds of the previous status update sent by the worker.';
+
+  -- Replaces server_worker_aggregate defined in 52/01_worker_operational_state.up.sql
+  create or replace view server_worker_aggregate as
+  with worker_config_tags(worker_id, source, tags) as (
+    select
+      ct.worker_id,
+      ct.source,
+      -- keys and tags can be any lowercase printable character so use uppercase characters as delimitors.
+      string_agg(distinct concat_ws('Y', ct.key, ct.value), 'Z') as tags
+    from server_worker_tag ct
+    group by ct.worker_id, ct.source
+  ),
+  connection_count (worker_id, count) as (
+   select
+     worker_id,
+     count(1) as count
+   from session_connection
+   where closed_reason is null
+   group by worker_id
+  )
+  select
+    w.public_id,
+    w.scope_id,
+    w.description,
+    w.name,
+    w.address,
+    w.create_time,
+    w.update_time,
+    w.version,
+    w.last_status_time,
+    w.type,
+    w.release_version,
+    w.operational_state,
+    cc.count as active_connection_count,
+    -- keys and tags can be any lowercase printable character so use uppercase characters as delimitors.
+    wt.tags as api_tags,
+    ct.tags as worker_config_tags,
+    w.status_rtt_ms
+  from server_worker w
+   left join worker_config_tags wt on
+      w.public_id = wt.worker_id and wt.source = 'api'
+   left join worker_config_tags ct on
+      w.public_id = ct.worker_id and ct.source = 'configuration'
+   left join connection_count as cc on
+      w.public_id = cc.worker_id;
+
+  -- Supports the least-connections host selection strategy of
+  -- AuthorizeSession, which looks up the active sessions of a target.
+  create index session_target_id_active_ix
+    on session (target_id)
+    where termination_reason is null;
+
+commit;
@@ -0,0 +1,130 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table target_tcp
+    add column enable_host_health_checks boolean not null default false;
+  alter table target_ssh
+    add column enable_host_health_checks boolean not null default false;
+
+  -- Replaces target_all_subtypes defined in 84/04_target_selection_strategy.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks
+  from
+    target_ssh;
+
+  create table host_health_status_enm (
+    name text primary key
+      constraint only_predefined_host_health_statuses_allowed
+        check (
+          name in (
+            'unknown',
+            'healthy',
+            'unhealthy'
+          )
+        )
+  );
+  comment on table host_health_status_enm is
+    'host_health_status_enm is an enumeration table for the health status of a host endpoint.';
+
+  insert into host_health_status_enm (name)
+  values
+    ('unknown'),
+    ('healthy'),
+    ('unhealthy');
+
+  create table host_health_check (
+    target_id wt_public_id not null
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    host_id wt_public_id not null
+      constraint host_fkey
+        references host (public_id)
+        on delete cascade
+        on update cascade,
+    address text not null
+      constraint address_must_not_be_empty
+        check(length(trim(address)) > 0),
+    port integer not null
+      constraint port_must_be_in_range
+        check(port > 0 and port <= 65535),
+    status text not null default 'unknown'
+      constraint host_health_status_enm_fkey
+        references host_health_status_enm (name)
+        on delete restrict
+        on update cascade,
+    last_check_time timestamp with time zone null,
+    last_check_error text null,
+    last_check_worker_id wt_public_id null
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key (target_id, host_id, address, port)
+  );
+  comment on table host_health_check is
+    'host_health_check is a table where each row is a host endpoint of a target with host health checks enabled. '
+    'Rows are registered when the target or its host sources change and by the host health check registration '
+    'job, and contain the result of the most recent health check of the endpoint reported by a worker.';
+
+  create index host_health_check_host_id_address_port_ix
+    on host_health_check (host_id, address, port);
+
+  create trigger update_time_column before update on host_health_check
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_health_check
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_health_check
+    for each row execute procedure immutable_columns('target_id', 'host_id', 'address', 'port', 'create_time');
+
+commit;
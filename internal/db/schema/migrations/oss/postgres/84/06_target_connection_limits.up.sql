@@ -0,0 +1,134 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- A value of zero for any of the limits means there is no limit. The
+  -- bandwidth limits are in bytes per second. The limits that are not prefixed
+  -- with session apply to all connections to the target through a single
+  -- worker and are enforced by each worker independently.
+  alter table target_tcp
+    add column bandwidth_limit bigint not null default 0
+      constraint bandwidth_limit_must_not_be_negative
+        check(bandwidth_limit >= 0),
+    add column session_bandwidth_limit bigint not null default 0
+      constraint session_bandwidth_limit_must_not_be_negative
+        check(session_bandwidth_limit >= 0),
+    add column max_concurrent_connections integer not null default 0
+      constraint max_concurrent_connections_must_not_be_negative
+        check(max_concurrent_connections >= 0),
+    add column session_max_concurrent_connections integer not null default 0
+      constraint session_max_concurrent_connections_must_not_be_negative
+        check(session_max_concurrent_connections >= 0);
+  alter table target_ssh
+    add column bandwidth_limit bigint not null default 0
+      constraint bandwidth_limit_must_not_be_negative
+        check(bandwidth_limit >= 0),
+    add column session_bandwidth_limit bigint not null default 0
+      constraint session_bandwidth_limit_must_not_be_negative
+        check(session_bandwidth_limit >= 0),
+    add column max_concurrent_connections integer not null default 0
+      constraint max_concurrent_connections_must_not_be_negative
+        check(max_concurrent_connections >= 0),
+    add column session_max_concurrent_connections integer not null default 0
+      constraint session_max_concurrent_connections_must_not_be_negative
+        check(session_max_concurrent_connections >= 0);
+
+  -- Replaces target_all_subtypes defined in 84/05_host_health_check.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks,
+    bandwidth_limit,
+    session_bandwidth_limit,
+    max_concurrent_connections,
+    session_max_concurrent_connections
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks,
+    bandwidth_limit,
+    session_bandwidth_limit,
+    max_concurrent_connections,
+    session_max_concurrent_connections
+  from
+    target_ssh;
+
+  -- The limits are copied from the target when the session is created, in the
+  -- same way as the connection limit, so that the worker proxying a connection
+  -- can enforce them.
+  alter table session
+    add column bandwidth_limit bigint not null default 0
+      constraint bandwidth_limit_must_not_be_negative
+        check(bandwidth_limit >= 0),
+    add column session_bandwidth_limit bigint not null default 0
+      constraint session_bandwidth_limit_must_not_be_negative
+        check(session_bandwidth_limit >= 0),
+    add column max_concurrent_connections integer not null default 0
+      constraint max_concurrent_connections_must_not_be_negative
+        check(max_concurrent_connections >= 0),
+    add column session_max_concurrent_connections integer not null default 0
+      constraint session_max_concurrent_connections_must_not_be_negative
+        check(session_max_concurrent_connections >= 0);
+
+  -- drop constraint so we can add limit exceeded
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  -- This replaces the constraint defined in 0/51_connection.up.sql
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'limit exceeded'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('limit exceeded');
+
+commit;
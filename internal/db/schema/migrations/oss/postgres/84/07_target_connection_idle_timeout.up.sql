@@ -0,0 +1,108 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- The number of seconds without any bytes transferred in either direction
+  -- after which a worker closes a proxied connection. A value of zero means
+  -- connections are never closed for being idle.
+  alter table target_tcp
+    add column connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0);
+  alter table target_ssh
+    add column connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0);
+
+  -- Replaces target_all_subtypes defined in 84/06_target_connection_limits.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks,
+    bandwidth_limit,
+    session_bandwidth_limit,
+    max_concurrent_connections,
+    session_max_concurrent_connections,
+    connection_idle_timeout_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    worker_selection_strategy,
+    host_selection_strategy,
+    enable_host_health_checks,
+    bandwidth_limit,
+    session_bandwidth_limit,
+    max_concurrent_connections,
+    session_max_concurrent_connections,
+    connection_idle_timeout_seconds
+  from
+    target_ssh;
+
+  -- The idle timeout is copied from the target when the session is created so
+  -- that the worker proxying a connection can enforce it.
+  alter table session
+    add column connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0);
+
+  -- drop constraint so we can add idle timeout
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  -- This replaces the constraint defined in 84/06_target_connection_limits.up.sql
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'limit exceeded',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
@@ -0,0 +1,52 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Sessions can be extended, so the expiration time is no longer immutable.
+  -- The certificate is reissued with the new expiration time when a session is
+  -- extended, so it is no longer immutable either. The original expiration
+  -- time is kept to bound the length of a single extension.
+  alter table session
+    add column original_expiration_time timestamp with time zone;
+  comment on column session.original_expiration_time is
+    'original_expiration_time is the expiration time of the session when it was created.';
+
+  update session
+     set original_expiration_time = expiration_time;
+
+  create function default_session_original_expiration_time() returns trigger
+  as $$
+  begin
+    new.original_expiration_time = new.expiration_time;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function default_session_original_expiration_time() is
+    'default_session_original_expiration_time is a before insert trigger function that sets the original expiration time of a session to its expiration time.';
+
+  create trigger default_session_original_expiration_time before insert on session
+    for each row execute procedure default_session_original_expiration_time();
+
+  -- Replaces the trigger defined in 59/01_target_ingress_egress_worker_filters.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'connection_limit', 'create_time',
+      'original_expiration_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter');
+
+  create function session_expiration_time_only_extended() returns trigger
+  as $$
+  begin
+    if new.expiration_time < old.expiration_time then
+      raise exception 'session expiration time cannot be shortened';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function session_expiration_time_only_extended() is
+    'session_expiration_time_only_extended is a before update trigger function that prevents moving the expiration time of a session to an earlier time.';
+
+  create trigger session_expiration_time_only_extended before update of expiration_time on session
+    for each row execute procedure session_expiration_time_only_extended();
+
+commit;
@@ -0,0 +1,74 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Updates the constraint defined in 52/01_worker_operational_state.up.sql
+  alter table server_worker_operational_state_enm
+    drop constraint only_predefined_operational_states_allowed;
+  alter table server_worker_operational_state_enm
+    add constraint only_predefined_operational_states_allowed
+      check (
+        state in (
+          'active',
+          'draining',
+          'shutdown',
+          'unknown'
+        )
+      );
+
+  insert into server_worker_operational_state_enm (state) values
+    ('draining');
+
+  alter table server_worker
+    add column drain_requested boolean not null default false;
+  comment on column server_worker.drain_requested is
+    'drain_requested is set when a worker has been asked to drain and is cleared once the worker reports that it is no longer active.';
+
+  -- Replaces server_worker_aggregate defined in 84/04_target_selection_strategy.up.sql
+  create or replace view server_worker_aggregate as
+  with worker_config_tags(worker_id, source, tags) as (
+    select
+      ct.worker_id,
+      ct.source,
+      -- keys and tags can be any lowercase printable character so use uppercase characters as delimitors.
+      string_agg(distinct concat_ws('Y', ct.key, ct.value), 'Z') as tags
+    from server_worker_tag ct
+    group by ct.worker_id, ct.source
+  ),
+  connection_count (worker_id, count) as (
+   select
+     worker_id,
+     count(1) as count
+   from session_connection
+   where closed_reason is null
+   group by worker_id
+  )
+  select
+    w.public_id,
+    w.scope_id,
+    w.description,
+    w.name,
+    w.address,
+    w.create_time,
+    w.update_time,
+    w.version,
+    w.last_status_time,
+    w.type,
+    w.release_version,
+    w.operational_state,
+    cc.count as active_connection_count,
+    -- keys and tags can be any lowercase printable character so use uppercase characters as delimitors.
+    wt.tags as api_tags,
+    ct.tags as worker_config_tags,
+    w.status_rtt_ms,
+    w.drain_requested
+  from server_worker w
+   left join worker_config_tags wt on
+      w.public_id = wt.worker_id and wt.source = 'api'
+   left join worker_config_tags ct on
+      w.public_id = ct.worker_id and ct.source = 'configuration'
+   left join connection_count as cc on
+      w.public_id = cc.worker_id;
+
+commit;
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package db
+
+import (
+	"context"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// pkgPrefix is the prefix of the names of the functions of the db package.
+const pkgPrefix = "github.com/hashicorp/boundary/internal/db."
+
+// closureSuffix matches the suffix the runtime adds to the names of function
+// literals, e.g. ".func1" or ".func2.1".
+var closureSuffix = regexp.MustCompile(`(\.func\d+)(\.\d+)*$`)
+
+// startSpan starts a span for the db operation op. The span is named after
+// the function that called into the db package, e.g.
+// "session.(*Repository).ActivateSession", so that traces show which
+// repository calls a request made.
+func startSpan(ctx context.Context, op string) (context.Context, trace.Span) {
+	if !tracing.Enabled() {
+		// A span that is not recording, so that ending it is a no-op.
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	name := op
+	// Skip startSpan and any db methods, e.g. LookupByPublicId calling
+	// LookupById, to find the caller of the db package.
+	pcs := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, pkgPrefix) {
+			if frame.Function != "" {
+				name = frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+				name = closureSuffix.ReplaceAllString(name, "")
+			}
+			break
+		}
+		if !more {
+			break
+		}
+	}
+	return tracing.StartSpan(ctx, name, attribute.String("db.operation", op))
+}
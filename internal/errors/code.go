@@ -69,6 +69,10 @@ const (
 
 	InvalidListToken Code = 136 // InvalidListToken represents an error where the provided list token is invalid
 
+	PrivateKeyPassphraseMissing   Code = 137 // PrivateKeyPassphraseMissing represents an error where an encrypted private key was provided without a passphrase
+	PrivateKeyPassphraseIncorrect Code = 138 // PrivateKeyPassphraseIncorrect represents an error where the provided passphrase does not decrypt the private key
+	PrivateKeyPassphraseUnneeded  Code = 139 // PrivateKeyPassphraseUnneeded represents an error where a passphrase was provided for an unencrypted private key
+
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
 
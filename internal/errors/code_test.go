@@ -420,6 +420,21 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    InvalidListToken,
 			want: InvalidListToken,
 		},
+		{
+			name: "PrivateKeyPassphraseMissing",
+			c:    PrivateKeyPassphraseMissing,
+			want: PrivateKeyPassphraseMissing,
+		},
+		{
+			name: "PrivateKeyPassphraseIncorrect",
+			c:    PrivateKeyPassphraseIncorrect,
+			want: PrivateKeyPassphraseIncorrect,
+		},
+		{
+			name: "PrivateKeyPassphraseUnneeded",
+			c:    PrivateKeyPassphraseUnneeded,
+			want: PrivateKeyPassphraseUnneeded,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,4 +351,16 @@ var errorCodeInfo = map[Code]Info{
 		Message: "invalid list token",
 		Kind:    Parameter,
 	},
+	PrivateKeyPassphraseMissing: {
+		Message: "private key is encrypted and no passphrase was provided",
+		Kind:    Parameter,
+	},
+	PrivateKeyPassphraseIncorrect: {
+		Message: "incorrect private key passphrase",
+		Kind:    Parameter,
+	},
+	PrivateKeyPassphraseUnneeded: {
+		Message: "passphrase supplied for unencrypted private key",
+		Kind:    Parameter,
+	},
 }
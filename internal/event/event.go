@@ -20,6 +20,7 @@ type RequestInfo struct {
 	Path     string `json:"path,omitempty" class:"public"`
 	PublicId string `json:"public_id,omitempty" class:"public"`
 	ClientIp string `json:"client_ip,omitempty" class:"public"`
+	TraceId  string `json:"trace_id,omitempty" class:"public"`
 }
 
 // UserInfo defines the fields captured about a user for a Boundary request.
@@ -27,6 +27,7 @@ const (
 	HostCatalogService_CreateHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/CreateHostCatalog"
 	HostCatalogService_UpdateHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/UpdateHostCatalog"
 	HostCatalogService_DeleteHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/DeleteHostCatalog"
+	HostCatalogService_ImportHosts_FullMethodName       = "/controller.api.services.v1.HostCatalogService/ImportHosts"
 )
 
 // HostCatalogServiceClient is the client API for HostCatalogService service.
@@ -59,6 +60,14 @@ type HostCatalogServiceClient interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(ctx context.Context, in *DeleteHostCatalogRequest, opts ...grpc.CallOption) (*DeleteHostCatalogResponse, error)
+	// ImportHosts makes the Hosts and Host Sets of a static Host Catalog
+	// match the Hosts and Host Sets in the request in a single transaction.
+	// Hosts and Host Sets are matched by name. Hosts and Host Sets in the
+	// Host Catalog which are not in the request are deleted, those whose
+	// fields differ are updated, and the rest are created. If the Host Catalog
+	// ID is missing, malformed, or references a non-existing or non-static
+	// Host Catalog an error is returned.
+	ImportHosts(ctx context.Context, in *ImportHostsRequest, opts ...grpc.CallOption) (*ImportHostsResponse, error)
 }
 
 type hostCatalogServiceClient struct {
@@ -114,6 +123,15 @@ func (c *hostCatalogServiceClient) DeleteHostCatalog(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *hostCatalogServiceClient) ImportHosts(ctx context.Context, in *ImportHostsRequest, opts ...grpc.CallOption) (*ImportHostsResponse, error) {
+	out := new(ImportHostsResponse)
+	err := c.cc.Invoke(ctx, HostCatalogService_ImportHosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostCatalogServiceServer is the server API for HostCatalogService service.
 // All implementations must embed UnimplementedHostCatalogServiceServer
 // for forward compatibility
@@ -144,6 +162,14 @@ type HostCatalogServiceServer interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error)
+	// ImportHosts makes the Hosts and Host Sets of a static Host Catalog
+	// match the Hosts and Host Sets in the request in a single transaction.
+	// Hosts and Host Sets are matched by name. Hosts and Host Sets in the
+	// Host Catalog which are not in the request are deleted, those whose
+	// fields differ are updated, and the rest are created. If the Host Catalog
+	// ID is missing, malformed, or references a non-existing or non-static
+	// Host Catalog an error is returned.
+	ImportHosts(context.Context, *ImportHostsRequest) (*ImportHostsResponse, error)
 	mustEmbedUnimplementedHostCatalogServiceServer()
 }
 
@@ -166,6 +192,9 @@ func (UnimplementedHostCatalogServiceServer) UpdateHostCatalog(context.Context,
 func (UnimplementedHostCatalogServiceServer) DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostCatalog not implemented")
 }
+func (UnimplementedHostCatalogServiceServer) ImportHosts(context.Context, *ImportHostsRequest) (*ImportHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHosts not implemented")
+}
 func (UnimplementedHostCatalogServiceServer) mustEmbedUnimplementedHostCatalogServiceServer() {}
 
 // UnsafeHostCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +298,24 @@ func _HostCatalogService_DeleteHostCatalog_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostCatalogService_ImportHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogServiceServer).ImportHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostCatalogService_ImportHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogServiceServer).ImportHosts(ctx, req.(*ImportHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostCatalogService_ServiceDesc is the grpc.ServiceDesc for HostCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +343,10 @@ var HostCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHostCatalog",
 			Handler:    _HostCatalogService_DeleteHostCatalog_Handler,
 		},
+		{
+			MethodName: "ImportHosts",
+			Handler:    _HostCatalogService_ImportHosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/host_catalog_service.proto",
@@ -22,9 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SessionService_GetSession_FullMethodName    = "/controller.api.services.v1.SessionService/GetSession"
-	SessionService_ListSessions_FullMethodName  = "/controller.api.services.v1.SessionService/ListSessions"
-	SessionService_CancelSession_FullMethodName = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_GetSession_FullMethodName              = "/controller.api.services.v1.SessionService/GetSession"
+	SessionService_ListSessions_FullMethodName            = "/controller.api.services.v1.SessionService/ListSessions"
+	SessionService_CancelSession_FullMethodName           = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_ExtendSession_FullMethodName           = "/controller.api.services.v1.SessionService/ExtendSession"
+	SessionService_CancelSessionConnection_FullMethodName = "/controller.api.services.v1.SessionService/CancelSessionConnection"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -45,6 +47,20 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// ExtendSession pushes out the expiration time of an existing Session in
+	// boundary and reissues the Session certificate with the new expiration time.
+	// A single extension is limited to the original lifetime of the Session and
+	// the total lifetime of the Session is limited by the controller
+	// configuration. An error is returned if the request attempts to extend a
+	// Session that does not exist, is no longer pending or active, or has reached
+	// its maximum lifetime.
+	ExtendSession(ctx context.Context, in *ExtendSessionRequest, opts ...grpc.CallOption) (*ExtendSessionResponse, error)
+	// CancelSessionConnection closes a single live connection of an existing
+	// Session in boundary, leaving the Session and its other connections
+	// untouched. The worker proxying the connection terminates it on its next
+	// status update. An error is returned if the Session does not exist or the
+	// connection does not belong to the Session or is already closed.
+	CancelSessionConnection(ctx context.Context, in *CancelSessionConnectionRequest, opts ...grpc.CallOption) (*CancelSessionConnectionResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -82,6 +98,24 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) ExtendSession(ctx context.Context, in *ExtendSessionRequest, opts ...grpc.CallOption) (*ExtendSessionResponse, error) {
+	out := new(ExtendSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_ExtendSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) CancelSessionConnection(ctx context.Context, in *CancelSessionConnectionRequest, opts ...grpc.CallOption) (*CancelSessionConnectionResponse, error) {
+	out := new(CancelSessionConnectionResponse)
+	err := c.cc.Invoke(ctx, SessionService_CancelSessionConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -100,6 +134,20 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// ExtendSession pushes out the expiration time of an existing Session in
+	// boundary and reissues the Session certificate with the new expiration time.
+	// A single extension is limited to the original lifetime of the Session and
+	// the total lifetime of the Session is limited by the controller
+	// configuration. An error is returned if the request attempts to extend a
+	// Session that does not exist, is no longer pending or active, or has reached
+	// its maximum lifetime.
+	ExtendSession(context.Context, *ExtendSessionRequest) (*ExtendSessionResponse, error)
+	// CancelSessionConnection closes a single live connection of an existing
+	// Session in boundary, leaving the Session and its other connections
+	// untouched. The worker proxying the connection terminates it on its next
+	// status update. An error is returned if the Session does not exist or the
+	// connection does not belong to the Session or is already closed.
+	CancelSessionConnection(context.Context, *CancelSessionConnectionRequest) (*CancelSessionConnectionResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -116,6 +164,12 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) ExtendSession(context.Context, *ExtendSessionRequest) (*ExtendSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendSession not implemented")
+}
+func (UnimplementedSessionServiceServer) CancelSessionConnection(context.Context, *CancelSessionConnectionRequest) (*CancelSessionConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSessionConnection not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +237,42 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ExtendSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ExtendSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ExtendSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ExtendSession(ctx, req.(*ExtendSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_CancelSessionConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSessionConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CancelSessionConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CancelSessionConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CancelSessionConnection(ctx, req.(*CancelSessionConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +292,14 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "ExtendSession",
+			Handler:    _SessionService_ExtendSession_Handler,
+		},
+		{
+			MethodName: "CancelSessionConnection",
+			Handler:    _SessionService_CancelSessionConnection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// ImportResult contains the number of hosts and host sets created, updated,
+// and deleted by ImportHosts.
+type ImportResult struct {
+	HostsCreated    int
+	HostsUpdated    int
+	HostsDeleted    int
+	HostSetsCreated int
+	HostSetsUpdated int
+	HostSetsDeleted int
+}
+
+// ImportHosts makes the hosts and host sets in catalogId match hosts, sets,
+// and members in a single transaction. Hosts and host sets are matched by
+// name.
+//
+// Each host in hosts must contain a Name which is unique within hosts and a
+// valid Address. Each host set in sets must contain a Name which is unique
+// within sets. A Description is optional for both. The CatalogId and
+// PublicId of each host and host set are ignored.
+//
+// members maps the name of a host set in sets to the names of the hosts in
+// hosts which are members of the host set. A host set without an entry in
+// members has no members.
+//
+// A host or host set in catalogId which is not in hosts or sets, including
+// one without a name, is deleted. A host or host set whose Description or
+// Address differs from the existing one is updated. All other hosts and host
+// sets are created. The membership of a host set which changes increments
+// the version of the host set. opt is ignored.
+func (r *Repository) ImportHosts(ctx context.Context, projectId, catalogId string, hosts []*Host, sets []*HostSet, members map[string][]string, _ ...Option) (*ImportResult, error) {
+	const op = "static.(Repository).ImportHosts"
+	switch {
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	case catalogId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	}
+
+	wantHosts := make(map[string]*Host, len(hosts))
+	for _, h := range hosts {
+		if h == nil || h.Host == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "nil Host")
+		}
+		if h.Name == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "host without a name")
+		}
+		if _, ok := wantHosts[h.Name]; ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("duplicate host name %q", h.Name))
+		}
+		h = h.clone()
+		h.CatalogId = catalogId
+		h.Address = strings.TrimSpace(h.Address)
+		if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
+			return nil, errors.New(ctx, errors.InvalidAddress, op, fmt.Sprintf("invalid address for host %q", h.Name))
+		}
+		wantHosts[h.Name] = h
+	}
+	wantSets := make(map[string]*HostSet, len(sets))
+	for _, s := range sets {
+		if s == nil || s.HostSet == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "nil HostSet")
+		}
+		if s.Name == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "host set without a name")
+		}
+		if _, ok := wantSets[s.Name]; ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("duplicate host set name %q", s.Name))
+		}
+		s = s.clone()
+		s.CatalogId = catalogId
+		wantSets[s.Name] = s
+	}
+	for setName, hostNames := range members {
+		if _, ok := wantSets[setName]; !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("members for unknown host set %q", setName))
+		}
+		for _, hostName := range hostNames {
+			if _, ok := wantHosts[hostName]; !ok {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown host %q in host set %q", hostName, setName))
+			}
+		}
+	}
+
+	wrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var result *ImportResult
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			result = &ImportResult{}
+
+			var existingSets []*HostSet
+			if err := reader.SearchWhere(ctx, &existingSets, "catalog_id = ?", []any{catalogId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host sets"))
+			}
+			var existingHosts []*Host
+			if err := reader.SearchWhere(ctx, &existingHosts, "catalog_id = ?", []any{catalogId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list hosts"))
+			}
+
+			// Deleting host sets and hosts also deletes their memberships
+			// so they are deleted before any memberships are calculated.
+			setIds := make(map[string]string, len(wantSets))
+			for _, s := range existingSets {
+				if _, ok := wantSets[s.Name]; ok {
+					setIds[s.Name] = s.PublicId
+					continue
+				}
+				if err := deleteImported(ctx, w, wrapper, s.clone(), s.oplog(oplog.OpType_OP_TYPE_DELETE)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				result.HostSetsDeleted++
+			}
+			hostIds := make(map[string]string, len(wantHosts))
+			for _, h := range existingHosts {
+				if _, ok := wantHosts[h.Name]; ok {
+					hostIds[h.Name] = h.PublicId
+					continue
+				}
+				if err := deleteImported(ctx, w, wrapper, h.clone(), h.oplog(oplog.OpType_OP_TYPE_DELETE)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				result.HostsDeleted++
+			}
+
+			for _, h := range existingHosts {
+				want, ok := wantHosts[h.Name]
+				if !ok || (want.Description == h.Description && want.Address == h.Address) {
+					continue
+				}
+				uh := want.clone()
+				uh.PublicId = h.PublicId
+				dbMask, nullFields := dbw.BuildUpdatePaths(
+					map[string]any{
+						"Description": uh.Description,
+						"Address":     uh.Address,
+					},
+					[]string{"Description", "Address"},
+					nil,
+				)
+				if err := updateImported(ctx, w, wrapper, uh, dbMask, nullFields, h.Version, uh.oplog(oplog.OpType_OP_TYPE_UPDATE)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host %q", h.Name)))
+				}
+				result.HostsUpdated++
+			}
+			for _, h := range hosts {
+				want := wantHosts[h.Name]
+				if _, ok := hostIds[want.Name]; ok {
+					continue
+				}
+				id, err := newHostId(ctx)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				want.PublicId = id
+				if err := w.Create(ctx, want.clone(), db.WithOplog(wrapper, want.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host %q", want.Name)))
+				}
+				hostIds[want.Name] = id
+				result.HostsCreated++
+			}
+
+			// changedSets contains the ids of the host sets counted in
+			// result as created or updated.
+			changedSets := make(map[string]bool, len(wantSets))
+			for _, s := range existingSets {
+				want, ok := wantSets[s.Name]
+				if !ok || want.Description == s.Description {
+					continue
+				}
+				us := want.clone()
+				us.PublicId = s.PublicId
+				dbMask, nullFields := dbw.BuildUpdatePaths(
+					map[string]any{
+						"Description": us.Description,
+					},
+					[]string{"Description"},
+					nil,
+				)
+				if err := updateImported(ctx, w, wrapper, us, dbMask, nullFields, s.Version, us.oplog(oplog.OpType_OP_TYPE_UPDATE)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %q", s.Name)))
+				}
+				changedSets[s.PublicId] = true
+				result.HostSetsUpdated++
+			}
+			for _, s := range sets {
+				want := wantSets[s.Name]
+				if _, ok := setIds[want.Name]; ok {
+					continue
+				}
+				id, err := newHostSetId(ctx)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				want.PublicId = id
+				if err := w.Create(ctx, want.clone(), db.WithOplog(wrapper, want.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %q", want.Name)))
+				}
+				setIds[want.Name] = id
+				changedSets[id] = true
+				result.HostSetsCreated++
+			}
+
+			// Read the host sets again for the versions written by the
+			// updates above.
+			var currentSets []*HostSet
+			if err := reader.SearchWhere(ctx, &currentSets, "catalog_id = ?", []any{catalogId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host sets"))
+			}
+			var currentMembers []*HostSetMember
+			if err := reader.SearchWhere(ctx, &currentMembers, "catalog_id = ?", []any{catalogId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host set members"))
+			}
+			memberIds := make(map[string][]string, len(currentSets))
+			for _, m := range currentMembers {
+				memberIds[m.SetId] = append(memberIds[m.SetId], m.HostId)
+			}
+			for _, s := range currentSets {
+				var want []string
+				for _, hostName := range members[s.Name] {
+					want = append(want, hostIds[hostName])
+				}
+				var additions, deletions []any
+				for _, hostId := range want {
+					if !slices.Contains(memberIds[s.PublicId], hostId) {
+						m, err := NewHostSetMember(ctx, s.PublicId, hostId)
+						if err != nil {
+							return errors.Wrap(ctx, err, op)
+						}
+						additions = append(additions, m)
+					}
+				}
+				for _, hostId := range memberIds[s.PublicId] {
+					if !slices.Contains(want, hostId) {
+						m, err := NewHostSetMember(ctx, s.PublicId, hostId)
+						if err != nil {
+							return errors.Wrap(ctx, err, op)
+						}
+						deletions = append(deletions, m)
+					}
+				}
+				if len(additions) == 0 && len(deletions) == 0 {
+					continue
+				}
+
+				set := newHostSetForMembers(s.PublicId, s.Version)
+				metadata := set.oplog(oplog.OpType_OP_TYPE_UPDATE)
+				var msgs []*oplog.Message
+				if len(deletions) > 0 {
+					deletedMsgs, err := deleteMembers(ctx, w, deletions)
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					msgs = append(msgs, deletedMsgs...)
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+				}
+				if len(additions) > 0 {
+					createdMsgs, err := createMembers(ctx, w, additions)
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					msgs = append(msgs, createdMsgs...)
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+				}
+				if err := updateVersion(ctx, w, wrapper, metadata, msgs, set, s.Version); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if !changedSets[s.PublicId] {
+					changedSets[s.PublicId] = true
+					result.HostSetsUpdated++
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsCheckConstraintError(err) || errors.IsNotNullError(err) {
+			return nil, errors.New(ctx, errors.InvalidAddress, op, fmt.Sprintf("in catalog: %s", catalogId), errors.WithWrap(err))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s", catalogId)))
+	}
+	return result, nil
+}
+
+func deleteImported(ctx context.Context, w db.Writer, wrapper wrapping.Wrapper, i any, metadata oplog.Metadata) error {
+	const op = "static.deleteImported"
+	rowsDeleted, err := w.Delete(ctx, i, db.WithOplog(wrapper, metadata))
+	switch {
+	case err != nil:
+		return errors.Wrap(ctx, err, op)
+	case rowsDeleted > 1:
+		return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+	}
+	return nil
+}
+
+func updateImported(ctx context.Context, w db.Writer, wrapper wrapping.Wrapper, i any, dbMask, nullFields []string, version uint32, metadata oplog.Metadata) error {
+	const op = "static.updateImported"
+	rowsUpdated, err := w.Update(ctx, i, dbMask, nullFields, db.WithOplog(wrapper, metadata), db.WithVersion(&version))
+	switch {
+	case err != nil:
+		return errors.Wrap(ctx, err, op)
+	case rowsUpdated == 0:
+		return errors.New(ctx, errors.RecordNotFound, op, "no matching version found")
+	case rowsUpdated > 1:
+		return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+	}
+	return nil
+}
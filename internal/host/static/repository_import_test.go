@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ImportHosts_Parameters(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	c := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	host := func(name, address string) *Host {
+		h, err := NewHost(ctx, c.PublicId, WithName(name), WithAddress(address))
+		require.NoError(t, err)
+		return h
+	}
+	set := func(name string) *HostSet {
+		s, err := NewHostSet(ctx, c.PublicId, WithName(name))
+		require.NoError(t, err)
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		projectId string
+		catalogId string
+		hosts     []*Host
+		sets      []*HostSet
+		members   map[string][]string
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "empty-project-id",
+			catalogId: c.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "empty-catalog-id",
+			projectId: prj.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "host-without-name",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			hosts:     []*Host{host("", "10.0.0.1")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "duplicate-host-name",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			hosts:     []*Host{host("web", "10.0.0.1"), host("web", "10.0.0.2")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-address",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			hosts:     []*Host{host("web", "10")},
+			wantIsErr: errors.InvalidAddress,
+		},
+		{
+			name:      "duplicate-set-name",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			sets:      []*HostSet{set("web"), set("web")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "members-of-unknown-set",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			hosts:     []*Host{host("web", "10.0.0.1")},
+			members:   map[string][]string{"web": {"web"}},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "unknown-member",
+			projectId: prj.PublicId,
+			catalogId: c.PublicId,
+			sets:      []*HostSet{set("web")},
+			members:   map[string][]string{"web": {"web-1"}},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(ctx, rw, rw, kms)
+			require.NoError(err)
+			got, err := repo.ImportHosts(ctx, tt.projectId, tt.catalogId, tt.hosts, tt.sets, tt.members)
+			assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+			assert.Nil(got)
+		})
+	}
+}
+
+func TestRepository_ImportHosts(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	c := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	unnamedHost := TestHosts(t, conn, c.PublicId, 1)[0]
+	unnamedSet := TestSets(t, conn, c.PublicId, 1)[0]
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	host := func(name, address, description string) *Host {
+		h, err := NewHost(ctx, c.PublicId, WithName(name), WithAddress(address), WithDescription(description))
+		require.NoError(t, err)
+		return h
+	}
+	set := func(name, description string) *HostSet {
+		s, err := NewHostSet(ctx, c.PublicId, WithName(name), WithDescription(description))
+		require.NoError(t, err)
+		return s
+	}
+	// catalog returns the names of the hosts in the catalog and the names of
+	// the hosts in each set of the catalog.
+	catalog := func(t *testing.T) (map[string]string, map[string][]string) {
+		t.Helper()
+		var hosts []*Host
+		require.NoError(t, rw.SearchWhere(ctx, &hosts, "catalog_id = ?", []any{c.PublicId}))
+		names := make(map[string]string, len(hosts))
+		addresses := make(map[string]string, len(hosts))
+		for _, h := range hosts {
+			names[h.PublicId] = h.Name
+			addresses[h.Name] = h.Address
+		}
+		var sets []*HostSet
+		require.NoError(t, rw.SearchWhere(ctx, &sets, "catalog_id = ?", []any{c.PublicId}))
+		members := make(map[string][]string, len(sets))
+		for _, s := range sets {
+			members[s.Name] = []string{}
+			var m []*HostSetMember
+			require.NoError(t, rw.SearchWhere(ctx, &m, "set_id = ?", []any{s.PublicId}))
+			for _, hm := range m {
+				members[s.Name] = append(members[s.Name], names[hm.HostId])
+			}
+			sort.Strings(members[s.Name])
+		}
+		return addresses, members
+	}
+
+	t.Run("initial-import", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ImportHosts(ctx, prj.PublicId, c.PublicId,
+			[]*Host{host("web-1", "10.0.0.1", ""), host("web-2", "10.0.0.2", ""), host("db-1", "10.0.1.1", "")},
+			[]*HostSet{set("web", ""), set("db", "")},
+			map[string][]string{"web": {"web-1", "web-2"}, "db": {"db-1"}})
+		require.NoError(err)
+		assert.Equal(&ImportResult{
+			HostsCreated:    3,
+			HostsDeleted:    1,
+			HostSetsCreated: 2,
+			HostSetsDeleted: 1,
+		}, got)
+
+		addresses, members := catalog(t)
+		assert.Equal(map[string]string{"web-1": "10.0.0.1", "web-2": "10.0.0.2", "db-1": "10.0.1.1"}, addresses)
+		assert.Equal(map[string][]string{"web": {"web-1", "web-2"}, "db": {"db-1"}}, members)
+
+		h, err := repo.LookupHost(ctx, unnamedHost.PublicId)
+		require.NoError(err)
+		assert.Nil(h)
+		s, _, err := repo.LookupSet(ctx, unnamedSet.PublicId)
+		require.NoError(err)
+		assert.Nil(s)
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ImportHosts(ctx, prj.PublicId, c.PublicId,
+			[]*Host{host("web-1", "10.0.0.1", ""), host("web-2", "10.0.0.2", ""), host("db-1", "10.0.1.1", "")},
+			[]*HostSet{set("web", ""), set("db", "")},
+			map[string][]string{"web": {"web-1", "web-2"}, "db": {"db-1"}})
+		require.NoError(err)
+		assert.Equal(&ImportResult{}, got)
+	})
+	t.Run("sync", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ImportHosts(ctx, prj.PublicId, c.PublicId,
+			[]*Host{host("web-1", "10.0.0.10", "primary"), host("db-1", "10.0.1.1", ""), host("db-2", "10.0.1.2", "")},
+			[]*HostSet{set("web", "web servers"), set("db", ""), set("all", "")},
+			map[string][]string{"web": {"web-1"}, "db": {"db-1", "db-2"}, "all": {"web-1", "db-1", "db-2"}})
+		require.NoError(err)
+		assert.Equal(&ImportResult{
+			HostsCreated:    1,
+			HostsUpdated:    1,
+			HostsDeleted:    1,
+			HostSetsCreated: 1,
+			HostSetsUpdated: 2,
+		}, got)
+
+		addresses, members := catalog(t)
+		assert.Equal(map[string]string{"web-1": "10.0.0.10", "db-1": "10.0.1.1", "db-2": "10.0.1.2"}, addresses)
+		assert.Equal(map[string][]string{"web": {"web-1"}, "db": {"db-1", "db-2"}, "all": {"db-1", "db-2", "web-1"}}, members)
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ImportHosts(ctx, prj.PublicId, c.PublicId, nil, nil, nil)
+		require.NoError(err)
+		assert.Equal(&ImportResult{HostsDeleted: 3, HostSetsDeleted: 3}, got)
+
+		addresses, members := catalog(t)
+		assert.Empty(addresses)
+		assert.Empty(members)
+	})
+}
@@ -5,6 +5,7 @@ package perms
 
 import (
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -29,6 +30,9 @@ type AclGrant struct {
 
 	// The set of output fields granted
 	OutputFields *OutputFields
+
+	// The maximum lifetime the grant allows extending sessions to
+	maxLifetime time.Duration
 }
 
 // Actions returns the actions as a slice from the internal map, along with the
@@ -50,6 +54,10 @@ type ACLResults struct {
 	AuthenticationFinished bool
 	Authorized             bool
 	OutputFields           *OutputFields
+	// MaxLifetime is the largest maximum lifetime, from creation to
+	// expiration, that the grants authorizing the action allow extending
+	// sessions to. It is zero if any of them doesn't limit the lifetime.
+	MaxLifetime time.Duration
 
 	// This is included but unexported for testing/debugging
 	scopeMap map[string][]AclGrant
@@ -119,6 +127,7 @@ func aclGrantFromGrant(grant Grant, id string) AclGrant {
 		typ:          grant.typ,
 		actions:      grant.actions,
 		OutputFields: grant.OutputFields,
+		maxLifetime:  grant.maxLifetime,
 	}
 }
 
@@ -135,6 +144,7 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	if len(split) == 2 {
 		parentAction = action.Map[split[0]]
 	}
+	var unlimitedLifetime bool
 	// Now, go through and check the cases indicated above
 	for _, grant := range grants {
 		var outputFieldsOnly bool
@@ -265,10 +275,17 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 		if found {
 			if !outputFieldsOnly {
 				results.Authorized = true
+				switch {
+				case grant.maxLifetime == 0:
+					unlimitedLifetime = true
+					results.MaxLifetime = 0
+				case !unlimitedLifetime && grant.maxLifetime > results.MaxLifetime:
+					results.MaxLifetime = grant.maxLifetime
+				}
 			}
 			fields, _ := grant.OutputFields.Fields()
 			results.OutputFields = results.OutputFields.AddFields(fields)
-			if results.OutputFields.Has("*") && results.Authorized {
+			if results.OutputFields.Has("*") && results.Authorized && unlimitedLifetime {
 				return
 			}
 		}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -412,6 +413,75 @@ func Test_ACLAllowed(t *testing.T) {
 	}
 }
 
+func TestACL_MaxLifetime(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	res := Resource{ScopeId: "p_a", Id: "s_1", Type: resource.Session}
+
+	tests := []struct {
+		name        string
+		grants      []string
+		action      action.Type
+		authorized  bool
+		maxLifetime time.Duration
+	}{
+		{
+			name:        "limited",
+			grants:      []string{"ids=*;type=session;actions=extend:self;max_lifetime_seconds=3600"},
+			action:      action.ExtendSelf,
+			authorized:  true,
+			maxLifetime: time.Hour,
+		},
+		{
+			name:   "limited other action",
+			grants: []string{"ids=*;type=session;actions=extend:self;max_lifetime_seconds=3600"},
+			action: action.Extend,
+		},
+		{
+			name: "longest limit",
+			grants: []string{
+				"ids=*;type=session;actions=extend;max_lifetime_seconds=3600",
+				"ids=*;type=session;actions=extend:self;max_lifetime_seconds=7200",
+			},
+			action:      action.ExtendSelf,
+			authorized:  true,
+			maxLifetime: 2 * time.Hour,
+		},
+		{
+			name: "longest limit after all output fields",
+			grants: []string{
+				"ids=*;type=session;actions=extend;output_fields=*;max_lifetime_seconds=3600",
+				"ids=s_1;actions=extend;max_lifetime_seconds=7200",
+			},
+			action:      action.Extend,
+			authorized:  true,
+			maxLifetime: 2 * time.Hour,
+		},
+		{
+			name: "unlimited grant",
+			grants: []string{
+				"ids=*;type=session;actions=extend;max_lifetime_seconds=3600",
+				"ids=*;type=session;actions=*",
+			},
+			action:     action.Extend,
+			authorized: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var grants []Grant
+			for _, g := range tc.grants {
+				grant, err := Parse(ctx, res.ScopeId, g)
+				require.NoError(t, err)
+				grants = append(grants, grant)
+			}
+			result := NewACL(grants...).Allowed(res, tc.action, "u_1234567890")
+			assert.Equal(t, tc.authorized, result.Authorized)
+			assert.Equal(t, tc.maxLifetime, result.MaxLifetime)
+		})
+	}
+}
+
 func TestACL_ListPermissions(t *testing.T) {
 	t.Parallel()
 
@@ -932,7 +1002,7 @@ func Test_AnonRestrictions(t *testing.T) {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.RemoveGrantScopes; j++ {
+				for j := action.Type(1); j <= action.Drain; j++ {
 					id := "foobar"
 					prefixes := globals.ResourcePrefixesFromType(resource.Type(i))
 					if len(prefixes) > 0 {
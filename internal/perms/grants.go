@@ -8,7 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/boundary/globals"
@@ -75,6 +77,9 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields *OutputFields
 
+	// The maximum lifetime the grant allows extending sessions to, if provided
+	maxLifetime time.Duration
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -101,6 +106,12 @@ func (g Grant) Actions() ([]action.Type, []string) {
 	return g.actions.Actions()
 }
 
+// MaxLifetime returns the maximum lifetime, from creation to expiration, the
+// grant allows extending sessions to, or zero if the grant doesn't limit it.
+func (g Grant) MaxLifetime() time.Duration {
+	return g.maxLifetime
+}
+
 // hasActionOrSubaction checks whether a grant's action set contains the given
 // action or contains an action that is a subaction of the passed-in parameter.
 // This is used for validation checking of parsed grants. N.B.: this is the
@@ -117,10 +128,11 @@ func (g Grant) hasActionOrSubaction(act action.Type) bool {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		ids:   g.ids,
-		typ:   g.typ,
+		scope:       g.scope,
+		id:          g.id,
+		ids:         g.ids,
+		typ:         g.typ,
+		maxLifetime: g.maxLifetime,
 	}
 	if g.ids != nil {
 		ret.ids = make([]string, len(g.ids))
@@ -174,6 +186,10 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(outFields, ",")))
 	}
 
+	if g.maxLifetime > 0 {
+		builder = append(builder, fmt.Sprintf("max_lifetime_seconds=%d", int64(g.maxLifetime/time.Second)))
+	}
+
 	return strings.Join(builder, ";")
 }
 
@@ -201,6 +217,9 @@ func (g Grant) MarshalJSON(ctx context.Context) ([]byte, error) {
 	if outFields, hasSetFields := g.OutputFields.Fields(); hasSetFields {
 		res["output_fields"] = outFields
 	}
+	if g.maxLifetime > 0 {
+		res["max_lifetime_seconds"] = int64(g.maxLifetime / time.Second)
+	}
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
@@ -307,6 +326,16 @@ func (g *Grant) unmarshalJSON(ctx context.Context, data []byte) error {
 			g.OutputFields = g.OutputFields.AddFields(fields)
 		}
 	}
+	if rawMaxLifetime, ok := raw["max_lifetime_seconds"]; ok {
+		seconds, ok := rawMaxLifetime.(float64)
+		if !ok || seconds != float64(int64(seconds)) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as integer", "max_lifetime_seconds"))
+		}
+		if seconds <= 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "max_lifetime_seconds must be greater than zero")
+		}
+		g.maxLifetime = time.Duration(seconds) * time.Second
+	}
 	return nil
 }
 
@@ -367,6 +396,16 @@ func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 			default:
 				g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
 			}
+
+		case "max_lifetime_seconds":
+			seconds, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as integer", "max_lifetime_seconds"))
+			}
+			if seconds <= 0 {
+				return errors.New(ctx, errors.InvalidParameter, op, "max_lifetime_seconds must be greater than zero")
+			}
+			g.maxLifetime = time.Duration(seconds) * time.Second
 		}
 	}
 
@@ -500,6 +539,10 @@ func Parse(ctx context.Context, scopeId, grantString string, opt ...Option) (Gra
 			if err := grant.parseAndValidateActions(ctx); err != nil {
 				return Grant{}, errors.Wrap(ctx, err, op)
 			}
+			// The maximum lifetime only limits extending sessions.
+			if grant.maxLifetime > 0 && !grant.actions[action.All] && !grant.hasActionOrSubaction(action.Extend) {
+				return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("parsed grant string %q contains max_lifetime_seconds without an extend action", grant.CanonicalString()))
+			}
 		}
 
 		if !opts.withSkipFinalValidation {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -274,6 +275,23 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"ids":["baz","bop"],"output_fields":["ids","name","version"],"type":"group"}`,
 			canonicalString: `ids=baz,bop;type=group;actions=create,read;output_fields=ids,name,version`,
 		},
+		{
+			name: "max lifetime",
+			input: Grant{
+				ids: []string{"*"},
+				scope: Scope{
+					Type: scope.Project,
+				},
+				typ: resource.Session,
+				actions: map[action.Type]bool{
+					action.ExtendSelf: true,
+				},
+				actionsBeingParsed: []string{"extend:self"},
+				maxLifetime:        8 * time.Hour,
+			},
+			jsonOutput:      `{"actions":["extend:self"],"ids":["*"],"max_lifetime_seconds":28800,"type":"session"}`,
+			canonicalString: `ids=*;type=session;actions=extend:self;max_lifetime_seconds=28800`,
+		},
 	}
 
 	for _, test := range tests {
@@ -1150,6 +1168,59 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "max lifetime",
+			input:         "ids=*;type=session;actions=read:self,extend:self;max_lifetime_seconds=28800",
+			scopeOverride: "p_scope",
+			expected: Grant{
+				scope: Scope{
+					Id:   "p_scope",
+					Type: scope.Project,
+				},
+				ids: []string{"*"},
+				typ: resource.Session,
+				actions: map[action.Type]bool{
+					action.ReadSelf:   true,
+					action.ExtendSelf: true,
+				},
+				maxLifetime: 8 * time.Hour,
+			},
+		},
+		{
+			name:          "max lifetime json",
+			input:         `{"ids":["*"],"type":"session","actions":["extend"],"max_lifetime_seconds":3600}`,
+			scopeOverride: "p_scope",
+			expected: Grant{
+				scope: Scope{
+					Id:   "p_scope",
+					Type: scope.Project,
+				},
+				ids: []string{"*"},
+				typ: resource.Session,
+				actions: map[action.Type]bool{
+					action.Extend: true,
+				},
+				maxLifetime: time.Hour,
+			},
+		},
+		{
+			name:          "max lifetime without extend",
+			input:         "ids=*;type=session;actions=read;max_lifetime_seconds=28800",
+			scopeOverride: "p_scope",
+			err:           `perms.Parse: parsed grant string "ids=*;type=session;actions=read;max_lifetime_seconds=28800" contains max_lifetime_seconds without an extend action: parameter violation: error #100`,
+		},
+		{
+			name:          "max lifetime not positive",
+			input:         "ids=*;type=session;actions=extend;max_lifetime_seconds=0",
+			scopeOverride: "p_scope",
+			err:           `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: max_lifetime_seconds must be greater than zero: parameter violation: error #100`,
+		},
+		{
+			name:          "max lifetime not an integer",
+			input:         `{"ids":["*"],"type":"session","actions":["extend"],"max_lifetime_seconds":1.5}`,
+			scopeOverride: "p_scope",
+			err:           `perms.Parse: unable to parse JSON grant string: perms.(Grant).unmarshalJSON: unable to interpret "max_lifetime_seconds" as integer: parameter violation: error #100`,
+		},
 		{
 			name:      "bad old account id template",
 			input:     `id={{superman}};actions=read`,
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package servers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/host"
+	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/server"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+)
+
+const (
+	hostHealthCheckJobName = "host_health_check_registration"
+
+	defaultHostHealthCheckRegistrationInterval = time.Minute
+)
+
+// hostHealthCheckJob defines a periodic job that registers a host health
+// check for each endpoint of the targets which have host health checks
+// enabled and removes the checks of endpoints which no longer belong to such
+// a target. The targets handler registers the checks of a target when the
+// target changes; this job picks up changes to the hosts of the host sources
+// of a target, such as hosts added to a static host set or the results of a
+// plugin host set sync.
+type hostHealthCheckJob struct {
+	serverRepo *server.Repository
+	staticRepo *static.Repository
+	pluginRepo *pluginhost.Repository
+
+	interval     time.Duration
+	numTargets   int
+	numProcessed int
+}
+
+// newHostHealthCheckJob instantiates the host health check registration job.
+func newHostHealthCheckJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sche *scheduler.Scheduler, opt ...Option) (*hostHealthCheckJob, error) {
+	const op = "server.newHostHealthCheckJob"
+	switch {
+	case isNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case isNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	case sche == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scheduler")
+	}
+	opts := getOpts(opt...)
+	plgm := opts.withHostPlugins
+	if plgm == nil {
+		plgm = map[string]plgpb.HostPluginServiceClient{}
+	}
+
+	serverRepo, err := server.NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	staticRepo, err := static.NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	pluginRepo, err := pluginhost.NewRepository(ctx, r, w, kms, sche, plgm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &hostHealthCheckJob{
+		serverRepo: serverRepo,
+		staticRepo: staticRepo,
+		pluginRepo: pluginRepo,
+		interval:   opts.withHostHealthCheckRegistrationInterval,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *hostHealthCheckJob) Name() string { return hostHealthCheckJobName }
+
+// Description returns the description for the job.
+func (j *hostHealthCheckJob) Description() string {
+	return "Register host health checks for the endpoints of targets with host health checks enabled"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+func (j *hostHealthCheckJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return j.interval, nil
+}
+
+// Status returns the status of the running job.
+func (j *hostHealthCheckJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numTargets,
+	}
+}
+
+// Run registers the host health checks of each target with host health
+// checks enabled and deletes the checks of all other targets. A target whose
+// endpoints cannot be retrieved keeps its current checks.
+func (j *hostHealthCheckJob) Run(ctx context.Context) error {
+	const op = "server.(hostHealthCheckJob).Run"
+	j.numTargets, j.numProcessed = 0, 0
+
+	if _, err := j.serverRepo.DeleteUnregisteredHostHealthChecks(ctx); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	targets, err := j.serverRepo.ListHostHealthCheckTargets(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.numTargets = len(targets)
+	for _, t := range targets {
+		endpoints, err := j.endpoints(ctx, t.HostSetIds)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error getting target endpoints", "target_id", t.TargetId))
+			continue
+		}
+		if _, err := j.serverRepo.UpsertTargetHostHealthChecks(ctx, t.TargetId, t.Port, endpoints); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error registering host health checks", "target_id", t.TargetId))
+			continue
+		}
+		j.numProcessed++
+	}
+	return nil
+}
+
+func (j *hostHealthCheckJob) endpoints(ctx context.Context, setIds []string) ([]*host.Endpoint, error) {
+	const op = "server.(hostHealthCheckJob).endpoints"
+	var endpoints []*host.Endpoint
+	var pluginSetIds []string
+	for _, id := range setIds {
+		switch globals.ResourceInfoFromPrefix(id).Subtype {
+		case static.Subtype:
+			eps, err := j.staticRepo.Endpoints(ctx, id)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			endpoints = append(endpoints, eps...)
+		default:
+			pluginSetIds = append(pluginSetIds, id)
+		}
+	}
+	if len(pluginSetIds) > 0 {
+		eps, err := j.pluginRepo.Endpoints(ctx, pluginSetIds)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		endpoints = append(endpoints, eps...)
+	}
+	return endpoints, nil
+}
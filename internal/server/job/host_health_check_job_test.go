@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package servers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHostHealthCheckJob(t *testing.T) {
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	tests := []struct {
+		name  string
+		r     db.Reader
+		w     db.Writer
+		kms   *kms.Kms
+		sched *scheduler.Scheduler
+	}{
+		{name: "nil reader", w: rw, kms: kmsCache, sched: sched},
+		{name: "nil writer", r: rw, kms: kmsCache, sched: sched},
+		{name: "nil kms", r: rw, w: rw, sched: sched},
+		{name: "nil scheduler", r: rw, w: rw, kms: kmsCache},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newHostHealthCheckJob(ctx, tt.r, tt.w, tt.kms, tt.sched)
+			assert.Nil(t, got)
+			assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		})
+	}
+
+	got, err := newHostHealthCheckJob(ctx, rw, rw, kmsCache, sched)
+	require.NoError(t, err)
+	assert.Equal(t, hostHealthCheckJobName, got.Name())
+	next, err := got.NextRunIn(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, defaultHostHealthCheckRegistrationInterval, next)
+}
+
+func TestHostHealthCheckJob_Run(t *testing.T) {
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	serverRepo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	sets := static.TestSets(t, conn, cats[0].PublicId, 1)
+	hosts := static.TestHosts(t, conn, cats[0].PublicId, 2)
+	static.TestSetMembers(t, conn, sets[0].PublicId, hosts)
+
+	enabled := tcp.TestTarget(ctx, t, conn, proj.PublicId, "enabled",
+		target.WithDefaultPort(22),
+		target.WithEnableHostHealthChecks(true),
+		target.WithHostSources([]string{sets[0].PublicId}))
+	disabled := tcp.TestTarget(ctx, t, conn, proj.PublicId, "disabled",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{sets[0].PublicId}))
+	_, err = serverRepo.UpsertTargetHostHealthChecks(ctx, disabled.GetPublicId(), 22,
+		[]*host.Endpoint{{HostId: hosts[0].PublicId, Address: hosts[0].Address}})
+	require.NoError(t, err)
+
+	job, err := newHostHealthCheckJob(ctx, rw, rw, kmsCache, sched)
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 1, job.Status().Total)
+	assert.Equal(t, 1, job.Status().Completed)
+
+	checks, err := serverRepo.ListTargetHostHealthChecks(ctx, enabled.GetPublicId())
+	require.NoError(t, err)
+	assert.Len(t, checks, len(hosts))
+
+	checks, err = serverRepo.ListTargetHostHealthChecks(ctx, disabled.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+}
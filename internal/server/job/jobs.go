@@ -13,7 +13,8 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 )
 
-// RegisterJobs registers the rotate roots job with the provided scheduler.
+// RegisterJobs registers the rotate roots job and the host health check
+// registration job with the provided scheduler.
 func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) error {
 	const op = "server.(Jobs).RegisterJobs"
 
@@ -38,6 +39,14 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 		return errors.Wrap(ctx, err, op)
 	}
 
+	hostHealthCheckJob, err := newHostHealthCheckJob(ctx, r, w, kms, scheduler, opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, hostHealthCheckJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
 	return nil
 }
 
@@ -5,6 +5,8 @@ package servers
 
 import (
 	"time"
+
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 )
 
 const defaultRotationFrequency = time.Hour
@@ -23,13 +25,16 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withRotationFrequency   time.Duration
-	withCertificateLifetime time.Duration
+	withRotationFrequency                   time.Duration
+	withCertificateLifetime                 time.Duration
+	withHostPlugins                         map[string]plgpb.HostPluginServiceClient
+	withHostHealthCheckRegistrationInterval time.Duration
 }
 
 func getDefaultOptions() options {
 	return options{
-		withRotationFrequency: defaultRotationFrequency,
+		withRotationFrequency:                   defaultRotationFrequency,
+		withHostHealthCheckRegistrationInterval: defaultHostHealthCheckRegistrationInterval,
 	}
 }
 
@@ -49,3 +54,22 @@ func WithCertificateLifetime(with time.Duration) Option {
 		o.withCertificateLifetime = with
 	}
 }
+
+// WithHostPlugins provides the host plugin clients used by the host health
+// check registration job to look up the endpoints of plugin host sets.
+func WithHostPlugins(with map[string]plgpb.HostPluginServiceClient) Option {
+	return func(o *options) {
+		o.withHostPlugins = with
+	}
+}
+
+// WithHostHealthCheckRegistrationInterval provides the interval of the host
+// health check registration job. If the passed-in interval is zero, the
+// default is used instead.
+func WithHostHealthCheckRegistrationInterval(with time.Duration) Option {
+	return func(o *options) {
+		if with > 0 {
+			o.withHostHealthCheckRegistrationInterval = with
+		}
+	}
+}
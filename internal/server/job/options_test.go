@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,4 +25,19 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithCertificateLifetime(time.Minute))
 		assert.Equal(t, time.Minute, opts.withCertificateLifetime)
 	})
+	t.Run("WithHostPlugins", func(t *testing.T) {
+		testOpts := getDefaultOptions()
+		assert.Nil(t, testOpts.withHostPlugins)
+		plgs := map[string]plgpb.HostPluginServiceClient{"plg_1234567890": nil}
+		opts := getOpts(WithHostPlugins(plgs))
+		assert.Equal(t, plgs, opts.withHostPlugins)
+	})
+	t.Run("WithHostHealthCheckRegistrationInterval", func(t *testing.T) {
+		testOpts := getDefaultOptions()
+		assert.Equal(t, defaultHostHealthCheckRegistrationInterval, testOpts.withHostHealthCheckRegistrationInterval)
+		opts := getOpts(WithHostHealthCheckRegistrationInterval(time.Second))
+		assert.Equal(t, time.Second, opts.withHostHealthCheckRegistrationInterval)
+		opts = getOpts(WithHostHealthCheckRegistrationInterval(0))
+		assert.Equal(t, defaultHostHealthCheckRegistrationInterval, opts.withHostHealthCheckRegistrationInterval)
+	})
 }
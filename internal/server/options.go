@@ -43,6 +43,7 @@ type options struct {
 	withTestPkiWorkerAuthorized            bool
 	withTestPkiWorkerKeyId                 *string
 	withTestUseInputTagsAsApiTags          bool
+	withTestActiveConnectionCount          uint32
 	withWorkerType                         WorkerType
 	withRoot                               RootInfo
 	withStopAfter                          uint
@@ -53,6 +54,7 @@ type options struct {
 	withFeature                            version.Feature
 	withDirectlyConnected                  bool
 	withWorkerPool                         []string
+	withStatusRttMs                        uint32
 }
 
 func getDefaultOptions() options {
@@ -197,6 +199,15 @@ func WithTestUseInputTagsAsApiTags(with bool) Option {
 	}
 }
 
+// WithTestActiveConnectionCount tells NewWorker to set the active connection
+// count of the worker. This is useful for testing code which selects workers
+// without having to store sessions in the database.
+func WithTestActiveConnectionCount(count uint32) Option {
+	return func(o *options) {
+		o.withTestActiveConnectionCount = count
+	}
+}
+
 // WithWorkerType allows specifying a particular type of worker (kms, pki)
 // during lookup or listing
 func WithWorkerType(with WorkerType) Option {
@@ -267,3 +278,11 @@ func WithWorkerPool(workerIds []string) Option {
 		o.withWorkerPool = workerIds
 	}
 }
+
+// WithStatusRttMs provides an optional round trip time, in milliseconds, of
+// the previous status update sent by a worker.
+func WithStatusRttMs(rtt uint32) Option {
+	return func(o *options) {
+		o.withStatusRttMs = rtt
+	}
+}
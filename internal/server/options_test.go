@@ -169,6 +169,13 @@ func Test_GetOpts(t *testing.T) {
 		opts := GetOpts(WithTestUseInputTagsAsApiTags(true))
 		assert.True(opts.withTestUseInputTagsAsApiTags)
 	})
+	t.Run("WithTestActiveConnectionCount", func(t *testing.T) {
+		assert := assert.New(t)
+		testOpts := getDefaultOptions()
+		assert.Zero(testOpts.withTestActiveConnectionCount)
+		opts := GetOpts(WithTestActiveConnectionCount(3))
+		assert.Equal(uint32(3), opts.withTestActiveConnectionCount)
+	})
 	t.Run("WithWorkerType", func(t *testing.T) {
 		opts := getDefaultOptions()
 		assert.Empty(t, opts.withWorkerType)
@@ -243,4 +250,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withNewIdFunc = nil
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithStatusRttMs", func(t *testing.T) {
+		opts := GetOpts(WithStatusRttMs(25))
+		testOpts := getDefaultOptions()
+		testOpts.withStatusRttMs = 25
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -14,6 +14,19 @@ const (
 	and
 		worker_id = ?`
 
+	requestWorkerDrainQuery = `
+		update server_worker
+		   set drain_requested = true
+		 where public_id = @public_id;
+	`
+
+	clearWorkerDrainRequestQuery = `
+		update server_worker
+		   set drain_requested = false
+		 where public_id = @public_id
+		   and drain_requested;
+	`
+
 	deleteWorkerAuthQuery = `
 		delete from worker_auth_authorized
  		where worker_key_identifier = @worker_key_identifier;
@@ -64,4 +77,113 @@ const (
 		where worker.scope_id = ?
 			and auth_token.key_id = ?
 	`
+
+	listTargetHostHealthChecksQuery = `
+		select target_id,
+			host_id,
+			address,
+			port,
+			status,
+			last_check_time,
+			coalesce(last_check_error, '') as last_check_error,
+			coalesce(last_check_worker_id, '') as last_check_worker_id
+		from host_health_check
+		where target_id = @target_id
+	`
+
+	listHostHealthCheckTargetsQuery = `
+		select t.public_id as target_id,
+			t.default_port as port,
+			ths.host_set_id
+		from target_all_subtypes t
+			inner join target_host_set ths
+				on ths.target_id = t.public_id
+		where t.enable_host_health_checks
+			and t.default_port > 0
+			and not exists (
+				select 1
+				from target_address ta
+				where ta.target_id = t.public_id
+			)
+		order by t.public_id, ths.host_set_id
+	`
+
+	deleteTargetHostHealthChecksQuery = `
+		delete from host_health_check
+		where target_id = @target_id
+	`
+
+	deleteUnregisteredHostHealthChecksQuery = `
+		delete from host_health_check hhc
+		where not exists (
+			select 1
+			from target_all_subtypes t
+				inner join target_host_set ths
+					on ths.target_id = t.public_id
+			where t.public_id = hhc.target_id
+				and t.enable_host_health_checks
+				and t.default_port > 0
+				and not exists (
+					select 1
+					from target_address ta
+					where ta.target_id = t.public_id
+				)
+		)
+	`
+
+	insertHostHealthCheckQuery = `
+		insert into host_health_check
+			(target_id, host_id, address, port)
+		values
+			(@target_id, @host_id, @address, @port)
+		on conflict do nothing
+	`
+
+	deleteHostHealthCheckQuery = `
+		delete from host_health_check
+		where target_id = @target_id
+			and host_id = @host_id
+			and address = @address
+			and port = @port
+	`
+
+	listHostHealthCheckEndpointsQuery = `
+		select distinct
+			hhc.host_id,
+			hhc.address,
+			hhc.port,
+			coalesce(nullif(t.egress_worker_filter, ''), nullif(t.worker_filter, ''), '') as worker_filter
+		from host_health_check hhc
+			inner join target_all_subtypes t
+				on t.public_id = hhc.target_id
+		where t.enable_host_health_checks
+	`
+
+	updateHostHealthCheckQuery = `
+		update host_health_check
+		set status = @status,
+			last_check_time = @check_time,
+			last_check_error = nullif(@error, ''),
+			last_check_worker_id = @worker_id
+		where host_id = @host_id
+			and address = @address
+			and port = @port
+			and (last_check_time is null or last_check_time <= @check_time)
+	`
+
+	lookupHostHealthCheckQuery = `
+		select target_id,
+			host_id,
+			address,
+			port,
+			status,
+			last_check_time,
+			coalesce(last_check_error, '') as last_check_error,
+			coalesce(last_check_worker_id, '') as last_check_worker_id
+		from host_health_check
+		where host_id = @host_id
+			and last_check_time is not null
+		order by last_check_time desc
+		limit 1
+	`
 )
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+)
+
+// HostHealthStatus defines the possible health statuses of a host endpoint
+type HostHealthStatus string
+
+const (
+	UnknownHostHealth   HostHealthStatus = "unknown"
+	HealthyHostHealth   HostHealthStatus = "healthy"
+	UnhealthyHostHealth HostHealthStatus = "unhealthy"
+)
+
+// HostHealthCheckStaleDuration is how long the result of a host health check
+// is considered current. The status of a host endpoint which has not been
+// checked within this duration is unknown.
+const HostHealthCheckStaleDuration = 5 * time.Minute
+
+// HostHealthCheck is a host endpoint of a target which has host health checks
+// enabled along with the result of the most recent health check of the
+// endpoint.
+type HostHealthCheck struct {
+	TargetId          string
+	HostId            string
+	Address           string
+	Port              uint32
+	Status            string
+	LastCheckTime     *timestamp.Timestamp
+	LastCheckError    string
+	LastCheckWorkerId string
+}
+
+// CurrentStatus returns the status of the endpoint, or UnknownHostHealth if
+// the endpoint has not been checked within HostHealthCheckStaleDuration.
+func (c *HostHealthCheck) CurrentStatus() HostHealthStatus {
+	if c.LastCheckTime == nil || time.Since(c.LastCheckTime.AsTime()) > HostHealthCheckStaleDuration {
+		return UnknownHostHealth
+	}
+	return HostHealthStatus(c.Status)
+}
+
+// HostHealthCheckEndpoint is a host endpoint which should be checked by the
+// workers matching WorkerFilter. An empty WorkerFilter matches all workers.
+type HostHealthCheckEndpoint struct {
+	HostId       string
+	Address      string
+	Port         uint32
+	WorkerFilter string
+}
+
+// HostHealthCheckResult is the result of a worker checking a host endpoint.
+type HostHealthCheckResult struct {
+	HostId    string
+	Address   string
+	Port      uint32
+	Healthy   bool
+	Error     string
+	CheckTime time.Time
+}
+
+// HostHealthCheckTarget is a target which has host health checks enabled,
+// a default port, and host sources.
+type HostHealthCheckTarget struct {
+	TargetId   string
+	Port       uint32
+	HostSetIds []string
+}
+
+// ListHostHealthCheckTargets returns the targets which have host health
+// checks enabled along with the ids of their host sets. Targets without a
+// default port, without host sources, or with an address are not included
+// since none of their endpoints can be checked.
+func (r *Repository) ListHostHealthCheckTargets(ctx context.Context) ([]*HostHealthCheckTarget, error) {
+	const op = "server.(Repository).ListHostHealthCheckTargets"
+	rows, err := r.reader.Query(ctx, listHostHealthCheckTargetsQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var targets []*HostHealthCheckTarget
+	for rows.Next() {
+		var row struct {
+			TargetId  string
+			Port      uint32
+			HostSetId string
+		}
+		if err := r.reader.ScanRows(ctx, rows, &row); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		// Rows are ordered by target id.
+		if n := len(targets); n > 0 && targets[n-1].TargetId == row.TargetId {
+			targets[n-1].HostSetIds = append(targets[n-1].HostSetIds, row.HostSetId)
+			continue
+		}
+		targets = append(targets, &HostHealthCheckTarget{
+			TargetId:   row.TargetId,
+			Port:       row.Port,
+			HostSetIds: []string{row.HostSetId},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return targets, nil
+}
+
+// ListTargetHostHealthChecks returns the host health checks of the target
+// with targetId.
+func (r *Repository) ListTargetHostHealthChecks(ctx context.Context, targetId string) ([]*HostHealthCheck, error) {
+	const op = "server.(Repository).ListTargetHostHealthChecks"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	checks, err := r.queryHostHealthChecks(ctx, listTargetHostHealthChecksQuery, []any{sql.Named("target_id", targetId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return checks, nil
+}
+
+// DeleteTargetHostHealthChecks deletes the host health checks of the target
+// with targetId. It returns the number of checks deleted.
+func (r *Repository) DeleteTargetHostHealthChecks(ctx context.Context, targetId string) (int, error) {
+	const op = "server.(Repository).DeleteTargetHostHealthChecks"
+	if targetId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	n, err := r.writer.Exec(ctx, deleteTargetHostHealthChecksQuery, []any{sql.Named("target_id", targetId)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return n, nil
+}
+
+// DeleteUnregisteredHostHealthChecks deletes the host health checks of all
+// targets which are not returned by ListHostHealthCheckTargets. It returns
+// the number of checks deleted.
+func (r *Repository) DeleteUnregisteredHostHealthChecks(ctx context.Context) (int, error) {
+	const op = "server.(Repository).DeleteUnregisteredHostHealthChecks"
+	n, err := r.writer.Exec(ctx, deleteUnregisteredHostHealthChecksQuery, nil)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return n, nil
+}
+
+// UpsertTargetHostHealthChecks ensures the target with targetId has a host
+// health check for each of endpoints on port and removes the checks of the
+// target for any other endpoints. It returns the current checks of the
+// target. Checks which are added have an unknown status.
+func (r *Repository) UpsertTargetHostHealthChecks(ctx context.Context, targetId string, port uint32, endpoints []*host.Endpoint) ([]*HostHealthCheck, error) {
+	const op = "server.(Repository).UpsertTargetHostHealthChecks"
+	switch {
+	case targetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	case port == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing port")
+	}
+
+	type key struct {
+		hostId, address string
+		port            uint32
+	}
+	existing, err := r.queryHostHealthChecks(ctx, listTargetHostHealthChecksQuery, []any{sql.Named("target_id", targetId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	existingByKey := make(map[key]*HostHealthCheck, len(existing))
+	for _, c := range existing {
+		existingByKey[key{c.HostId, c.Address, c.Port}] = c
+	}
+
+	var current, added []*HostHealthCheck
+	wanted := make(map[key]bool, len(endpoints))
+	for _, ep := range endpoints {
+		k := key{ep.HostId, ep.Address, port}
+		if wanted[k] {
+			continue
+		}
+		wanted[k] = true
+		if c, ok := existingByKey[k]; ok {
+			current = append(current, c)
+			continue
+		}
+		c := &HostHealthCheck{
+			TargetId: targetId,
+			HostId:   ep.HostId,
+			Address:  ep.Address,
+			Port:     port,
+			Status:   string(UnknownHostHealth),
+		}
+		current = append(current, c)
+		added = append(added, c)
+	}
+	var removed []*HostHealthCheck
+	for k, c := range existingByKey {
+		if !wanted[k] {
+			removed = append(removed, c)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return current, nil
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		for _, c := range removed {
+			if _, err := w.Exec(ctx, deleteHostHealthCheckQuery, hostHealthCheckArgs(c)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete host health check"))
+			}
+		}
+		for _, c := range added {
+			if _, err := w.Exec(ctx, insertHostHealthCheckQuery, hostHealthCheckArgs(c)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert host health check"))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// ListHostHealthCheckEndpoints returns the endpoints of all targets which
+// have host health checks enabled.
+func (r *Repository) ListHostHealthCheckEndpoints(ctx context.Context) ([]*HostHealthCheckEndpoint, error) {
+	const op = "server.(Repository).ListHostHealthCheckEndpoints"
+	rows, err := r.reader.Query(ctx, listHostHealthCheckEndpointsQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var endpoints []*HostHealthCheckEndpoint
+	for rows.Next() {
+		var ep HostHealthCheckEndpoint
+		if err := r.reader.ScanRows(ctx, rows, &ep); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		endpoints = append(endpoints, &ep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return endpoints, nil
+}
+
+// UpdateHostHealthCheckResults records the results of the host health checks
+// performed by the worker with workerId. A result is ignored if a more recent
+// result for the same endpoint has already been recorded.
+func (r *Repository) UpdateHostHealthCheckResults(ctx context.Context, workerId string, results []*HostHealthCheckResult) error {
+	const op = "server.(Repository).UpdateHostHealthCheckResults"
+	if workerId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		for _, res := range results {
+			status := UnhealthyHostHealth
+			if res.Healthy {
+				status = HealthyHostHealth
+			}
+			_, err := w.Exec(ctx, updateHostHealthCheckQuery, []any{
+				sql.Named("status", string(status)),
+				sql.Named("check_time", res.CheckTime),
+				sql.Named("error", res.Error),
+				sql.Named("worker_id", workerId),
+				sql.Named("host_id", res.HostId),
+				sql.Named("address", res.Address),
+				sql.Named("port", res.Port),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update host health check"))
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// LookupHostHealthCheck returns the most recent health check of the host with
+// hostId across all of its endpoints. It returns nil if the host has not been
+// checked.
+func (r *Repository) LookupHostHealthCheck(ctx context.Context, hostId string) (*HostHealthCheck, error) {
+	const op = "server.(Repository).LookupHostHealthCheck"
+	if hostId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host id")
+	}
+	checks, err := r.queryHostHealthChecks(ctx, lookupHostHealthCheckQuery, []any{sql.Named("host_id", hostId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(checks) == 0 {
+		return nil, nil
+	}
+	return checks[0], nil
+}
+
+func (r *Repository) queryHostHealthChecks(ctx context.Context, query string, args []any) ([]*HostHealthCheck, error) {
+	const op = "server.(Repository).queryHostHealthChecks"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var checks []*HostHealthCheck
+	for rows.Next() {
+		var c HostHealthCheck
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		checks = append(checks, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return checks, nil
+}
+
+func hostHealthCheckArgs(c *HostHealthCheck) []any {
+	return []any{
+		sql.Named("target_id", c.TargetId),
+		sql.Named("host_id", c.HostId),
+		sql.Named("address", c.Address),
+		sql.Named("port", c.Port),
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_HostHealthChecks(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	ctx := context.Background()
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hosts := static.TestHosts(t, conn, cats[0].PublicId, 2)
+	tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "health checked",
+		target.WithDefaultPort(22),
+		target.WithEnableHostHealthChecks(true),
+		target.WithEgressWorkerFilter(`"east" in "/tags/region"`))
+	worker := server.TestKmsWorker(t, conn, wrapper)
+
+	endpoints := []*host.Endpoint{
+		{HostId: hosts[0].PublicId, Address: hosts[0].Address},
+		{HostId: hosts[1].PublicId, Address: hosts[1].Address},
+	}
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		_, err := repo.UpsertTargetHostHealthChecks(ctx, "", 22, endpoints)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.UpsertTargetHostHealthChecks(ctx, tar.GetPublicId(), 0, endpoints)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.UpdateHostHealthCheckResults(ctx, "", nil)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.LookupHostHealthCheck(ctx, "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	checks, err := repo.UpsertTargetHostHealthChecks(ctx, tar.GetPublicId(), 22, endpoints)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	for _, c := range checks {
+		assert.Equal(t, server.UnknownHostHealth, c.CurrentStatus())
+	}
+
+	got, err := repo.ListHostHealthCheckEndpoints(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for _, ep := range got {
+		assert.Equal(t, uint32(22), ep.Port)
+		assert.Equal(t, `"east" in "/tags/region"`, ep.WorkerFilter)
+	}
+
+	c, err := repo.LookupHostHealthCheck(ctx, hosts[0].PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	now := time.Now()
+	require.NoError(t, repo.UpdateHostHealthCheckResults(ctx, worker.GetPublicId(), []*server.HostHealthCheckResult{
+		{HostId: hosts[0].PublicId, Address: hosts[0].Address, Port: 22, Healthy: true, CheckTime: now},
+		{HostId: hosts[1].PublicId, Address: hosts[1].Address, Port: 22, Error: "connection refused", CheckTime: now},
+	}))
+	// An older result does not replace a newer one.
+	require.NoError(t, repo.UpdateHostHealthCheckResults(ctx, worker.GetPublicId(), []*server.HostHealthCheckResult{
+		{HostId: hosts[0].PublicId, Address: hosts[0].Address, Port: 22, Error: "timed out", CheckTime: now.Add(-time.Minute)},
+	}))
+
+	c, err = repo.LookupHostHealthCheck(ctx, hosts[0].PublicId)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, server.HealthyHostHealth, c.CurrentStatus())
+	assert.Empty(t, c.LastCheckError)
+	assert.Equal(t, worker.GetPublicId(), c.LastCheckWorkerId)
+
+	c, err = repo.LookupHostHealthCheck(ctx, hosts[1].PublicId)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, server.UnhealthyHostHealth, c.CurrentStatus())
+	assert.Equal(t, "connection refused", c.LastCheckError)
+
+	// Upserting keeps the results of existing checks and removes the checks
+	// of endpoints which are no longer part of the target.
+	checks, err = repo.UpsertTargetHostHealthChecks(ctx, tar.GetPublicId(), 22, endpoints[:1])
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, server.HealthyHostHealth, checks[0].CurrentStatus())
+
+	c, err = repo.LookupHostHealthCheck(ctx, hosts[1].PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestRepository_HostHealthCheckTargets(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	ctx := context.Background()
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	sets := static.TestSets(t, conn, cats[0].PublicId, 2)
+	hosts := static.TestHosts(t, conn, cats[0].PublicId, 1)
+	static.TestSetMembers(t, conn, sets[0].PublicId, hosts)
+
+	enabled := tcp.TestTarget(ctx, t, conn, proj.PublicId, "enabled",
+		target.WithDefaultPort(22),
+		target.WithEnableHostHealthChecks(true),
+		target.WithHostSources([]string{sets[0].PublicId, sets[1].PublicId}))
+	disabled := tcp.TestTarget(ctx, t, conn, proj.PublicId, "disabled",
+		target.WithDefaultPort(22),
+		target.WithHostSources([]string{sets[0].PublicId}))
+	_ = tcp.TestTarget(ctx, t, conn, proj.PublicId, "no host sources",
+		target.WithDefaultPort(22),
+		target.WithEnableHostHealthChecks(true))
+	_ = tcp.TestTarget(ctx, t, conn, proj.PublicId, "no port",
+		target.WithEnableHostHealthChecks(true),
+		target.WithHostSources([]string{sets[0].PublicId}))
+
+	got, err := repo.ListHostHealthCheckTargets(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, enabled.GetPublicId(), got[0].TargetId)
+	assert.Equal(t, uint32(22), got[0].Port)
+	assert.ElementsMatch(t, []string{sets[0].PublicId, sets[1].PublicId}, got[0].HostSetIds)
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		_, err := repo.ListTargetHostHealthChecks(ctx, "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.DeleteTargetHostHealthChecks(ctx, "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	endpoints := []*host.Endpoint{{HostId: hosts[0].PublicId, Address: hosts[0].Address}}
+	_, err = repo.UpsertTargetHostHealthChecks(ctx, enabled.GetPublicId(), 22, endpoints)
+	require.NoError(t, err)
+	_, err = repo.UpsertTargetHostHealthChecks(ctx, disabled.GetPublicId(), 22, endpoints)
+	require.NoError(t, err)
+
+	checks, err := repo.ListTargetHostHealthChecks(ctx, enabled.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, hosts[0].PublicId, checks[0].HostId)
+
+	// Only the checks of the disabled target are removed.
+	n, err := repo.DeleteUnregisteredHostHealthChecks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	checks, err = repo.ListTargetHostHealthChecks(ctx, disabled.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+
+	n, err = repo.DeleteTargetHostHealthChecks(ctx, enabled.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	checks, err = repo.ListTargetHostHealthChecks(ctx, enabled.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+}
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	stderrors "errors"
 	"fmt"
 	"strings"
@@ -349,7 +350,7 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				// KMS-PKI) PKI-based workers to come via API only. We can't
 				// really guard on this in the DB so we need to be sure to not
 				// include it here.
-				n, err := w.Update(ctx, workerClone, []string{"address", "ReleaseVersion", "OperationalState"}, nil)
+				n, err := w.Update(ctx, workerClone, []string{"address", "ReleaseVersion", "OperationalState", "StatusRttMs"}, nil)
 				if err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update status of pki worker"))
 				}
@@ -366,7 +367,7 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				workerClone.Type = KmsWorkerType.String()
 				workerCreateConflict := &db.OnConflict{
 					Target: db.Columns{"public_id"},
-					Action: append(db.SetColumns([]string{"address", "release_version", "operational_state"}),
+					Action: append(db.SetColumns([]string{"address", "release_version", "operational_state", "status_rtt_ms"}),
 						db.SetColumnValues(map[string]any{"last_status_time": "now()"})...),
 				}
 				var withRowsAffected int64
@@ -386,6 +387,14 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				}
 			}
 
+			// Once the worker reports it is no longer active, any outstanding
+			// drain request has been acted on.
+			if workerClone.OperationalState != ActiveOperationalState.String() {
+				if _, err := w.Exec(ctx, clearWorkerDrainRequestQuery, []any{sql.Named("public_id", workerClone.GetPublicId())}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to clear worker drain request"))
+				}
+			}
+
 			// If we've been told to update tags, we need to clean out old
 			// ones and add new ones. Within the current transaction, simply
 			// delete all tags for the given worker, then add the new ones
@@ -415,6 +424,47 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 	return ret, nil
 }
 
+// RequestWorkerDrain marks the worker with the provided id as having been asked
+// to drain. The request is delivered to the worker in its next status
+// response. Draining workers are no longer selected for new sessions and shut
+// down once their active connections have closed. The updated worker is
+// returned.
+func (r *Repository) RequestWorkerDrain(ctx context.Context, workerId string, _ ...Option) (*Worker, error) {
+	const op = "server.(Repository).RequestWorkerDrain"
+	if workerId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+
+	var ret *Worker
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Exec(ctx, requestWorkerDrainQuery, []any{sql.Named("public_id", workerId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsUpdated {
+			case 0:
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("worker %q not found", workerId))
+			case 1:
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("multiple records found when updating worker with id %q", workerId))
+			}
+			ret, err = lookupWorker(ctx, reader, workerId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
 // setWorkerTags removes all existing tags from the same source and worker id
 // and creates new ones based on the ones provided.  This function should be
 // called from inside a db transaction.
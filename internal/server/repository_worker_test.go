@@ -801,6 +801,20 @@ func TestListWorkers_WithActiveWorkers(t *testing.T) {
 			wantCnt:   1,
 			wantState: server.ActiveOperationalState.String(),
 		},
+		{ // Upsert with draining status and do not expect to get a hit
+			name: "upsert-draining-status",
+			upsertFn: func() (*server.Worker, error) {
+				return serversRepo.UpsertWorkerStatus(ctx,
+					server.NewWorker(scope.Global.String(),
+						server.WithName(worker3.GetName()),
+						server.WithAddress(worker3.GetAddress()),
+						server.WithOperationalState(server.DrainingOperationalState.String()),
+						server.WithReleaseVersion("Boundary v.0.11")),
+					server.WithPublicId(worker3.GetPublicId()))
+			},
+			wantCnt:   0,
+			wantState: server.DrainingOperationalState.String(),
+		},
 		{ // Upsert with unknown status and do not expect to get a hit- test worker create before status
 			name: "upsert-unknown-status",
 			upsertFn: func() (*server.Worker, error) {
@@ -829,6 +843,56 @@ func TestListWorkers_WithActiveWorkers(t *testing.T) {
 	}
 }
 
+func TestRepository_RequestWorkerDrain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("missing-id", func(t *testing.T) {
+		_, err := repo.RequestWorkerDrain(ctx, "")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, err := repo.RequestWorkerDrain(ctx, "w_doesnotexist")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := server.TestKmsWorker(t, conn, wrapper)
+		assert.False(w.GetDrainRequested())
+
+		got, err := repo.RequestWorkerDrain(ctx, w.GetPublicId())
+		require.NoError(err)
+		assert.True(got.GetDrainRequested())
+		assert.Equal(w.GetVersion(), got.GetVersion())
+
+		// Still active, so the request stays outstanding
+		upsertFn := func(state server.OperationalState) *server.Worker {
+			got, err := repo.UpsertWorkerStatus(ctx,
+				server.NewWorker(scope.Global.String(),
+					server.WithName(w.GetName()),
+					server.WithAddress(w.GetAddress()),
+					server.WithOperationalState(state.String()),
+					server.WithReleaseVersion("Boundary v0.15.0")),
+				server.WithPublicId(w.GetPublicId()))
+			require.NoError(err)
+			return got
+		}
+		assert.True(upsertFn(server.ActiveOperationalState).GetDrainRequested())
+
+		// Once the worker reports draining the request is cleared
+		assert.False(upsertFn(server.DrainingOperationalState).GetDrainRequested())
+		assert.False(upsertFn(server.ActiveOperationalState).GetDrainRequested())
+	})
+}
+
 func TestListWorkers_WithLiveness(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
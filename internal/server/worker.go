@@ -25,6 +25,7 @@ const (
 	KmsWorkerType            WorkerType       = "kms"
 	PkiWorkerType            WorkerType       = "pki"
 	ActiveOperationalState   OperationalState = "active"
+	DrainingOperationalState OperationalState = "draining"
 	ShutdownOperationalState OperationalState = "shutdown"
 	UnknownOperationalState  OperationalState = "unknown"
 )
@@ -51,7 +52,7 @@ type workerAuthWorkerId struct {
 
 func ValidOperationalState(s string) bool {
 	switch s {
-	case ActiveOperationalState.String(), ShutdownOperationalState.String():
+	case ActiveOperationalState.String(), DrainingOperationalState.String(), ShutdownOperationalState.String():
 		return true
 	}
 	return false
@@ -59,7 +60,7 @@ func ValidOperationalState(s string) bool {
 
 func (t OperationalState) String() string {
 	switch t {
-	case ActiveOperationalState, ShutdownOperationalState:
+	case ActiveOperationalState, DrainingOperationalState, ShutdownOperationalState:
 		return string(t)
 	}
 	return string(UnknownOperationalState)
@@ -114,8 +115,10 @@ func NewWorker(scopeId string, opt ...Option) *Worker {
 			Address:          opts.withAddress,
 			ReleaseVersion:   opts.withReleaseVersion,
 			OperationalState: opts.withOperationalState,
+			StatusRttMs:      opts.withStatusRttMs,
 		},
-		inputTags: opts.withWorkerTags,
+		inputTags:             opts.withWorkerTags,
+		activeConnectionCount: opts.withTestActiveConnectionCount,
 	}
 	if opts.withTestUseInputTagsAsApiTags {
 		worker.apiTags = worker.inputTags
@@ -231,6 +234,8 @@ type workerAggregate struct {
 	ApiTags               string
 	ActiveConnectionCount uint32
 	OperationalState      string
+	StatusRttMs           uint32
+	DrainRequested        bool
 	// Config Fields
 	LastStatusTime   *timestamp.Timestamp
 	WorkerConfigTags string
@@ -252,6 +257,8 @@ func (a *workerAggregate) toWorker(ctx context.Context) (*Worker, error) {
 			Type:             a.Type,
 			ReleaseVersion:   a.ReleaseVersion,
 			OperationalState: a.OperationalState,
+			StatusRttMs:      a.StatusRttMs,
+			DrainRequested:   a.DrainRequested,
 		},
 		activeConnectionCount: a.ActiveConnectionCount,
 	}
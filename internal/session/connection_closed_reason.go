@@ -20,6 +20,14 @@ const (
 	ConnectionCanceled     ClosedReason = "canceled"
 	ConnectionNetworkError ClosedReason = "network error"
 	ConnectionSystemError  ClosedReason = "system error"
+	// ConnectionLimitExceeded is used when a worker refuses to proxy a
+	// connection because it exceeds a concurrent connection limit of the
+	// session or target. Connections closed for this reason don't count
+	// toward the connection limit of the session.
+	ConnectionLimitExceeded ClosedReason = "limit exceeded"
+	// ConnectionIdleTimeout is used when a worker closes a connection because
+	// no bytes were transferred within the idle timeout of the target.
+	ConnectionIdleTimeout ClosedReason = "idle timeout"
 )
 
 // String representation of the termination reason
@@ -42,6 +50,10 @@ func convertToClosedReason(ctx context.Context, s string) (ClosedReason, error)
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionLimitExceeded.String():
+		return ConnectionLimitExceeded, nil
+	case ConnectionIdleTimeout.String():
+		return ConnectionIdleTimeout, nil
 	default:
 		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	subsystem = "controller_session"
+)
+
+var stateTransitions = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: subsystem,
+		Name:      "state_transitions_total",
+		Help:      "Count of sessions transitioned into a state, by state.",
+	},
+	[]string{"state"},
+)
+
+// InitializeMetrics initializes the metrics for visibility into session
+// state transitions.
+func InitializeMetrics(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(stateTransitions)
+	for _, s := range []Status{StatusPending, StatusActive, StatusCanceling, StatusTerminated} {
+		stateTransitions.WithLabelValues(s.String())
+	}
+}
+
+// recordStateTransitions counts n sessions that transitioned into state s.
+func recordStateTransitions(s Status, n int) {
+	if n <= 0 {
+		return
+	}
+	stateTransitions.WithLabelValues(s.String()).Add(float64(n))
+}
@@ -42,12 +42,14 @@ type options struct {
 	withIgnoreDecryptionFailures bool
 	withRandomReader             io.Reader
 	withStartPageAfterItem       pagination.Item
+	withMaxLifetime              time.Duration
 }
 
 func getDefaultOptions() options {
 	return options{
 		withWorkerStateDelay: 10 * time.Second,
 		withRandomReader:     rand.Reader,
+		withMaxLifetime:      DefaultMaxLifetime,
 	}
 }
 
@@ -159,3 +161,14 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithMaxLifetime provides an option to limit the total lifetime of a session,
+// from its creation to its expiration, when it is extended. If the passed-in
+// duration is not positive, DefaultMaxLifetime is used instead.
+func WithMaxLifetime(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.withMaxLifetime = d
+		}
+	}
+}
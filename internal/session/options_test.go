@@ -6,6 +6,7 @@ package session
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -94,4 +95,15 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRandomReader = reader
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithMaxLifetime", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxLifetime(time.Hour))
+		testOpts := getDefaultOptions()
+		assert.Equal(DefaultMaxLifetime, testOpts.withMaxLifetime)
+		testOpts.withMaxLifetime = time.Hour
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithMaxLifetime(0))
+		assert.Equal(DefaultMaxLifetime, opts.withMaxLifetime)
+	})
 }
@@ -69,7 +69,13 @@ with connections_available as (
 	where
 		s.public_id = @session_id and
  		(s.connection_limit = -1 or
-		s.connection_limit > (select count(*) from session_connection sc where sc.session_id = @session_id ))
+		s.connection_limit > (
+			select count(*) from session_connection sc
+			where sc.session_id = @session_id and
+				-- connections a worker refused for exceeding a concurrent
+				-- connection limit don't count toward the connection limit
+				sc.closed_reason is distinct from 'limit exceeded'
+		))
 ),
 unexpired_session as (
 	select
@@ -106,7 +112,8 @@ session_connection_count(current_connection_count) as (
 	from
 		session_connection sc
 	where
-		sc.session_id = @session_id
+		sc.session_id = @session_id and
+		sc.closed_reason is distinct from 'limit exceeded'
 ),
 session_connection_limit(expiration_time, connection_limit) as (
 	select
@@ -179,7 +186,8 @@ from
             select count (*) 
               from session_connection sc 
             where 
-              sc.session_id = us.public_id
+              sc.session_id = us.public_id and
+              sc.closed_reason is distinct from 'limit exceeded'
           ) >= connection_limit
         ) or 
         -- canceled sessions
@@ -257,7 +265,8 @@ where
 			select count (*)
 				from session_connection sc
 			where
-				sc.session_id = us.public_id
+				sc.session_id = us.public_id and
+				sc.closed_reason is distinct from 'limit exceeded'
 			) >= connection_limit
 		) or
 		-- canceled sessions
@@ -457,9 +466,69 @@ with session_ids as (
     from session_list
    where session_list.public_id in (select * from session_ids)
 order by update_time desc, public_id desc;
+`
+	// extendSession moves the expiration time of a pending or active session
+	// which has not expired yet to a later time and replaces its certificate
+	// with one reissued for the new expiration time.
+	extendSession = `
+update session
+   set expiration_time = @expiration_time,
+       certificate = @certificate,
+       version = version + 1
+ where public_id = @public_id
+   and version = @version
+   and expiration_time > now()
+   and expiration_time < @expiration_time
+   and public_id in (
+         select session_id
+           from session_state
+          where session_id = @public_id
+            and state in ('pending', 'active')
+            and end_time is null
+       );
+`
+	// originalExpirationTime returns the expiration time of a session when it
+	// was created.
+	originalExpirationTime = `
+select original_expiration_time
+  from session
+ where public_id = @public_id;
+`
+	// cancelConnection closes a single open connection of a session on behalf
+	// of an administrator. The worker proxying the connection terminates it
+	// once it learns about the closed state.
+	cancelConnection = `
+update session_connection
+   set closed_reason = 'canceled'
+ where public_id = @public_id
+   and session_id = @session_id
+   and closed_reason is null;
 `
 	estimateCountSessions = `
     select reltuples::bigint as estimate from pg_class where oid in ('session'::regclass)
+`
+
+	listHostSessionStats = `
+with
+active_connection (session_id, connection_count) as (
+  select session_id,
+         count(*)
+    from session_connection
+   where closed_reason is null
+group by session_id
+)
+  select shsh.host_id                          as host_id,
+         count(s.public_id)                    as active_session_count,
+         coalesce(sum(ac.connection_count), 0) as active_connection_count
+    from session s
+    join session_host_set_host shsh
+      on s.public_id = shsh.session_id
+    left join active_connection ac
+      on s.public_id = ac.session_id
+   where s.target_id = @target_id
+     and s.termination_reason is null
+     and shsh.host_id is not null
+group by shsh.host_id;
 `
 )
 
@@ -442,6 +442,25 @@ func (r *ConnectionRepository) closeOrphanedConnections(ctx context.Context, wor
 	return orphanedConns, nil
 }
 
+// closedConnections returns the ids of the connections from the given set
+// which are closed in the repo, such as connections canceled by an
+// administrator while the worker was still proxying them.
+func (r *ConnectionRepository) closedConnections(ctx context.Context, connectionIds []string) ([]string, error) {
+	const op = "session.(ConnectionRepository).closedConnections"
+	if len(connectionIds) == 0 {
+		return nil, nil
+	}
+	var connections []*Connection
+	if err := r.list(ctx, &connections, "closed_reason is not null and public_id in (?)", []any{connectionIds}, WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	closed := make([]string, 0, len(connections))
+	for _, c := range connections {
+		closed = append(closed, c.PublicId)
+	}
+	return closed, nil
+}
+
 func fetchConnectionStates(ctx context.Context, r db.Reader, connectionId string, opt ...db.Option) ([]*ConnectionState, error) {
 	const op = "session.fetchConnectionStates"
 	var states []*ConnectionState
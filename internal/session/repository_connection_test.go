@@ -576,6 +576,8 @@ func TestUpdateBytesUpDown(t *testing.T) {
 		ConnectionCanceled,
 		ConnectionNetworkError,
 		ConnectionSystemError,
+		ConnectionLimitExceeded,
+		ConnectionIdleTimeout,
 	}
 	cws := make([]CloseWith, 0, len(conns))
 	for i := 0; i < len(conns); i++ {
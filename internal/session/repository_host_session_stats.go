@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// HostSessionStats contains statistics about the active sessions of a target
+// which were established to a host. It is used to select the host of a new
+// session.
+type HostSessionStats struct {
+	HostId string
+	// ActiveSessionCount is the number of sessions to the host which have not
+	// been terminated.
+	ActiveSessionCount uint32
+	// ActiveConnectionCount is the number of connections to the host which
+	// have not been closed.
+	ActiveConnectionCount uint32
+}
+
+// ListHostSessionStats returns the session statistics of each host which the
+// target targetId has active sessions to. Hosts without any active sessions
+// for the target are not included. Terminated sessions are not counted so
+// the cost of the query does not grow with the session history of the
+// target.
+func (r *Repository) ListHostSessionStats(ctx context.Context, targetId string, _ ...Option) ([]*HostSessionStats, error) {
+	const op = "session.(Repository).ListHostSessionStats"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	rows, err := r.reader.Query(ctx, listHostSessionStats, []any{sql.Named("target_id", targetId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query host session stats"))
+	}
+	defer rows.Close()
+	var stats []*HostSessionStats
+	for rows.Next() {
+		var s HostSessionStats
+		if err := r.reader.ScanRows(ctx, rows, &s); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("failed to scan host session stats"))
+		}
+		stats = append(stats, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query host session stats"))
+	}
+	return stats, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListHostSessionStats(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	t.Run("missing-target-id", func(t *testing.T) {
+		got, err := repo.ListHostSessionStats(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+		assert.Nil(t, got)
+	})
+	t.Run("no-sessions", func(t *testing.T) {
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		got, err := repo.ListHostSessionStats(ctx, c.TargetId)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("sessions", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		first := TestSession(t, conn, wrapper, c)
+		_ = TestConnection(t, conn, first.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		_ = TestConnection(t, conn, first.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		_ = TestSession(t, conn, wrapper, c)
+
+		// Terminated sessions are not counted.
+		terminated := TestSession(t, conn, wrapper, c)
+		_, err := repo.CancelSession(ctx, terminated.PublicId, terminated.Version)
+		require.NoError(err)
+		n, err := repo.terminateSessionIfPossible(ctx, terminated.PublicId)
+		require.NoError(err)
+		require.Equal(1, n)
+
+		got, err := repo.ListHostSessionStats(ctx, c.TargetId)
+		require.NoError(err)
+		require.Len(got, 1)
+		assert.Equal(c.HostId, got[0].HostId)
+		assert.Equal(uint32(2), got[0].ActiveSessionCount)
+		assert.Equal(uint32(2), got[0].ActiveConnectionCount)
+	})
+	t.Run("only-terminated-sessions", func(t *testing.T) {
+		require := require.New(t)
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		terminated := TestSession(t, conn, wrapper, c)
+		_, err := repo.CancelSession(ctx, terminated.PublicId, terminated.Version)
+		require.NoError(err)
+		_, err = repo.terminateSessionIfPossible(ctx, terminated.PublicId)
+		require.NoError(err)
+
+		got, err := repo.ListHostSessionStats(ctx, c.TargetId)
+		require.NoError(err)
+		assert.Empty(t, got)
+	})
+}
@@ -187,6 +187,7 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	recordStateTransitions(StatusPending, 1)
 	return returnedSession, nil
 }
 
@@ -503,6 +504,167 @@ func (r *Repository) CancelSession(ctx context.Context, sessionId string, sessio
 	return s, nil
 }
 
+// ExtendSession moves the expiration time of a pending or active session to
+// the given extension from now and reissues the session certificate with the
+// new expiration time. A single extension is limited to the original lifetime
+// of the session, the total lifetime of the session is limited to the maximum
+// lifetime, and the expiration time is never moved to an earlier time. If
+// extension is zero, the original lifetime of the session is used. It returns
+// the updated session.
+//
+// Supported options: WithMaxLifetime, WithIgnoreDecryptionFailures
+func (r *Repository) ExtendSession(ctx context.Context, sessionId string, sessionVersion uint32, extension time.Duration, opt ...Option) (*Session, error) {
+	const op = "session.(Repository).ExtendSession"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if sessionVersion == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session version")
+	}
+	if extension < 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "extension must not be negative")
+	}
+	opts := getOpts(opt...)
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			current := AllocSession()
+			current.PublicId = sessionId
+			if err := read.LookupById(ctx, &current); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", sessionId)))
+			}
+			originalExpiration, err := fetchOriginalExpirationTime(ctx, read, sessionId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			createTime := current.CreateTime.AsTime()
+			lifetime := originalExpiration.Sub(createTime)
+			if lifetime <= 0 {
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s has no lifetime", sessionId))
+			}
+			if extension == 0 || extension > lifetime {
+				extension = lifetime
+			}
+			expiration := time.Now().Add(extension)
+			maxExpiration := createTime.Add(opts.withMaxLifetime)
+			if !maxExpiration.After(current.ExpirationTime.AsTime()) {
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s has reached its maximum lifetime of %s", sessionId, opts.withMaxLifetime))
+			}
+			if expiration.After(maxExpiration) {
+				expiration = maxExpiration
+			}
+
+			if err := decryptAndMaybeUpdateSession(ctx, r.kms, &current, w); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			cert, err := reissueCert(ctx, current.Certificate, current.CertificatePrivateKey, expiration, r.randomReader)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			rowsAffected, err := w.Exec(ctx, extendSession, []any{
+				sql.Named("public_id", sessionId),
+				sql.Named("version", sessionVersion),
+				sql.Named("expiration_time", timestamp.New(expiration)),
+				sql.Named("certificate", cert),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsAffected {
+			case 1:
+				return nil
+			case 0:
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s is not pending or active, has expired, already expires later, or its version does not match", sessionId))
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("extended session %s and %d rows updated", sessionId, rowsAffected))
+			}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, _, err := r.LookupSession(ctx, sessionId, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return s, nil
+}
+
+// fetchOriginalExpirationTime returns the expiration time of the session when
+// it was created.
+func fetchOriginalExpirationTime(ctx context.Context, r db.Reader, sessionId string) (time.Time, error) {
+	const op = "session.fetchOriginalExpirationTime"
+	rows, err := r.Query(ctx, originalExpirationTime, []any{sql.Named("public_id", sessionId)})
+	if err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var exp sql.NullTime
+	for rows.Next() {
+		if err := rows.Scan(&exp); err != nil {
+			return time.Time{}, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	if !exp.Valid {
+		return time.Time{}, errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s has no original expiration time", sessionId))
+	}
+	return exp.Time, nil
+}
+
+// CancelConnection closes a single open connection of the session without
+// changing the state of the session or its other connections. The worker
+// proxying the connection terminates it once it learns that the connection
+// was closed. It returns the updated session.
+//
+// Supported options: WithIgnoreDecryptionFailures
+func (r *Repository) CancelConnection(ctx context.Context, sessionId, connectionId string, opt ...Option) (*Session, error) {
+	const op = "session.(Repository).CancelConnection"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if connectionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsAffected, err := w.Exec(ctx, cancelConnection, []any{
+				sql.Named("public_id", connectionId),
+				sql.Named("session_id", sessionId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsAffected {
+			case 1:
+				return nil
+			case 0:
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("no open connection %s found for session %s", connectionId, sessionId))
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("canceled connection %s and %d rows updated", connectionId, rowsAffected))
+			}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, _, err := r.LookupSession(ctx, sessionId, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return s, nil
+}
+
 // TerminateCompletedSessions will terminate sessions in the repo based on:
 //   - sessions that have exhausted their connection limit and all their connections are closed.
 //   - sessions that are expired and all their connections are closed.
@@ -529,6 +691,7 @@ func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
+	recordStateTransitions(StatusTerminated, rowsAffected)
 	return rowsAffected, nil
 }
 
@@ -558,6 +721,7 @@ func (r *Repository) terminateSessionIfPossible(ctx context.Context, sessionId s
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
+	recordStateTransitions(StatusTerminated, rowsAffected)
 	return rowsAffected, nil
 }
 
@@ -731,6 +895,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 		}
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
+	recordStateTransitions(StatusActive, 1)
 	return &updatedSession, returnedStates, nil
 }
 
@@ -807,6 +972,7 @@ func (r *Repository) updateState(ctx context.Context, sessionId string, sessionV
 	if err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("error creating new state"))
 	}
+	recordStateTransitions(s, rowsAffected)
 	return &updatedSession, returnedStates, nil
 }
 
@@ -5,6 +5,7 @@ package session
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"testing"
 	"time"
@@ -2025,3 +2026,141 @@ func TestRepository_CheckIfNoLongerActive(t *testing.T) {
 	}
 	assert.ElementsMatch(t, gotIds, []string{unrecognizedSessionId, terminatedSession.PublicId, cancelingSess.PublicId})
 }
+
+func TestRepository_ExtendSession(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	const lifetime = time.Minute
+	setupFn := func() *Session {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ExpirationTime = timestamp.New(time.Now().Add(lifetime))
+		return TestSession(t, conn, wrapper, composedOf)
+	}
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		_, err := repo.ExtendSession(ctx, "", 1, 0)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ExtendSession(ctx, "s_1234567890", 0, 0)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ExtendSession(ctx, "s_1234567890", 1, -time.Second)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("extend", func(t *testing.T) {
+		s := setupFn()
+		// Sleep so that extending by the original lifetime moves the
+		// expiration time.
+		time.Sleep(time.Second)
+		before := time.Now()
+		got, err := repo.ExtendSession(ctx, s.PublicId, s.Version, 10*lifetime)
+		require.NoError(t, err)
+		exp := got.ExpirationTime.AsTime()
+		assert.True(t, exp.After(s.ExpirationTime.AsTime()))
+		assert.False(t, exp.Before(before.Add(lifetime)))
+		assert.False(t, exp.After(time.Now().Add(lifetime)))
+		assert.Equal(t, s.Version+1, got.Version)
+
+		// The certificate is reissued with the new expiration time.
+		assert.NotEqual(t, s.Certificate, got.Certificate)
+		orig, err := x509.ParseCertificate(s.Certificate)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(got.Certificate)
+		require.NoError(t, err)
+		assert.Equal(t, orig.PublicKey, cert.PublicKey)
+		assert.True(t, cert.NotAfter.Equal(exp.Truncate(time.Second)))
+
+		// The expiration time is never moved to an earlier time.
+		_, err = repo.ExtendSession(ctx, s.PublicId, got.Version, time.Second)
+		assert.True(t, errors.Match(errors.T(errors.InvalidSessionState), err))
+
+		// The version must match.
+		time.Sleep(time.Second)
+		_, err = repo.ExtendSession(ctx, s.PublicId, s.Version, 0)
+		assert.True(t, errors.Match(errors.T(errors.InvalidSessionState), err))
+	})
+
+	t.Run("max-lifetime", func(t *testing.T) {
+		s := setupFn()
+		time.Sleep(time.Second)
+		// The expiration time is limited to the maximum lifetime.
+		maxLifetime := lifetime + 30*time.Second
+		got, err := repo.ExtendSession(ctx, s.PublicId, s.Version, 0, WithMaxLifetime(maxLifetime))
+		require.NoError(t, err)
+		assert.True(t, got.ExpirationTime.AsTime().Equal(s.CreateTime.AsTime().Add(maxLifetime)))
+
+		// Once the maximum lifetime is reached the session cannot be extended.
+		_, err = repo.ExtendSession(ctx, s.PublicId, got.Version, 0, WithMaxLifetime(maxLifetime))
+		assert.True(t, errors.Match(errors.T(errors.InvalidSessionState), err))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		s := setupFn()
+		canceled, err := repo.CancelSession(ctx, s.PublicId, s.Version)
+		require.NoError(t, err)
+		time.Sleep(time.Second)
+		_, err = repo.ExtendSession(ctx, s.PublicId, canceled.Version, 0)
+		assert.True(t, errors.Match(errors.T(errors.InvalidSessionState), err))
+	})
+
+	t.Run("not-found", func(t *testing.T) {
+		id, err := newId(ctx)
+		require.NoError(t, err)
+		_, err = repo.ExtendSession(ctx, id, 1, 0)
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+}
+
+func TestRepository_CancelConnection(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		_, err := repo.CancelConnection(ctx, "", "sc_1234567890")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.CancelConnection(ctx, "s_1234567890", "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		c1 := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 222, "127.0.0.1")
+		c2 := TestConnection(t, conn, s.PublicId, "127.0.0.1", 23, "127.0.0.1", 222, "127.0.0.1")
+
+		got, err := repo.CancelConnection(ctx, s.PublicId, c1.PublicId)
+		require.NoError(t, err)
+		assert.NotEqual(t, StatusCanceling, got.States[0].Status)
+		reasons := make(map[string]string, len(got.Connections))
+		for _, c := range got.Connections {
+			reasons[c.PublicId] = c.ClosedReason
+		}
+		assert.Equal(t, ConnectionCanceled.String(), reasons[c1.PublicId])
+		assert.Empty(t, reasons[c2.PublicId])
+
+		// Already closed connections cannot be canceled again.
+		_, err = repo.CancelConnection(ctx, s.PublicId, c1.PublicId)
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+
+	t.Run("wrong-session", func(t *testing.T) {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		other := TestDefaultSession(t, conn, wrapper, iamRepo)
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 222, "127.0.0.1")
+		_, err := repo.CancelConnection(ctx, other.PublicId, c.PublicId)
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+}
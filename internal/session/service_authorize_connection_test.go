@@ -162,3 +162,48 @@ func TestService_AuthorizeConnection(t *testing.T) {
 		})
 	}
 }
+
+func TestService_AuthorizeConnection_LimitExceeded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	composedOf.ConnectionLimit = 2
+	s := TestSession(t, conn, wrapper, composedOf)
+	srv := server.TestKmsWorker(t, conn, wrapper)
+	_, _, err = repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+	require.NoError(t, err)
+
+	_, _, authzInfo, err := AuthorizeConnection(ctx, repo, connRepo, s.PublicId, srv.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), authzInfo.CurrentConnectionCount)
+
+	// A connection the worker refuses for exceeding a concurrent connection
+	// limit leaves the number of connections left unchanged.
+	refused, _, _, err := AuthorizeConnection(ctx, repo, connRepo, s.PublicId, srv.PublicId)
+	require.NoError(t, err)
+	_, err = CloseConnections(ctx, repo, connRepo, []CloseWith{
+		{ConnectionId: refused.PublicId, ClosedReason: ConnectionLimitExceeded},
+	})
+	require.NoError(t, err)
+	authzInfo, err = repo.sessionAuthzSummary(ctx, s.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), authzInfo.ConnectionLimit)
+	assert.Equal(t, uint32(1), authzInfo.CurrentConnectionCount)
+
+	// So the session can still use its whole connection limit.
+	_, _, authzInfo, err = AuthorizeConnection(ctx, repo, connRepo, s.PublicId, srv.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), authzInfo.CurrentConnectionCount)
+	_, _, _, err = AuthorizeConnection(ctx, repo, connRepo, s.PublicId, srv.PublicId)
+	assert.Error(t, err)
+}
@@ -30,6 +30,10 @@ type StateReport struct {
 //  3. Checks for any orphaned connections, which is defined as a connection
 //     that is in an active state, but was not reported by worker. Any orphaned
 //     connections will be marked as closed.
+//  4. Checks for reported connections that are closed in the repositories,
+//     such as connections canceled by an administrator. These are included in
+//     the returned StateReport for their session so the Worker terminates
+//     them.
 func WorkerStatusReport(ctx context.Context, repo *Repository, connRepo *ConnectionRepository, workerId string, report []*StateReport) ([]*StateReport, error) {
 	const op = "session.WorkerStatusReport"
 
@@ -63,8 +67,43 @@ func WorkerStatusReport(ctx context.Context, repo *Repository, connRepo *Connect
 		event.WriteSysEvent(ctx, op, "marked unclaimed connections as closed", "worker_id", workerId, "count", len(closed))
 	}
 
+	closedConns, err := connRepo.closedConnections(ctx, reportedConnectionIds)
+	if err != nil {
+		merr = stderrors.Join(merr, errors.New(ctx, errors.Internal, op, fmt.Sprintf("Error checking for closed connections for worker %s: %v", workerId, err)))
+	}
+
 	if merr != nil {
 		return nil, merr
 	}
+
+	if len(closedConns) > 0 {
+		closedIds := make(map[string]struct{}, len(closedConns))
+		for _, id := range closedConns {
+			closedIds[id] = struct{}{}
+		}
+		bySession := make(map[string]*StateReport, len(notActive))
+		for _, na := range notActive {
+			bySession[na.SessionId] = na
+		}
+		for _, r := range report {
+			for _, c := range r.Connections {
+				if _, ok := closedIds[c.GetPublicId()]; !ok {
+					continue
+				}
+				sr, ok := bySession[r.SessionId]
+				if !ok {
+					// The session is still pending or active, only the
+					// connection has been closed.
+					sr = &StateReport{
+						SessionId: r.SessionId,
+						Status:    StatusActive,
+					}
+					bySession[r.SessionId] = sr
+					notActive = append(notActive, sr)
+				}
+				sr.Connections = append(sr.Connections, c)
+			}
+		}
+	}
 	return notActive, nil
 }
@@ -143,6 +143,53 @@ func TestWorkerStatusReport(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "CanceledConnection",
+			caseFn: func(t *testing.T) testCase {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+					UserId:          uId,
+					HostId:          h.GetPublicId(),
+					TargetId:        tar.GetPublicId(),
+					HostSetId:       hs.GetPublicId(),
+					AuthTokenId:     at.GetPublicId(),
+					ProjectId:       prj.GetPublicId(),
+					Endpoint:        "tcp://127.0.0.1:22",
+					ConnectionLimit: 10,
+				})
+				tofu := session.TestTofu(t)
+				sess, _, err = repo.ActivateSession(ctx, sess.PublicId, sess.Version, tofu)
+				require.NoError(t, err)
+				connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker.PublicId)
+				require.NoError(t, err)
+				connection2, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker.PublicId)
+				require.NoError(t, err)
+				_, err = repo.CancelConnection(ctx, sess.PublicId, connection.PublicId)
+				require.NoError(t, err)
+
+				canceled := &session.Connection{PublicId: connection.PublicId}
+				return testCase{
+					worker: worker,
+					req: []*session.StateReport{
+						{
+							SessionId: sess.PublicId,
+							Status:    session.StatusActive,
+							Connections: []*session.Connection{
+								canceled,
+								{PublicId: connection2.PublicId},
+							},
+						},
+					},
+					want: []*session.StateReport{
+						{
+							SessionId:   sess.PublicId,
+							Status:      session.StatusActive,
+							Connections: []*session.Connection{canceled},
+						},
+					},
+				}
+			},
+		},
 		{
 			name: "SessionClosed",
 			caseFn: func(t *testing.T) testCase {
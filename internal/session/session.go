@@ -27,6 +27,10 @@ import (
 
 const (
 	defaultSessionTableName = "session"
+
+	// DefaultMaxLifetime is the default limit on the total lifetime of a
+	// session, from its creation to its expiration, when it is extended.
+	DefaultMaxLifetime = 24 * time.Hour
 )
 
 // ComposedOf defines the boundary data that is referenced to compose a session.
@@ -58,6 +62,17 @@ type ComposedOf struct {
 	WorkerFilter        string
 	EgressWorkerFilter  string
 	IngressWorkerFilter string
+	// Bandwidth and concurrent connection limits enforced by the worker. The
+	// limits not prefixed with Session apply to all connections to the target
+	// through a single worker. Zero means no limit.
+	BandwidthLimit                  uint32
+	SessionBandwidthLimit           uint32
+	MaxConcurrentConnections        uint32
+	SessionMaxConcurrentConnections uint32
+	// ConnectionIdleTimeoutSeconds is the number of seconds without any bytes
+	// transferred after which the worker closes a connection. Zero means no
+	// timeout.
+	ConnectionIdleTimeoutSeconds uint32
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
@@ -113,6 +128,16 @@ type Session struct {
 	EgressWorkerFilter  string `json:"-" gorm:"default:null"`
 	IngressWorkerFilter string `json:"-" gorm:"default:null"`
 
+	// Bandwidth and concurrent connection limits
+	BandwidthLimit                  uint32 `json:"bandwidth_limit,omitempty" gorm:"default:null"`
+	SessionBandwidthLimit           uint32 `json:"session_bandwidth_limit,omitempty" gorm:"default:null"`
+	MaxConcurrentConnections        uint32 `json:"max_concurrent_connections,omitempty" gorm:"default:null"`
+	SessionMaxConcurrentConnections uint32 `json:"session_max_concurrent_connections,omitempty" gorm:"default:null"`
+
+	// Number of seconds without any bytes transferred after which the worker
+	// closes a connection
+	ConnectionIdleTimeoutSeconds uint32 `json:"connection_idle_timeout_seconds,omitempty" gorm:"default:null"`
+
 	// key_id is the ID of the key version used to encrypt any fields in this struct
 	KeyId string `json:"key_id,omitempty" gorm:"default:null"`
 
@@ -190,21 +215,26 @@ var (
 func New(ctx context.Context, c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:              c.UserId,
-		HostId:              c.HostId,
-		TargetId:            c.TargetId,
-		HostSetId:           c.HostSetId,
-		AuthTokenId:         c.AuthTokenId,
-		ProjectId:           c.ProjectId,
-		Endpoint:            c.Endpoint,
-		ExpirationTime:      c.ExpirationTime,
-		ConnectionLimit:     c.ConnectionLimit,
-		WorkerFilter:        c.WorkerFilter,
-		EgressWorkerFilter:  c.EgressWorkerFilter,
-		IngressWorkerFilter: c.IngressWorkerFilter,
-		DynamicCredentials:  c.DynamicCredentials,
-		StaticCredentials:   c.StaticCredentials,
-		ProtocolWorkerId:    c.ProtocolWorkerId,
+		UserId:                          c.UserId,
+		HostId:                          c.HostId,
+		TargetId:                        c.TargetId,
+		HostSetId:                       c.HostSetId,
+		AuthTokenId:                     c.AuthTokenId,
+		ProjectId:                       c.ProjectId,
+		Endpoint:                        c.Endpoint,
+		ExpirationTime:                  c.ExpirationTime,
+		ConnectionLimit:                 c.ConnectionLimit,
+		WorkerFilter:                    c.WorkerFilter,
+		EgressWorkerFilter:              c.EgressWorkerFilter,
+		IngressWorkerFilter:             c.IngressWorkerFilter,
+		BandwidthLimit:                  c.BandwidthLimit,
+		SessionBandwidthLimit:           c.SessionBandwidthLimit,
+		MaxConcurrentConnections:        c.MaxConcurrentConnections,
+		SessionMaxConcurrentConnections: c.SessionMaxConcurrentConnections,
+		ConnectionIdleTimeoutSeconds:    c.ConnectionIdleTimeoutSeconds,
+		DynamicCredentials:              c.DynamicCredentials,
+		StaticCredentials:               c.StaticCredentials,
+		ProtocolWorkerId:                c.ProtocolWorkerId,
 	}
 	if err := s.validateNewSession(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -220,22 +250,27 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() any {
 	clone := &Session{
-		PublicId:            s.PublicId,
-		UserId:              s.UserId,
-		HostId:              s.HostId,
-		TargetId:            s.TargetId,
-		HostSetId:           s.HostSetId,
-		AuthTokenId:         s.AuthTokenId,
-		ProjectId:           s.ProjectId,
-		TerminationReason:   s.TerminationReason,
-		Version:             s.Version,
-		Endpoint:            s.Endpoint,
-		ConnectionLimit:     s.ConnectionLimit,
-		WorkerFilter:        s.WorkerFilter,
-		EgressWorkerFilter:  s.EgressWorkerFilter,
-		IngressWorkerFilter: s.IngressWorkerFilter,
-		KeyId:               s.KeyId,
-		ProtocolWorkerId:    s.ProtocolWorkerId,
+		PublicId:                        s.PublicId,
+		UserId:                          s.UserId,
+		HostId:                          s.HostId,
+		TargetId:                        s.TargetId,
+		HostSetId:                       s.HostSetId,
+		AuthTokenId:                     s.AuthTokenId,
+		ProjectId:                       s.ProjectId,
+		TerminationReason:               s.TerminationReason,
+		Version:                         s.Version,
+		Endpoint:                        s.Endpoint,
+		ConnectionLimit:                 s.ConnectionLimit,
+		WorkerFilter:                    s.WorkerFilter,
+		EgressWorkerFilter:              s.EgressWorkerFilter,
+		IngressWorkerFilter:             s.IngressWorkerFilter,
+		BandwidthLimit:                  s.BandwidthLimit,
+		SessionBandwidthLimit:           s.SessionBandwidthLimit,
+		MaxConcurrentConnections:        s.MaxConcurrentConnections,
+		SessionMaxConcurrentConnections: s.SessionMaxConcurrentConnections,
+		ConnectionIdleTimeoutSeconds:    s.ConnectionIdleTimeoutSeconds,
+		KeyId:                           s.KeyId,
+		ProtocolWorkerId:                s.ProtocolWorkerId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -353,6 +388,16 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "egress worker filter is immutable")
 		case contains(opts.WithFieldMaskPaths, "IngressWorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "ingress worker filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "BandwidthLimit"):
+			return errors.New(ctx, errors.InvalidParameter, op, "bandwidth limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "SessionBandwidthLimit"):
+			return errors.New(ctx, errors.InvalidParameter, op, "session bandwidth limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "MaxConcurrentConnections"):
+			return errors.New(ctx, errors.InvalidParameter, op, "max concurrent connections is immutable")
+		case contains(opts.WithFieldMaskPaths, "SessionMaxConcurrentConnections"):
+			return errors.New(ctx, errors.InvalidParameter, op, "session max concurrent connections is immutable")
+		case contains(opts.WithFieldMaskPaths, "ConnectionIdleTimeoutSeconds"):
+			return errors.New(ctx, errors.InvalidParameter, op, "connection idle timeout is immutable")
 		case contains(opts.WithFieldMaskPaths, "DynamicCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "StaticCredentials"):
@@ -488,6 +533,50 @@ func newCert(ctx context.Context, jobId string, addresses []string, exp time.Tim
 	return privKey, certBytes, nil
 }
 
+// reissueCert creates a copy of the session certificate which expires at exp
+// and is signed with the private key of the session. It is used to move the
+// expiration of the certificate along with the expiration of the session when
+// the session is extended.
+func reissueCert(ctx context.Context, certBytes []byte, privKey ed25519.PrivateKey, exp time.Time, rand io.Reader) ([]byte, error) {
+	const op = "session.reissueCert"
+	if len(certBytes) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing certificate")
+	}
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+	}
+	if exp.IsZero() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing expiry")
+	}
+	if util.IsNil(rand) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing random data source")
+	}
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse session certificate"))
+	}
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	if !pubKey.Equal(cert.PublicKey) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "private key does not match the session certificate")
+	}
+	template := &x509.Certificate{
+		ExtKeyUsage:           cert.ExtKeyUsage,
+		DNSNames:              cert.DNSNames,
+		IPAddresses:           cert.IPAddresses,
+		KeyUsage:              cert.KeyUsage,
+		SerialNumber:          big.NewInt(mathrand.Int63()),
+		NotBefore:             cert.NotBefore,
+		NotAfter:              exp,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	newCertBytes, err := x509.CreateCertificate(rand, template, template, pubKey, privKey)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenCert))
+	}
+	return newCertBytes, nil
+}
+
 func (s *Session) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
 	const op = "session.(Session).encrypt"
 	if util.IsNil(cipher) {
@@ -379,3 +379,53 @@ func Test_newCert(t *testing.T) {
 		assert.Equal(t, parsedCert.PublicKey.(crypto.PublicKey), ed25519.PrivateKey(key).Public())
 	})
 }
+
+func Test_reissueCert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	jobId := "job-id"
+	addresses := []string{"127.0.0.1", "localhost"}
+	reader := rand.Reader
+	key, cert, err := newCert(ctx, jobId, addresses, time.Now().Add(5*time.Minute), reader)
+	require.NoError(t, err)
+	expireTime := time.Now().Add(time.Hour)
+
+	t.Run("fails-on-invalid-certificate", func(t *testing.T) {
+		_, err := reissueCert(ctx, nil, key, expireTime, reader)
+		require.Error(t, err)
+	})
+	t.Run("fails-on-invalid-private-key", func(t *testing.T) {
+		_, err := reissueCert(ctx, cert, nil, expireTime, reader)
+		require.Error(t, err)
+	})
+	t.Run("fails-on-mismatched-private-key", func(t *testing.T) {
+		otherKey, _, err := newCert(ctx, jobId, addresses, expireTime, reader)
+		require.NoError(t, err)
+		_, err = reissueCert(ctx, cert, otherKey, expireTime, reader)
+		require.Error(t, err)
+	})
+	t.Run("fails-on-invalid-expiry", func(t *testing.T) {
+		_, err := reissueCert(ctx, cert, key, time.Time{}, reader)
+		require.Error(t, err)
+	})
+	t.Run("fails-on-invalid-random-reader", func(t *testing.T) {
+		_, err := reissueCert(ctx, cert, key, expireTime, nil)
+		require.Error(t, err)
+	})
+	t.Run("succeeds-on-valid-inputs", func(t *testing.T) {
+		got, err := reissueCert(ctx, cert, key, expireTime, reader)
+		require.NoError(t, err)
+		orig, err := x509.ParseCertificate(cert)
+		require.NoError(t, err)
+		parsedCert, err := x509.ParseCertificate(got)
+		require.NoError(t, err)
+		assert.Equal(t, orig.DNSNames, parsedCert.DNSNames)
+		assert.Equal(t, orig.IPAddresses, parsedCert.IPAddresses)
+		assert.Equal(t, orig.PublicKey, parsedCert.PublicKey)
+		assert.True(t, parsedCert.NotBefore.Equal(orig.NotBefore))
+		assert.True(t, parsedCert.NotAfter.Equal(expireTime.Truncate(time.Second)), "NotAfter (%q) != expireTime (%q)", parsedCert.NotAfter.Format(time.RFC3339Nano), expireTime.Format(time.RFC3339Nano))
+
+		// The reissued certificate is signed with the session key.
+		assert.NoError(t, parsedCert.CheckSignatureFrom(orig))
+	})
+}
@@ -27,33 +27,41 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithDefaultClientPort      uint32
-	WithLimit                  int
-	WithProjectId              string
-	WithProjectIds             []string
-	WithProjectName            string
-	WithUserId                 string
-	WithType                   globals.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithStaticCredentials      []*StaticCredential
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPermissions            []perms.Permission
-	WithPublicId               string
-	WithWorkerFilter           string
-	WithTestWorkerFilter       string
-	WithEgressWorkerFilter     string
-	WithIngressWorkerFilter    string
-	WithTargetIds              []string
-	WithAddress                string
-	WithStorageBucketId        string
-	WithEnableSessionRecording bool
-	WithNetResolver            intglobals.NetIpResolver
-	WithStartPageAfterItem     pagination.Item
+	WithName                            string
+	WithDescription                     string
+	WithDefaultPort                     uint32
+	WithDefaultClientPort               uint32
+	WithLimit                           int
+	WithProjectId                       string
+	WithProjectIds                      []string
+	WithProjectName                     string
+	WithUserId                          string
+	WithType                            globals.Subtype
+	WithHostSources                     []string
+	WithCredentialLibraries             []*CredentialLibrary
+	WithStaticCredentials               []*StaticCredential
+	WithSessionMaxSeconds               uint32
+	WithSessionConnectionLimit          int32
+	WithPermissions                     []perms.Permission
+	WithPublicId                        string
+	WithWorkerFilter                    string
+	WithTestWorkerFilter                string
+	WithEgressWorkerFilter              string
+	WithIngressWorkerFilter             string
+	WithWorkerSelection                 WorkerSelectionStrategy
+	WithHostSelection                   HostSelectionStrategy
+	WithEnableHostHealthChecks          bool
+	WithBandwidthLimit                  uint32
+	WithSessionBandwidthLimit           uint32
+	WithMaxConcurrentConnections        uint32
+	WithSessionMaxConcurrentConnections uint32
+	WithConnectionIdleTimeoutSeconds    uint32
+	WithTargetIds                       []string
+	WithAddress                         string
+	WithStorageBucketId                 string
+	WithEnableSessionRecording          bool
+	WithNetResolver                     intglobals.NetIpResolver
+	WithStartPageAfterItem              pagination.Item
 }
 
 func getDefaultOptions() options {
@@ -224,6 +232,68 @@ func WithIngressWorkerFilter(filter string) Option {
 	}
 }
 
+// WithWorkerSelectionStrategy provides an optional worker selection strategy
+func WithWorkerSelectionStrategy(strategy WorkerSelectionStrategy) Option {
+	return func(o *options) {
+		o.WithWorkerSelection = strategy
+	}
+}
+
+// WithHostSelectionStrategy provides an optional host selection strategy
+func WithHostSelectionStrategy(strategy HostSelectionStrategy) Option {
+	return func(o *options) {
+		o.WithHostSelection = strategy
+	}
+}
+
+// WithEnableHostHealthChecks provides an option to enable health checks of a
+// target's hosts
+func WithEnableHostHealthChecks(enable bool) Option {
+	return func(o *options) {
+		o.WithEnableHostHealthChecks = enable
+	}
+}
+
+// WithBandwidthLimit provides an optional limit, in bytes per second, on the
+// bandwidth of all connections to a target through a single worker
+func WithBandwidthLimit(limit uint32) Option {
+	return func(o *options) {
+		o.WithBandwidthLimit = limit
+	}
+}
+
+// WithSessionBandwidthLimit provides an optional limit, in bytes per second,
+// on the bandwidth of all connections of a session
+func WithSessionBandwidthLimit(limit uint32) Option {
+	return func(o *options) {
+		o.WithSessionBandwidthLimit = limit
+	}
+}
+
+// WithMaxConcurrentConnections provides an optional limit on the number of
+// concurrent connections to a target through a single worker
+func WithMaxConcurrentConnections(limit uint32) Option {
+	return func(o *options) {
+		o.WithMaxConcurrentConnections = limit
+	}
+}
+
+// WithSessionMaxConcurrentConnections provides an optional limit on the
+// number of concurrent connections of a session
+func WithSessionMaxConcurrentConnections(limit uint32) Option {
+	return func(o *options) {
+		o.WithSessionMaxConcurrentConnections = limit
+	}
+}
+
+// WithConnectionIdleTimeoutSeconds provides an optional number of seconds
+// without any bytes transferred after which a connection is closed
+func WithConnectionIdleTimeoutSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.WithConnectionIdleTimeoutSeconds = seconds
+	}
+}
+
 // WithTargetIds provides an option to search by specific target IDs
 func WithTargetIds(with []string) Option {
 	return func(o *options) {
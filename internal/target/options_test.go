@@ -163,6 +163,62 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithIngressWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithWorkerSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithWorkerSelectionStrategy(LeastConnectionsWorkerSelection))
+		testOpts := getDefaultOptions()
+		testOpts.WithWorkerSelection = LeastConnectionsWorkerSelection
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHostSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostSelectionStrategy(RoundRobinHostSelection))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostSelection = RoundRobinHostSelection
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEnableHostHealthChecks", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithEnableHostHealthChecks(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithEnableHostHealthChecks = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBandwidthLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithBandwidthLimit(1024))
+		testOpts := getDefaultOptions()
+		testOpts.WithBandwidthLimit = 1024
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionBandwidthLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionBandwidthLimit(1024))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionBandwidthLimit = 1024
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxConcurrentConnections", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithMaxConcurrentConnections(1024))
+		testOpts := getDefaultOptions()
+		testOpts.WithMaxConcurrentConnections = 1024
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionMaxConcurrentConnections", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionMaxConcurrentConnections(1024))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionMaxConcurrentConnections = 1024
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithConnectionIdleTimeoutSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithConnectionIdleTimeoutSeconds(300))
+		testOpts := getDefaultOptions()
+		testOpts.WithConnectionIdleTimeoutSeconds = 300
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPermissions", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithPermissions([]perms.Permission{{ScopeId: "test1"}, {ScopeId: "test2"}}))
@@ -98,7 +98,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from tcp_targets
    union
   select public_id,
@@ -117,7 +125,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from ssh_targets
 )
   select *
@@ -161,7 +177,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from tcp_targets
    union
   select public_id,
@@ -180,7 +204,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from ssh_targets
 )
   select *
@@ -224,7 +256,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from tcp_targets
    union
   select public_id,
@@ -243,7 +283,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from ssh_targets
 )
   select *
@@ -288,7 +336,15 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from tcp_targets
    union
   select public_id,
@@ -307,7 +363,15 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         worker_selection_strategy,
+         host_selection_strategy,
+         enable_host_health_checks,
+         bandwidth_limit,
+         session_bandwidth_limit,
+         max_concurrent_connections,
+         session_max_concurrent_connections,
+         connection_idle_timeout_seconds
     from ssh_targets
 )
   select *
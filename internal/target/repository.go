@@ -635,6 +635,14 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("workerfilter", f):
 		case strings.EqualFold("egressworkerfilter", f):
 		case strings.EqualFold("ingressworkerfilter", f):
+		case strings.EqualFold("workerselectionstrategy", f):
+		case strings.EqualFold("hostselectionstrategy", f):
+		case strings.EqualFold("enablehosthealthchecks", f):
+		case strings.EqualFold("bandwidthlimit", f):
+		case strings.EqualFold("sessionbandwidthlimit", f):
+		case strings.EqualFold("maxconcurrentconnections", f):
+		case strings.EqualFold("sessionmaxconcurrentconnections", f):
+		case strings.EqualFold("connectionidletimeoutseconds", f):
 		case strings.EqualFold("address", f):
 			target.SetAddress(strings.TrimSpace(target.GetAddress()))
 			addressEndpoint = target.GetAddress()
@@ -648,21 +656,29 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"DefaultClientPort":      target.GetDefaultClientPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
-			"StorageBucketId":        target.GetStorageBucketId(),
-			"EnableSessionRecording": target.GetEnableSessionRecording(),
+			"Name":                            target.GetName(),
+			"Description":                     target.GetDescription(),
+			"DefaultPort":                     target.GetDefaultPort(),
+			"DefaultClientPort":               target.GetDefaultClientPort(),
+			"SessionMaxSeconds":               target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":          target.GetSessionConnectionLimit(),
+			"WorkerFilter":                    target.GetWorkerFilter(),
+			"EgressWorkerFilter":              target.GetEgressWorkerFilter(),
+			"IngressWorkerFilter":             target.GetIngressWorkerFilter(),
+			"WorkerSelectionStrategy":         target.GetWorkerSelectionStrategy(),
+			"HostSelectionStrategy":           target.GetHostSelectionStrategy(),
+			"EnableHostHealthChecks":          target.GetEnableHostHealthChecks(),
+			"BandwidthLimit":                  target.GetBandwidthLimit(),
+			"SessionBandwidthLimit":           target.GetSessionBandwidthLimit(),
+			"MaxConcurrentConnections":        target.GetMaxConcurrentConnections(),
+			"SessionMaxConcurrentConnections": target.GetSessionMaxConcurrentConnections(),
+			"ConnectionIdleTimeoutSeconds":    target.GetConnectionIdleTimeoutSeconds(),
+			"Address":                         target.GetAddress(),
+			"StorageBucketId":                 target.GetStorageBucketId(),
+			"EnableSessionRecording":          target.GetEnableSessionRecording(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "EnableHostHealthChecks", "BandwidthLimit", "SessionBandwidthLimit", "MaxConcurrentConnections", "SessionMaxConcurrentConnections", "ConnectionIdleTimeoutSeconds"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+// A WorkerSelectionStrategy determines the order of the workers which can
+// handle a session for a target. Clients try the workers in that order.
+type WorkerSelectionStrategy string
+
+// Worker selection strategies.
+const (
+	// RandomWorkerSelection orders the workers randomly. It is used when a
+	// target does not have a worker selection strategy.
+	RandomWorkerSelection WorkerSelectionStrategy = "random"
+
+	// LeastConnectionsWorkerSelection orders the workers by the number of
+	// active connections they are proxying, fewest first.
+	LeastConnectionsWorkerSelection WorkerSelectionStrategy = "least-connections"
+
+	// LatencyWeightedWorkerSelection orders the workers randomly, favoring
+	// workers with a lower status round trip time.
+	LatencyWeightedWorkerSelection WorkerSelectionStrategy = "latency-weighted"
+
+	// StickyWorkerSelection orders the workers the same way for every session
+	// of a user as long as the set of workers does not change.
+	StickyWorkerSelection WorkerSelectionStrategy = "sticky"
+)
+
+// IsValid reports whether s is a known worker selection strategy.
+func (s WorkerSelectionStrategy) IsValid() bool {
+	switch s {
+	case RandomWorkerSelection, LeastConnectionsWorkerSelection, LatencyWeightedWorkerSelection, StickyWorkerSelection:
+		return true
+	}
+	return false
+}
+
+// A HostSelectionStrategy determines which host of a target's host sources
+// is chosen for a session when the client does not request a specific host.
+type HostSelectionStrategy string
+
+// Host selection strategies.
+const (
+	// RandomHostSelection chooses a host randomly. It is used when a target
+	// does not have a host selection strategy.
+	RandomHostSelection HostSelectionStrategy = "random"
+
+	// RoundRobinHostSelection cycles through the hosts of the target. Each
+	// controller cycles through the hosts on its own, so the rotation is
+	// approximate when there is more than one controller.
+	RoundRobinHostSelection HostSelectionStrategy = "round-robin"
+
+	// LeastConnectionsHostSelection chooses the host with the fewest active
+	// connections and sessions for the target.
+	LeastConnectionsHostSelection HostSelectionStrategy = "least-connections"
+)
+
+// IsValid reports whether s is a known host selection strategy.
+func (s HostSelectionStrategy) IsValid() bool {
+	switch s {
+	case RandomHostSelection, RoundRobinHostSelection, LeastConnectionsHostSelection:
+		return true
+	}
+	return false
+}
@@ -35,6 +35,14 @@ type Target interface {
 	GetWorkerFilter() string
 	GetEgressWorkerFilter() string
 	GetIngressWorkerFilter() string
+	GetWorkerSelectionStrategy() string
+	GetHostSelectionStrategy() string
+	GetEnableHostHealthChecks() bool
+	GetBandwidthLimit() uint32
+	GetSessionBandwidthLimit() uint32
+	GetMaxConcurrentConnections() uint32
+	GetSessionMaxConcurrentConnections() uint32
+	GetConnectionIdleTimeoutSeconds() uint32
 	GetAddress() string
 	GetHostSources() []HostSource
 	GetCredentialSources() []CredentialSource
@@ -55,6 +63,14 @@ type Target interface {
 	SetWorkerFilter(string)
 	SetEgressWorkerFilter(string)
 	SetIngressWorkerFilter(string)
+	SetWorkerSelectionStrategy(string)
+	SetHostSelectionStrategy(string)
+	SetEnableHostHealthChecks(bool)
+	SetBandwidthLimit(uint32)
+	SetSessionBandwidthLimit(uint32)
+	SetMaxConcurrentConnections(uint32)
+	SetSessionMaxConcurrentConnections(uint32)
+	SetConnectionIdleTimeoutSeconds(uint32)
 	SetAddress(string)
 	SetHostSources([]HostSource)
 	SetCredentialSources([]CredentialSource)
@@ -182,6 +198,14 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetWorkerFilter(t.WorkerFilter)
 	tt.SetEgressWorkerFilter(t.EgressWorkerFilter)
 	tt.SetIngressWorkerFilter(t.IngressWorkerFilter)
+	tt.SetWorkerSelectionStrategy(t.WorkerSelectionStrategy)
+	tt.SetHostSelectionStrategy(t.HostSelectionStrategy)
+	tt.SetEnableHostHealthChecks(t.EnableHostHealthChecks)
+	tt.SetBandwidthLimit(t.BandwidthLimit)
+	tt.SetSessionBandwidthLimit(t.SessionBandwidthLimit)
+	tt.SetMaxConcurrentConnections(t.MaxConcurrentConnections)
+	tt.SetSessionMaxConcurrentConnections(t.SessionMaxConcurrentConnections)
+	tt.SetConnectionIdleTimeoutSeconds(t.ConnectionIdleTimeoutSeconds)
 	tt.SetAddress(address)
 	tt.SetHostSources(t.HostSource)
 	tt.SetCredentialSources(t.CredentialSources)
@@ -135,6 +135,38 @@ func (t *Target) GetIngressWorkerFilter() string {
 	return t.IngressWorkerFilter
 }
 
+func (t *Target) GetWorkerSelectionStrategy() string {
+	return t.WorkerSelectionStrategy
+}
+
+func (t *Target) GetHostSelectionStrategy() string {
+	return t.HostSelectionStrategy
+}
+
+func (t *Target) GetEnableHostHealthChecks() bool {
+	return t.EnableHostHealthChecks
+}
+
+func (t *Target) GetBandwidthLimit() uint32 {
+	return t.BandwidthLimit
+}
+
+func (t *Target) GetSessionBandwidthLimit() uint32 {
+	return t.SessionBandwidthLimit
+}
+
+func (t *Target) GetMaxConcurrentConnections() uint32 {
+	return t.MaxConcurrentConnections
+}
+
+func (t *Target) GetSessionMaxConcurrentConnections() uint32 {
+	return t.SessionMaxConcurrentConnections
+}
+
+func (t *Target) GetConnectionIdleTimeoutSeconds() uint32 {
+	return t.ConnectionIdleTimeoutSeconds
+}
+
 func (t *Target) GetAddress() string {
 	return t.Address
 }
@@ -227,6 +259,38 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetWorkerSelectionStrategy(strategy string) {
+	t.WorkerSelectionStrategy = strategy
+}
+
+func (t *Target) SetHostSelectionStrategy(strategy string) {
+	t.HostSelectionStrategy = strategy
+}
+
+func (t *Target) SetEnableHostHealthChecks(enable bool) {
+	t.EnableHostHealthChecks = enable
+}
+
+func (t *Target) SetBandwidthLimit(limit uint32) {
+	t.BandwidthLimit = limit
+}
+
+func (t *Target) SetSessionBandwidthLimit(limit uint32) {
+	t.SessionBandwidthLimit = limit
+}
+
+func (t *Target) SetMaxConcurrentConnections(limit uint32) {
+	t.MaxConcurrentConnections = limit
+}
+
+func (t *Target) SetSessionMaxConcurrentConnections(limit uint32) {
+	t.SessionMaxConcurrentConnections = limit
+}
+
+func (t *Target) SetConnectionIdleTimeoutSeconds(seconds uint32) {
+	t.ConnectionIdleTimeoutSeconds = seconds
+}
+
 func (t *Target) SetAddress(a string) {
 	t.Address = a
 }
@@ -318,16 +382,24 @@ func New(ctx context.Context, projectId string, opt ...target.Option) (target.Ta
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			DefaultClientPort:      opts.WithDefaultClientPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:                       projectId,
+			Name:                            opts.WithName,
+			Description:                     opts.WithDescription,
+			DefaultPort:                     opts.WithDefaultPort,
+			DefaultClientPort:               opts.WithDefaultClientPort,
+			SessionConnectionLimit:          opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:               opts.WithSessionMaxSeconds,
+			WorkerFilter:                    opts.WithWorkerFilter,
+			EgressWorkerFilter:              opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:             opts.WithIngressWorkerFilter,
+			WorkerSelectionStrategy:         string(opts.WithWorkerSelection),
+			HostSelectionStrategy:           string(opts.WithHostSelection),
+			EnableHostHealthChecks:          opts.WithEnableHostHealthChecks,
+			BandwidthLimit:                  opts.WithBandwidthLimit,
+			SessionBandwidthLimit:           opts.WithSessionBandwidthLimit,
+			MaxConcurrentConnections:        opts.WithMaxConcurrentConnections,
+			SessionMaxConcurrentConnections: opts.WithSessionMaxConcurrentConnections,
+			ConnectionIdleTimeoutSeconds:    opts.WithConnectionIdleTimeoutSeconds,
 		},
 		Address: opts.WithAddress,
 	}
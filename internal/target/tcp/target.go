@@ -55,16 +55,24 @@ func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...tar
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			DefaultClientPort:      opts.WithDefaultClientPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:                       projectId,
+			Name:                            opts.WithName,
+			Description:                     opts.WithDescription,
+			DefaultPort:                     opts.WithDefaultPort,
+			DefaultClientPort:               opts.WithDefaultClientPort,
+			SessionConnectionLimit:          opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:               opts.WithSessionMaxSeconds,
+			WorkerFilter:                    opts.WithWorkerFilter,
+			EgressWorkerFilter:              opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:             opts.WithIngressWorkerFilter,
+			WorkerSelectionStrategy:         string(opts.WithWorkerSelection),
+			HostSelectionStrategy:           string(opts.WithHostSelection),
+			EnableHostHealthChecks:          opts.WithEnableHostHealthChecks,
+			BandwidthLimit:                  opts.WithBandwidthLimit,
+			SessionBandwidthLimit:           opts.WithSessionBandwidthLimit,
+			MaxConcurrentConnections:        opts.WithMaxConcurrentConnections,
+			SessionMaxConcurrentConnections: opts.WithSessionMaxConcurrentConnections,
+			ConnectionIdleTimeoutSeconds:    opts.WithConnectionIdleTimeoutSeconds,
 		},
 		Address: opts.WithAddress,
 	}
@@ -224,6 +232,38 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetWorkerSelectionStrategy(strategy string) {
+	t.WorkerSelectionStrategy = strategy
+}
+
+func (t *Target) SetHostSelectionStrategy(strategy string) {
+	t.HostSelectionStrategy = strategy
+}
+
+func (t *Target) SetEnableHostHealthChecks(enable bool) {
+	t.EnableHostHealthChecks = enable
+}
+
+func (t *Target) SetBandwidthLimit(limit uint32) {
+	t.BandwidthLimit = limit
+}
+
+func (t *Target) SetSessionBandwidthLimit(limit uint32) {
+	t.SessionBandwidthLimit = limit
+}
+
+func (t *Target) SetMaxConcurrentConnections(limit uint32) {
+	t.MaxConcurrentConnections = limit
+}
+
+func (t *Target) SetSessionMaxConcurrentConnections(limit uint32) {
+	t.SessionMaxConcurrentConnections = limit
+}
+
+func (t *Target) SetConnectionIdleTimeoutSeconds(seconds uint32) {
+	t.ConnectionIdleTimeoutSeconds = seconds
+}
+
 func (t *Target) SetAddress(address string) {
 	t.Address = address
 }
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const rpcMethodKey = attribute.Key("rpc.method")
+
+// metadataCarrier adapts grpc metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func extractIncoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+func injectOutgoing(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func endRpcSpan(span trace.Span, err error) {
+	if err != nil {
+		s, _ := status.FromError(err)
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", s.Code().String()))
+		span.SetStatus(codes.Error, s.Message())
+	}
+	span.End()
+}
+
+// UnaryServerInterceptor returns an interceptor that starts a server span
+// for every unary request, continuing any trace propagated by the caller.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, span := otel.Tracer(tracerName).Start(extractIncoming(ctx), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(rpcMethodKey.String(info.FullMethod)))
+		resp, err := handler(ctx, req)
+		endRpcSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that starts a server span
+// for every stream, continuing any trace propagated by the caller.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := otel.Tracer(tracerName).Start(extractIncoming(ss.Context()), info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(rpcMethodKey.String(info.FullMethod)))
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		endRpcSpan(span, err)
+		return err
+	}
+}
+
+// UnaryClientInterceptor returns an interceptor that starts a client span for
+// every unary request and propagates its trace context to the server.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := otel.Tracer(tracerName).Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(rpcMethodKey.String(method)))
+		err := invoker(injectOutgoing(ctx), method, req, reply, cc, opts...)
+		endRpcSpan(span, err)
+		return err
+	}
+}
+
+// serverStream overrides the context of a grpc.ServerStream so that handlers
+// see the server span.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
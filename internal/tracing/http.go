@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartHttpServerSpan starts a server span for the request, continuing any
+// trace propagated by the caller, and returns the request with the span in its
+// context. The returned function must be called with the response status code
+// once the request has been served.
+func StartHttpServerSpan(r *http.Request) (*http.Request, func(statusCode int)) {
+	ctx := ExtractHeaders(r.Context(), r.Header)
+	ctx, span := otel.Tracer(tracerName).Start(ctx, r.Method+" "+r.URL.Path,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(r.Method),
+			semconv.URLPath(r.URL.Path),
+		))
+	return r.WithContext(ctx), func(statusCode int) {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+		if statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+		span.End()
+	}
+}
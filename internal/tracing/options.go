@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withEndpoint    string
+	withInsecure    bool
+	withSampleRatio float64
+	withExporter    sdktrace.SpanExporter
+}
+
+func getDefaultOptions() options {
+	return options{
+		withSampleRatio: 1,
+	}
+}
+
+// WithEndpoint provides the host and port of the OTLP/HTTP collector spans
+// are exported to, e.g. "localhost:4318".
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.withEndpoint = endpoint
+	}
+}
+
+// WithInsecure provides an option to export spans to the collector over
+// plain HTTP instead of HTTPS.
+func WithInsecure(insecure bool) Option {
+	return func(o *options) {
+		o.withInsecure = insecure
+	}
+}
+
+// WithSampleRatio provides the ratio of new traces that are sampled. Traces
+// started by a caller that propagated its trace context follow the caller's
+// sampling decision.
+func WithSampleRatio(ratio float64) Option {
+	return func(o *options) {
+		o.withSampleRatio = ratio
+	}
+}
+
+// WithExporter provides an option to use a span exporter other than OTLP,
+// e.g. in tests.
+func WithExporter(e sdktrace.SpanExporter) Option {
+	return func(o *options) {
+		o.withExporter = e
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package tracing provides OpenTelemetry tracing for Boundary. Spans are
+// exported to an OTLP collector once Setup has been called; until then all
+// spans are no-ops. The trace context is propagated using the W3C Trace
+// Context headers so that a single trace can follow a request from the CLI
+// through the controller and on to workers.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/hashicorp/boundary"
+
+var enabled atomic.Bool
+
+func init() {
+	// The propagator is set regardless of whether spans are exported so that
+	// a trace context received from a caller is always passed along.
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// Setup configures the global tracer provider to export spans for the named
+// service and returns a function that flushes and stops the exporter.
+// Supported options: WithEndpoint, WithInsecure, WithSampleRatio,
+// WithExporter. Either WithEndpoint or WithExporter is required.
+func Setup(ctx context.Context, serviceName string, opt ...Option) (func(context.Context) error, error) {
+	const op = "tracing.Setup"
+	if serviceName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing service name")
+	}
+	opts := getOpts(opt...)
+	if opts.withSampleRatio < 0 || opts.withSampleRatio > 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "sample ratio must be between 0 and 1")
+	}
+
+	exporter := opts.withExporter
+	if exporter == nil {
+		if opts.withEndpoint == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing endpoint")
+		}
+		clientOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(opts.withEndpoint)}
+		if opts.withInsecure {
+			clientOpts = append(clientOpts, otlptracehttp.WithInsecure())
+		}
+		var err error
+		exporter, err = otlptracehttp.New(ctx, clientOpts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create otlp exporter"))
+		}
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Get().VersionNumber()),
+	))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create tracing resource"))
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.withSampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	enabled.Store(true)
+	return func(ctx context.Context) error {
+		enabled.Store(false)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// Enabled returns true if spans are being exported. Callers can use it to
+// skip work that is only needed to describe a span.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// StartSpan starts a span with the given name as a child of any span in ctx.
+// The returned span must be ended, typically with EndSpan.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, recording err on it if it is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceId returns the id of the trace of the span in ctx. It returns false if
+// ctx has no valid span.
+func TraceId(ctx context.Context) (string, bool) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return "", false
+	}
+	return sc.TraceID().String(), true
+}
+
+// InjectHeaders adds the trace context of the span in ctx to h.
+func InjectHeaders(ctx context.Context, h http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(h))
+}
+
+// ExtractHeaders returns ctx with the trace context found in h, if any.
+func ExtractHeaders(ctx context.Context, h http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(h))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// testExporter keeps the exported spans after shutdown so that they can be
+// inspected once shutting down has flushed them.
+type testExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (testExporter) Shutdown(context.Context) error { return nil }
+
+// setupTestExporter sets up tracing and returns a function that flushes and
+// returns the exported spans.
+func setupTestExporter(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+	exp := testExporter{tracetest.NewInMemoryExporter()}
+	shutdown, err := Setup(context.Background(), "boundary-test", WithExporter(exp))
+	require.NoError(t, err)
+	return func() tracetest.SpanStubs {
+		require.NoError(t, shutdown(context.Background()))
+		return exp.GetSpans()
+	}
+}
+
+func TestSetup(t *testing.T) {
+	ctx := context.Background()
+	_, err := Setup(ctx, "")
+	assert.Error(t, err)
+	_, err = Setup(ctx, "boundary-test")
+	assert.Error(t, err, "either an endpoint or an exporter is required")
+	_, err = Setup(ctx, "boundary-test", WithEndpoint("localhost:4318"), WithSampleRatio(2))
+	assert.Error(t, err)
+
+	assert.False(t, Enabled())
+	shutdown, err := Setup(ctx, "boundary-test", WithEndpoint("localhost:4318"), WithInsecure(true))
+	require.NoError(t, err)
+	assert.True(t, Enabled())
+	_ = shutdown(ctx)
+	assert.False(t, Enabled())
+}
+
+func TestStartSpan(t *testing.T) {
+	spansFn := setupTestExporter(t)
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	traceId, ok := TraceId(ctx)
+	require.True(t, ok)
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("boom"))
+	EndSpan(parent, nil)
+
+	spans := spansFn()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, traceId, spans[0].SpanContext.TraceID().String())
+	assert.Equal(t, "parent", spans[1].Name)
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+
+	_, ok = TraceId(context.Background())
+	assert.False(t, ok)
+}
+
+func TestHttpPropagation(t *testing.T) {
+	spansFn := setupTestExporter(t)
+
+	ctx, client := StartSpan(context.Background(), "client")
+	req := httptest.NewRequest(http.MethodGet, "/v1/targets", nil)
+	InjectHeaders(ctx, req.Header)
+	client.End()
+
+	req, end := StartHttpServerSpan(req)
+	serverTraceId, ok := TraceId(req.Context())
+	require.True(t, ok)
+	end(http.StatusInternalServerError)
+
+	assert.Equal(t, client.SpanContext().TraceID().String(), serverTraceId)
+	spans := spansFn()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "GET /v1/targets", spans[1].Name)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+}
+
+func TestGrpcPropagation(t *testing.T) {
+	spansFn := setupTestExporter(t)
+
+	var serverTraceId string
+	server := UnaryServerInterceptor()
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		// Hand the outgoing metadata to the server as incoming metadata.
+		md, _ := metadata.FromOutgoingContext(ctx)
+		_, err := server(metadata.NewIncomingContext(context.Background(), md), req,
+			&grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req any) (any, error) {
+				serverTraceId, _ = TraceId(ctx)
+				return nil, nil
+			})
+		return err
+	}
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	err := UnaryClientInterceptor()(ctx, "/test.Service/Method", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	parent.End()
+
+	assert.Equal(t, parent.SpanContext().TraceID().String(), serverTraceId)
+	spans := spansFn()
+	require.Len(t, spans, 3)
+	for _, s := range spans {
+		assert.Equal(t, parent.SpanContext().TraceID(), s.SpanContext.TraceID())
+	}
+}
@@ -74,6 +74,10 @@ const (
 	AddGrantScopes                     Type = 60
 	SetGrantScopes                     Type = 61
 	RemoveGrantScopes                  Type = 62
+	ImportHosts                        Type = 63
+	Extend                             Type = 64
+	ExtendSelf                         Type = 65
+	Drain                              Type = 66
 
 	// When adding new actions, be sure to update:
 	//
@@ -144,6 +148,10 @@ var Map = map[string]Type{
 	AddGrantScopes.String():                     AddGrantScopes,
 	SetGrantScopes.String():                     SetGrantScopes,
 	RemoveGrantScopes.String():                  RemoveGrantScopes,
+	ImportHosts.String():                        ImportHosts,
+	Extend.String():                             Extend,
+	ExtendSelf.String():                         ExtendSelf,
+	Drain.String():                              Drain,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -220,6 +228,10 @@ func (a Type) String() string {
 		"add-grant-scopes",
 		"set-grant-scopes",
 		"remove-grant-scopes",
+		"import-hosts",
+		"extend",
+		"extend:self",
+		"drain",
 	}[a]
 }
 
@@ -138,6 +138,22 @@ func TestAction(t *testing.T) {
 			action: Download,
 			want:   "download",
 		},
+		{
+			action: ImportHosts,
+			want:   "import-hosts",
+		},
+		{
+			action: Extend,
+			want:   "extend",
+		},
+		{
+			action: ExtendSelf,
+			want:   "extend:self",
+		},
+		{
+			action: Drain,
+			want:   "drain",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
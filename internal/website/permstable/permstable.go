@@ -695,6 +695,13 @@ var session = &Resource{
 						"ids=<id>;actions=cancel",
 					},
 				},
+				{
+					Name:        "extend",
+					Description: "Extend the expiration of a session",
+					Examples: []string{
+						"ids=<id>;actions=extend",
+					},
+				},
 				{
 					Name:        "read:self",
 					Description: "Read a session, which must be associated with the calling user",
@@ -709,6 +716,13 @@ var session = &Resource{
 						"ids=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "extend:self",
+					Description: "Extend the expiration of a session, which must be associated with the calling user",
+					Examples: []string{
+						"ids=*;type=session;actions=extend:self",
+					},
+				},
 			},
 		},
 	},
@@ -948,7 +962,16 @@ var worker = &Resource{
 				"ID":   "<id>",
 				"Type": "worker",
 			},
-			Actions: rudActions("a worker", false),
+			Actions: append(
+				rudActions("a worker", false),
+				&Action{
+					Name:        "drain",
+					Description: "Request that a worker stop accepting new sessions and shut down once its connections have closed",
+					Examples: []string{
+						"ids=<id>;actions=drain",
+					},
+				},
+			),
 		},
 	},
 }
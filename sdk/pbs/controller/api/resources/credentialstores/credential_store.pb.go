@@ -245,6 +245,27 @@ type VaultCredentialStoreAttributes struct {
 	WorkerFilter *wrapperspb.StringValue `protobuf:"bytes,110,opt,name=worker_filter,proto3" json:"worker_filter,omitempty" class:"public"` // @gotags: `class:"public"`
 	// Output only. The status of the vault token used by this credential store (current or expired).
 	TokenStatus string `protobuf:"bytes,120,opt,name=token_status,proto3" json:"token_status,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The results of the most recent health check of this credential store.
+	Health *VaultCredentialStoreHealth `protobuf:"bytes,130,opt,name=health,proto3" json:"health,omitempty"`
+	// Output only. The vault auth method the credential store uses to log in to vault (approle or kubernetes).
+	// Not set if the credential store uses the vault token it was created with.
+	AuthMethod string `protobuf:"bytes,140,opt,name=auth_method,proto3" json:"auth_method,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The path the approle or kubernetes auth method is mounted at in vault.
+	// Defaults to "approle" or "kubernetes".
+	AuthMountPath *wrapperspb.StringValue `protobuf:"bytes,150,opt,name=auth_mount_path,proto3" json:"auth_mount_path,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The role id used to log in to vault with the approle auth method.
+	ApproleRoleId *wrapperspb.StringValue `protobuf:"bytes,160,opt,name=approle_role_id,proto3" json:"approle_role_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Input only. The secret id used to log in to vault with the approle auth method.
+	ApproleSecretId *wrapperspb.StringValue `protobuf:"bytes,170,opt,name=approle_secret_id,proto3" json:"approle_secret_id,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// Output only. The hmac value of the approle secret id used by this credential store.
+	ApproleSecretIdHmac string `protobuf:"bytes,180,opt,name=approle_secret_id_hmac,proto3" json:"approle_secret_id_hmac,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The role used to log in to vault with the kubernetes auth method.
+	KubernetesRole *wrapperspb.StringValue `protobuf:"bytes,190,opt,name=kubernetes_role,proto3" json:"kubernetes_role,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Input only. The service account token used to log in to vault with the kubernetes auth method.
+	// Required when using the kubernetes auth method.
+	KubernetesJwt *wrapperspb.StringValue `protobuf:"bytes,200,opt,name=kubernetes_jwt,proto3" json:"kubernetes_jwt,omitempty" class:"secret"` // @gotags: `class:"secret"`
+	// Output only. The hmac value of the kubernetes service account token used by this credential store.
+	KubernetesJwtHmac string `protobuf:"bytes,210,opt,name=kubernetes_jwt_hmac,proto3" json:"kubernetes_jwt_hmac,omitempty" class:"public"` // @gotags: `class:"public"`
 }
 
 func (x *VaultCredentialStoreAttributes) Reset() {
@@ -363,6 +384,181 @@ func (x *VaultCredentialStoreAttributes) GetTokenStatus() string {
 	return ""
 }
 
+func (x *VaultCredentialStoreAttributes) GetHealth() *VaultCredentialStoreHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreAttributes) GetAuthMountPath() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AuthMountPath
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetApproleRoleId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ApproleRoleId
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetApproleSecretId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ApproleSecretId
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetApproleSecretIdHmac() string {
+	if x != nil {
+		return x.ApproleSecretIdHmac
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreAttributes) GetKubernetesRole() *wrapperspb.StringValue {
+	if x != nil {
+		return x.KubernetesRole
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetKubernetesJwt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.KubernetesJwt
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreAttributes) GetKubernetesJwtHmac() string {
+	if x != nil {
+		return x.KubernetesJwtHmac
+	}
+	return ""
+}
+
+// The results of the most recent health check of a Vault credential store.
+type VaultCredentialStoreHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The overall health of the credential store (healthy, degraded, or unhealthy).
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. Whether Vault was reachable during the most recent check. Not set if the credential store has not been checked yet.
+	VaultReachable *wrapperspb.BoolValue `protobuf:"bytes,20,opt,name=vault_reachable,proto3" json:"vault_reachable,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The time the current vault token will expire if it is not renewed.
+	TokenExpirationTime *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=token_expiration_time,proto3" json:"token_expiration_time,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. A Vault policy describing the capabilities required by Boundary that the current vault token is missing.
+	MissingCapabilities string `protobuf:"bytes,40,opt,name=missing_capabilities,proto3" json:"missing_capabilities,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The error returned by the most recent health check, if any.
+	LastCheckError string `protobuf:"bytes,50,opt,name=last_check_error,proto3" json:"last_check_error,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The time of the most recent health check.
+	LastCheckTime *timestamppb.Timestamp `protobuf:"bytes,60,opt,name=last_check_time,proto3" json:"last_check_time,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The error returned by the most recent failed attempt to renew the vault token, if any.
+	LastRenewalError string `protobuf:"bytes,70,opt,name=last_renewal_error,proto3" json:"last_renewal_error,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The time of the most recent failed attempt to renew the vault token.
+	LastRenewalErrorTime *timestamppb.Timestamp `protobuf:"bytes,80,opt,name=last_renewal_error_time,proto3" json:"last_renewal_error_time,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *VaultCredentialStoreHealth) Reset() {
+	*x = VaultCredentialStoreHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCredentialStoreHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCredentialStoreHealth) ProtoMessage() {}
+
+func (x *VaultCredentialStoreHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCredentialStoreHealth.ProtoReflect.Descriptor instead.
+func (*VaultCredentialStoreHealth) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_credentialstores_v1_credential_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultCredentialStoreHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreHealth) GetVaultReachable() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.VaultReachable
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreHealth) GetTokenExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TokenExpirationTime
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreHealth) GetMissingCapabilities() string {
+	if x != nil {
+		return x.MissingCapabilities
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreHealth) GetLastCheckError() string {
+	if x != nil {
+		return x.LastCheckError
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreHealth) GetLastCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckTime
+	}
+	return nil
+}
+
+func (x *VaultCredentialStoreHealth) GetLastRenewalError() string {
+	if x != nil {
+		return x.LastRenewalError
+	}
+	return ""
+}
+
+func (x *VaultCredentialStoreHealth) GetLastRenewalErrorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRenewalErrorTime
+	}
+	return nil
+}
+
 var File_controller_api_resources_credentialstores_v1_credential_store_proto protoreflect.FileDescriptor
 
 var file_controller_api_resources_credentialstores_v1_credential_store_proto_rawDesc = []byte{
@@ -454,7 +650,7 @@ var file_controller_api_resources_credentialstores_v1_credential_store_proto_raw
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x22,
-	0xad, 0x09, 0x0a, 0x1e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0xff, 0x0f, 0x0a, 0x1e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
 	0x65, 0x73, 0x12, 0x62, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
@@ -528,14 +724,99 @@ var file_controller_api_resources_credentialstores_v1_credential_store_proto_raw
 	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x77, 0x6f,
 	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x78, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x62, 0x5a, 0x60, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79,
-	0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x62, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x73, 0x3b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x61, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x48, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x21, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x78, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2f, 0xa0,
+	0xda, 0x29, 0x01, 0xc2, 0xdd, 0x29, 0x27, 0x0a, 0x1a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x09, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x75, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2c, 0xa0, 0xda, 0x29, 0x01, 0xc2, 0xdd, 0x29,
+	0x24, 0x0a, 0x1a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x06, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x7d, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0xaa, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x30, 0xa0, 0xda, 0x29, 0x01, 0xc2, 0xdd, 0x29, 0x28, 0x0a, 0x1c, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x08, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x64, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x6d, 0x61, 0x63, 0x18,
+	0xb4, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x6d, 0x61, 0x63, 0x12, 0x7d,
+	0x0a, 0x0f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0xbe, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x34, 0xa0, 0xda, 0x29, 0x01, 0xc2, 0xdd, 0x29, 0x2c,
+	0x0a, 0x1a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0f, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x6f, 0x0a,
+	0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6a, 0x77, 0x74, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x28, 0xa0, 0xda, 0x29, 0x01, 0xc2, 0xdd, 0x29, 0x20, 0x0a, 0x19,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6a, 0x77, 0x74, 0x12, 0x03, 0x4a, 0x77, 0x74, 0x52, 0x0e,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6a, 0x77, 0x74, 0x12, 0x31,
+	0x0a, 0x13, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6a, 0x77, 0x74,
+	0x5f, 0x68, 0x6d, 0x61, 0x63, 0x18, 0xd2, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6a, 0x77, 0x74, 0x5f, 0x68, 0x6d, 0x61,
+	0x63, 0x22, 0xf8, 0x03, 0x0a, 0x1a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x50,
+	0x0a, 0x15, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x32, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x44, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x46, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61,
+	0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x62, 0x5a, 0x60,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x64,
+	0x6b, 0x2f, 0x70, 0x62, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x3b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -550,42 +831,53 @@ func file_controller_api_resources_credentialstores_v1_credential_store_proto_ra
 	return file_controller_api_resources_credentialstores_v1_credential_store_proto_rawDescData
 }
 
-var file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_controller_api_resources_credentialstores_v1_credential_store_proto_goTypes = []interface{}{
 	(*CredentialStore)(nil),                // 0: controller.api.resources.credentialstores.v1.CredentialStore
 	(*VaultCredentialStoreAttributes)(nil), // 1: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes
-	nil,                                    // 2: controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry
-	(*scopes.ScopeInfo)(nil),               // 3: controller.api.resources.scopes.v1.ScopeInfo
-	(*wrapperspb.StringValue)(nil),         // 4: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),          // 5: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                // 6: google.protobuf.Struct
-	(*wrapperspb.BoolValue)(nil),           // 7: google.protobuf.BoolValue
-	(*structpb.ListValue)(nil),             // 8: google.protobuf.ListValue
+	(*VaultCredentialStoreHealth)(nil),     // 2: controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth
+	nil,                                    // 3: controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry
+	(*scopes.ScopeInfo)(nil),               // 4: controller.api.resources.scopes.v1.ScopeInfo
+	(*wrapperspb.StringValue)(nil),         // 5: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),          // 6: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 7: google.protobuf.Struct
+	(*wrapperspb.BoolValue)(nil),           // 8: google.protobuf.BoolValue
+	(*structpb.ListValue)(nil),             // 9: google.protobuf.ListValue
 }
 var file_controller_api_resources_credentialstores_v1_credential_store_proto_depIdxs = []int32{
-	3,  // 0: controller.api.resources.credentialstores.v1.CredentialStore.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
-	4,  // 1: controller.api.resources.credentialstores.v1.CredentialStore.name:type_name -> google.protobuf.StringValue
-	4,  // 2: controller.api.resources.credentialstores.v1.CredentialStore.description:type_name -> google.protobuf.StringValue
-	5,  // 3: controller.api.resources.credentialstores.v1.CredentialStore.created_time:type_name -> google.protobuf.Timestamp
-	5,  // 4: controller.api.resources.credentialstores.v1.CredentialStore.updated_time:type_name -> google.protobuf.Timestamp
-	6,  // 5: controller.api.resources.credentialstores.v1.CredentialStore.attributes:type_name -> google.protobuf.Struct
+	4,  // 0: controller.api.resources.credentialstores.v1.CredentialStore.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
+	5,  // 1: controller.api.resources.credentialstores.v1.CredentialStore.name:type_name -> google.protobuf.StringValue
+	5,  // 2: controller.api.resources.credentialstores.v1.CredentialStore.description:type_name -> google.protobuf.StringValue
+	6,  // 3: controller.api.resources.credentialstores.v1.CredentialStore.created_time:type_name -> google.protobuf.Timestamp
+	6,  // 4: controller.api.resources.credentialstores.v1.CredentialStore.updated_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: controller.api.resources.credentialstores.v1.CredentialStore.attributes:type_name -> google.protobuf.Struct
 	1,  // 6: controller.api.resources.credentialstores.v1.CredentialStore.vault_credential_store_attributes:type_name -> controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes
-	2,  // 7: controller.api.resources.credentialstores.v1.CredentialStore.authorized_collection_actions:type_name -> controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry
-	4,  // 8: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.address:type_name -> google.protobuf.StringValue
-	4,  // 9: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.namespace:type_name -> google.protobuf.StringValue
-	4,  // 10: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.ca_cert:type_name -> google.protobuf.StringValue
-	4,  // 11: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.tls_server_name:type_name -> google.protobuf.StringValue
-	7,  // 12: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.tls_skip_verify:type_name -> google.protobuf.BoolValue
-	4,  // 13: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.token:type_name -> google.protobuf.StringValue
-	4,  // 14: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.client_certificate:type_name -> google.protobuf.StringValue
-	4,  // 15: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.client_certificate_key:type_name -> google.protobuf.StringValue
-	4,  // 16: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.worker_filter:type_name -> google.protobuf.StringValue
-	8,  // 17: controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry.value:type_name -> google.protobuf.ListValue
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	3,  // 7: controller.api.resources.credentialstores.v1.CredentialStore.authorized_collection_actions:type_name -> controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry
+	5,  // 8: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.address:type_name -> google.protobuf.StringValue
+	5,  // 9: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.namespace:type_name -> google.protobuf.StringValue
+	5,  // 10: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.ca_cert:type_name -> google.protobuf.StringValue
+	5,  // 11: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.tls_server_name:type_name -> google.protobuf.StringValue
+	8,  // 12: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.tls_skip_verify:type_name -> google.protobuf.BoolValue
+	5,  // 13: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.token:type_name -> google.protobuf.StringValue
+	5,  // 14: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.client_certificate:type_name -> google.protobuf.StringValue
+	5,  // 15: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.client_certificate_key:type_name -> google.protobuf.StringValue
+	5,  // 16: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.worker_filter:type_name -> google.protobuf.StringValue
+	2,  // 17: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.health:type_name -> controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth
+	5,  // 18: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.auth_mount_path:type_name -> google.protobuf.StringValue
+	5,  // 19: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.approle_role_id:type_name -> google.protobuf.StringValue
+	5,  // 20: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.approle_secret_id:type_name -> google.protobuf.StringValue
+	5,  // 21: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.kubernetes_role:type_name -> google.protobuf.StringValue
+	5,  // 22: controller.api.resources.credentialstores.v1.VaultCredentialStoreAttributes.kubernetes_jwt:type_name -> google.protobuf.StringValue
+	8,  // 23: controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth.vault_reachable:type_name -> google.protobuf.BoolValue
+	6,  // 24: controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth.token_expiration_time:type_name -> google.protobuf.Timestamp
+	6,  // 25: controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth.last_check_time:type_name -> google.protobuf.Timestamp
+	6,  // 26: controller.api.resources.credentialstores.v1.VaultCredentialStoreHealth.last_renewal_error_time:type_name -> google.protobuf.Timestamp
+	9,  // 27: controller.api.resources.credentialstores.v1.CredentialStore.AuthorizedCollectionActionsEntry.value:type_name -> google.protobuf.ListValue
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_controller_api_resources_credentialstores_v1_credential_store_proto_init() }
@@ -618,6 +910,18 @@ func file_controller_api_resources_credentialstores_v1_credential_store_proto_in
 				return nil
 			}
 		}
+		file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCredentialStoreHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_controller_api_resources_credentialstores_v1_credential_store_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*CredentialStore_Attributes)(nil),
@@ -629,7 +933,7 @@ func file_controller_api_resources_credentialstores_v1_credential_store_proto_in
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_controller_api_resources_credentialstores_v1_credential_store_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},